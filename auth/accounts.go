@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// Name of the cookie storing the staff session token
+	SessionCookie = "session"
+
+	// Duration of staff sessions
+	SessionExpiry = 30 * 24 * time.Hour
+)
+
+// Session is an authenticated staff member's login session
+type Session struct {
+	UserID string                 `json:"user_id"`
+	Level  common.ModerationLevel `json:"level"`
+}
+
+// TagStaff is a staff position scoped to threads with a specific tag
+type TagStaff struct {
+	Account     string                 `json:"account"`
+	Tag         string                 `json:"tag"`
+	Level       common.ModerationLevel `json:"level"`
+	AppointedBy string                 `json:"appointed_by"`
+	Created     time.Time              `json:"created"`
+}
+
+// HashPassword generates a bcrypt hash from the passed password
+func HashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+}
+
+// ComparePassword compares a bcrypt hash with a plaintext password.
+// Returns common.ErrInvalidCreds on mismatch.
+func ComparePassword(hash []byte, password string) error {
+	err := bcrypt.CompareHashAndPassword(hash, []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		err = common.ErrInvalidCreds
+	}
+	return err
+}
+
+// Hash of bcrypt.DefaultCost compared against by CompareDummyPassword
+var dummyHash = []byte(
+	"$2a$10$jneCsWORCPMusOjUmf9hguSsJHG4VBXaTWbwl2qphN94r.Hu1GUKm",
+)
+
+// CompareDummyPassword takes as long as ComparePassword and always returns
+// common.ErrInvalidCreds. Used for nonexistent accounts, so they can not be
+// told apart from existing ones by response time.
+func CompareDummyPassword(password string) error {
+	bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+	return common.ErrInvalidCreds
+}
+
+// NewSessionToken generates a random session token of common.LenSession
+// length
+func NewSessionToken() (string, error) {
+	// 128 bytes encode to 171 base64 characters
+	return RandomID(128)
+}
+
+// GetSessionToken extracts the session token from a request's cookies.
+// Returns an empty string, if none or invalid.
+func GetSessionToken(r *http.Request) string {
+	c, err := r.Cookie(SessionCookie)
+	if err != nil || len(c.Value) != common.LenSession {
+		return ""
+	}
+	return c.Value
+}
+
+// SetSessionCookie sets the session cookie on a response to r. An empty token
+// removes the cookie. The cookie is restricted to HTTPS, if the request was
+// made over TLS or the site is served over HTTPS.
+func SetSessionCookie(w http.ResponseWriter, r *http.Request, token string) {
+	c := &http.Cookie{
+		Name:     SessionCookie,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure: r.TLS != nil ||
+			strings.HasPrefix(config.Get().RootURL, "https://"),
+		SameSite: http.SameSiteStrictMode,
+	}
+	if token == "" {
+		c.MaxAge = -1
+	} else {
+		c.Expires = time.Now().Add(SessionExpiry)
+	}
+	http.SetCookie(w, c)
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordHashing(t *testing.T) {
+	t.Parallel()
+
+	const password = "123456"
+	hash, err := HashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ComparePassword(hash, password); err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, ComparePassword(hash, "654321"), common.ErrInvalidCreds)
+}
+
+func TestCompareDummyPassword(t *testing.T) {
+	t.Parallel()
+
+	// Must take as long as comparing against hashes of real passwords
+	cost, err := bcrypt.Cost(dummyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, cost, bcrypt.DefaultCost)
+	AssertEquals(t, CompareDummyPassword("123456"), common.ErrInvalidCreds)
+}
+
+func TestNewSessionToken(t *testing.T) {
+	t.Parallel()
+
+	token, err := NewSessionToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(token), common.LenSession)
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"net"
+	"strings"
 	"time"
 
 	"github.com/bakape/meguca/common"
@@ -11,31 +12,96 @@ import (
 type ModLogEntry struct {
 	common.ModerationEntry
 	ID      uint64    `json:"id"`
+	Post    uint64    `json:"post,omitempty"`
+	Thread  uint64    `json:"thread,omitempty"`
 	Created time.Time `json:"created"`
-	Board   string    `json:"board"`
+
+	// Target of a ban or a lifted ban
+	Ban *Ban `json:"ban,omitempty"`
 }
 
-// Ban holds an entry of an IP being banned from a board
+// Ban holds an entry of an IP range, a public key or both being banned. Unset
+// fields are zero values.
 type Ban struct {
-	IP     net.IP `json:"ban"`
-	Thread uint64 `json:"thread"` // 0, if global ban
+	IP        *IPRange `json:"ip,omitempty"`
+	PublicKey uint64   `json:"public_key,omitempty"`
+
+	// Also ban all public keys ever used from IP range
+	AllKeys bool `json:"all_keys"`
+}
+
+// IPRange is an IP address range encoded in CIDR notation. Single IPs can be
+// encoded without the prefix length.
+type IPRange struct {
+	net.IPNet
+}
+
+// NewIPRange creates an IPRange matching only the passed IP
+func NewIPRange(ip net.IP) *IPRange {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return &IPRange{
+		net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(len(ip)*8, len(ip)*8),
+		},
+	}
+}
+
+func (r IPRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *IPRange) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.ContainsRune(s, '/') {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return common.ErrInvalidInput("invalid IP: " + s)
+		}
+		*r = *NewIPRange(ip)
+		return nil
+	}
+
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return common.ErrInvalidInput("invalid IP range: " + s)
+	}
+	r.IPNet = *n
+	return nil
 }
 
 // BanRecord stores information about a specific ban
 type BanRecord struct {
 	Ban
-	ForPost uint64    `json:"for_post"`
+	ID      uint64    `json:"id"`
+	ForPost uint64    `json:"for_post"` // 0, if not issued for a post
 	Reason  string    `json:"reason"`
 	By      string    `json:"by"`
-	Type    string    `json:"type"`
+	Created time.Time `json:"created"`
 	Expires time.Time `json:"expires"`
 }
 
+// BanAppeal is an appeal of a ban by the banned user
+type BanAppeal struct {
+	ID      uint64    `json:"id"`
+	Ban     BanRecord `json:"ban"`
+	Body    string    `json:"body"`
+	Created time.Time `json:"created"`
+}
+
 // Report contains data of a reported post
 type Report struct {
 	ID      uint64    `json:"id"`
 	Target  uint64    `json:"target"`
 	Created time.Time `json:"created"`
-	Board   string    `json:"board"`
 	Reason  string    `json:"reason"`
 }
+
+// ReportGroup contains all open reports of a single post
+type ReportGroup struct {
+	Post    uint64   `json:"post"`
+	Thread  uint64   `json:"thread"`
+	Reports []Report `json:"reports"`
+}
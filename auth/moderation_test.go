@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestIPRangeText(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, in, out string
+		err           bool
+	}{
+		{
+			name: "IPv4",
+			in:   "192.0.2.1",
+			out:  "192.0.2.1/32",
+		},
+		{
+			name: "IPv6",
+			in:   "2001:db8::1",
+			out:  "2001:db8::1/128",
+		},
+		{
+			name: "CIDR",
+			in:   "192.0.2.7/24",
+			out:  "192.0.2.0/24",
+		},
+		{
+			name: "invalid IP",
+			in:   "192.0.2",
+			err:  true,
+		},
+		{
+			name: "invalid CIDR",
+			in:   "192.0.2.0/33",
+			err:  true,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r IPRange
+			err := r.UnmarshalText([]byte(c.in))
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := r.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, string(out), c.out)
+		})
+	}
+}
@@ -3,14 +3,19 @@
 package cache
 
 import (
+	"bytes"
 	"context"
 	"encoding/gob"
+	"io"
 	"net/http"
 	"time"
 
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/util"
 	"github.com/bakape/recache/v6"
+	"github.com/jackc/pgx/v4"
 )
 
 // TODO: Evict from cache on post and thread updates
@@ -23,16 +28,24 @@ var (
 	// Cache frontend for retreiving thread page JSON
 	threadFrontend *recache.Frontend
 
-	// Cache frontend for retrieving the thread index.
-	// Contrains only one record.
+	// Cache frontend for retrieving sorted pages of the thread index
 	indexFrontend *recache.Frontend
 
-	// Stores the threads IDs pf all threads.
-	// Contrains only one record.
+	// Stores the sorted thread IDs of thread index pages
 	threadIDFrontend *recache.Frontend
 
+	// Cache frontend for retrieving sorted pages of threads with a specific
+	// tag
+	tagIndexFrontend *recache.Frontend
+
+	// Stores the sorted thread IDs of tag thread index pages
+	tagThreadIDFrontend *recache.Frontend
+
 	// List of currently used tags
 	usedTagsFrontend *recache.Frontend
+
+	// Cache frontend for retrieving single post JSON
+	postFrontend *recache.Frontend
 )
 
 // Key for identifying thread pages
@@ -41,6 +54,20 @@ type threadKey struct {
 	page int
 }
 
+// Key for identifying sorted thread index pages
+type indexKey struct {
+	sort  common.ThreadSortMode
+	page  uint
+	limit uint
+}
+
+// Key for identifying sorted thread index pages of a specific tag.
+// A limit of 0 denotes the tag catalog containing all threads with the tag.
+type tagIndexKey struct {
+	tag string
+	indexKey
+}
+
 // Init cache with specified max memory usage
 func Init() (err error) {
 	cache = recache.NewCache(recache.CacheOptions{
@@ -62,10 +89,11 @@ func Init() (err error) {
 	})
 
 	threadIDFrontend = cache.NewFrontend(func(
-		_ recache.Key,
+		k recache.Key,
 		rw *recache.RecordWriter,
 	) (err error) {
-		ids, err := db.GetThreadIDs()
+		key := k.(indexKey)
+		ids, err := db.GetThreadIDs(key.sort, key.page, key.limit)
 		if err != nil {
 			return
 		}
@@ -73,51 +101,53 @@ func Init() (err error) {
 	})
 
 	indexFrontend = cache.NewFrontend(func(
-		_ recache.Key,
+		k recache.Key,
 		rw *recache.RecordWriter,
 	) (err error) {
-		var ids []uint64
-		s, err := rw.Bind(threadIDFrontend, struct{}{})
-		if err != nil {
-			return
-		}
-		err = gob.NewDecoder(s.Decompress()).Decode(&ids)
-		if err != nil {
-			return
-		}
+		return writeThreadList(rw, threadIDFrontend, k)
+	})
 
-		_, err = rw.Write([]byte{'['})
+	tagThreadIDFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		key := k.(tagIndexKey)
+		ids, err := db.GetTagThreadIDs(
+			key.tag,
+			key.sort,
+			key.page,
+			key.limit,
+		)
 		if err != nil {
 			return
 		}
-		for i, id := range ids {
-			if i != 0 {
-				_, err = rw.Write([]byte{','})
-				if err != nil {
-					return
-				}
-			}
-			err = rw.Include(threadFrontend, threadKey{
-				id:   id,
-				page: -5,
-			})
-			if err != nil {
-				return
-			}
-		}
-		_, err = rw.Write([]byte{']'})
-		return
+		return gob.NewEncoder(rw).Encode(ids)
+	})
+
+	tagIndexFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		return writeThreadList(rw, tagThreadIDFrontend, k)
 	})
 
 	usedTagsFrontend = cache.NewFrontend(func(
 		_ recache.Key,
 		rw *recache.RecordWriter,
 	) (err error) {
-		_, err = rw.Bind(threadIDFrontend, struct{}{})
+		buf, err := db.GetTagList(context.Background())
 		if err != nil {
 			return
 		}
-		buf, err := db.GetTagList(context.Background())
+		_, err = rw.Write(buf)
+		return
+	})
+
+	postFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		buf, err := db.GetPost(context.Background(), k.(uint64))
 		if err != nil {
 			return
 		}
@@ -125,6 +155,66 @@ func Init() (err error) {
 		return
 	})
 
+	initFeeds()
+	initHTML()
+	util.Hook("config.updated", func() error {
+		evictConfigDependant()
+		return nil
+	})
+
+	err = db.ListenThreadDeletion(func(id uint64) error {
+		EvictThread(id)
+		EvictThreadList()
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	// Open post bodies are updated and posts closed by the websocket server
+	// without going through this process
+	return db.ListenPostUpdates(func(id uint64) error {
+		EvictPost(id)
+		return nil
+	})
+}
+
+// Write JSON array of threads with their last 5 posts. The thread IDs are read
+// from the record of idFrontend under key k.
+func writeThreadList(
+	rw *recache.RecordWriter,
+	idFrontend *recache.Frontend,
+	k recache.Key,
+) (err error) {
+	var ids []uint64
+	s, err := rw.Bind(idFrontend, k)
+	if err != nil {
+		return
+	}
+	err = gob.NewDecoder(s.Decompress()).Decode(&ids)
+	if err != nil {
+		return
+	}
+
+	_, err = rw.Write([]byte{'['})
+	if err != nil {
+		return
+	}
+	for i, id := range ids {
+		if i != 0 {
+			_, err = rw.Write([]byte{','})
+			if err != nil {
+				return
+			}
+		}
+		err = rw.Include(threadFrontend, threadKey{
+			id:   id,
+			page: -5,
+		})
+		if err != nil {
+			return
+		}
+	}
+	_, err = rw.Write([]byte{']'})
 	return
 }
 
@@ -156,11 +246,26 @@ func EvictThreadPage(id uint64, page uint32) {
 		id:   id,
 		page: -5,
 	})
+
+	// The post may have been inserted into a new last page of the thread
+	threadFeedFrontend.Evict(evictionTimer, id)
+
+	// New posts bump threads and change their reply counts, so the order of
+	// the index has likely changed
+	threadIDFrontend.EvictAll(evictionTimer)
+	tagThreadIDFrontend.EvictAll(evictionTimer)
+}
+
+// Evict a single post
+func EvictPost(id uint64) {
+	postFrontend.Evict(evictionTimer, id)
 }
 
 // Call this to evict caches on new thread creation or old thread deletion
 func EvictThreadList() {
 	threadIDFrontend.EvictAll(0)
+	tagThreadIDFrontend.EvictAll(0)
+	usedTagsFrontend.EvictAll(0)
 }
 
 // Write thread page JSON to w
@@ -185,9 +290,56 @@ func WriteThread(
 	return
 }
 
-// Write thread index JSON to w
-func WriteIndex(w http.ResponseWriter, r *http.Request) (err error) {
-	_, err = indexFrontend.WriteHTTP(struct{}{}, w, r)
+// Write a page of the thread index JSON to w
+//
+// sort: order to sort threads in
+// page: page of the index to write, starting at 0
+// limit: maximum number of threads on a page
+func WriteIndex(
+	w http.ResponseWriter, r *http.Request,
+	sort common.ThreadSortMode,
+	page, limit uint,
+) (err error) {
+	_, err = indexFrontend.WriteHTTP(indexKey{sort, page, limit}, w, r)
+	return
+}
+
+// Write a page of the thread index of a specific tag as JSON to w
+//
+// sort: order to sort threads in
+// page: page of the index to write, starting at 0
+// limit: maximum number of threads on a page
+func WriteTagIndex(
+	w http.ResponseWriter, r *http.Request,
+	tag string,
+	sort common.ThreadSortMode,
+	page, limit uint,
+) (err error) {
+	_, err = tagIndexFrontend.WriteHTTP(
+		tagIndexKey{
+			tag:      tag,
+			indexKey: indexKey{sort, page, limit},
+		},
+		w, r,
+	)
+	return
+}
+
+// Write all threads with a specific tag as JSON to w
+func WriteTagCatalog(
+	w http.ResponseWriter, r *http.Request,
+	tag string,
+	sort common.ThreadSortMode,
+) (err error) {
+	_, err = tagIndexFrontend.WriteHTTP(
+		tagIndexKey{
+			tag: tag,
+			indexKey: indexKey{
+				sort: sort,
+			},
+		},
+		w, r,
+	)
 	return
 }
 
@@ -196,3 +348,38 @@ func WriteUsedTags(w http.ResponseWriter, r *http.Request) (err error) {
 	_, err = usedTagsFrontend.WriteHTTP(struct{}{}, w, r)
 	return
 }
+
+// Write post JSON to w
+func WritePost(w http.ResponseWriter, r *http.Request, id uint64) (err error) {
+	_, err = postFrontend.WriteHTTP(id, w, r)
+	return
+}
+
+// Write a JSON array of posts to w. Posts that do not exist are omitted.
+// Nothing is written, if reading any of the posts fails.
+func WritePosts(w io.Writer, ids []uint64) (err error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for _, id := range ids {
+		rec, err := postFrontend.Get(id)
+		switch err {
+		case nil:
+		case pgx.ErrNoRows:
+			continue
+		default:
+			return err
+		}
+
+		if buf.Len() != 1 {
+			buf.WriteByte(',')
+		}
+		_, err = buf.ReadFrom(rec.Decompress())
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	_, err = buf.WriteTo(w)
+	return
+}
@@ -0,0 +1,257 @@
+package cache
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/recache/v6"
+)
+
+const (
+	// Maximum number of entries in tag and global feeds
+	feedSize = 50
+
+	// Maximum length of feed entry titles derived from post bodies
+	maxLenEntryTitle = 100
+)
+
+var (
+	// Atom feeds of thread posts. Keyed by thread ID.
+	threadFeedFrontend *recache.Frontend
+
+	// Atom feeds of the latest threads with a tag. Keyed by tag.
+	tagFeedFrontend *recache.Frontend
+
+	// Atom feed of the latest threads on the site
+	globalFeedFrontend *recache.Frontend
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Link      atomLink    `xml:"link"`
+	Author    atomAuthor  `xml:"author"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+func initFeeds() {
+	threadFeedFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		id := k.(uint64)
+		page, err := db.GetLastPage(id)
+		if err != nil {
+			return
+		}
+		t, err := bindThread(rw, threadKey{id, page})
+		if err != nil {
+			return
+		}
+
+		f := atomFeed{
+			ID:      threadURL(t.ID, 0),
+			Title:   t.Subject,
+			Updated: formatFeedTime(t.BumpedOn),
+			Link:    atomLink{threadURL(t.ID, 0)},
+			Author:  atomAuthor{"Anonymous"},
+			Entries: make([]atomEntry, 0, len(t.Posts)),
+		}
+		// Newest posts first
+		for i := len(t.Posts) - 1; i >= 0; i-- {
+			f.Entries = append(f.Entries, postEntry(t.Posts[i]))
+		}
+		return writeFeed(rw, f)
+	})
+
+	tagFeedFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		tag := k.(string)
+		link := fmt.Sprintf("%s/tags/%s", config.Get().RootURL, url.PathEscape(tag))
+		return writeThreadListFeed(
+			rw,
+			tagThreadIDFrontend,
+			tagIndexKey{
+				tag: tag,
+				indexKey: indexKey{
+					sort:  common.SortByCreationTime,
+					limit: feedSize,
+				},
+			},
+			atomFeed{
+				ID:     link,
+				Title:  "/" + tag + "/",
+				Link:   atomLink{link},
+				Author: atomAuthor{"Anonymous"},
+			},
+		)
+	})
+
+	globalFeedFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		link := config.Get().RootURL + "/"
+		return writeThreadListFeed(
+			rw,
+			threadIDFrontend,
+			indexKey{
+				sort:  common.SortByCreationTime,
+				limit: feedSize,
+			},
+			atomFeed{
+				ID:     link,
+				Title:  "Latest threads",
+				Link:   atomLink{link},
+				Author: atomAuthor{"Anonymous"},
+			},
+		)
+	})
+}
+
+// Write a feed with an entry for the OP of each thread listed in the record of
+// idFrontend under key k
+func writeThreadListFeed(
+	rw *recache.RecordWriter,
+	idFrontend *recache.Frontend,
+	k recache.Key,
+	f atomFeed,
+) (err error) {
+	threads, err := bindThreadList(rw, idFrontend, k)
+	if err != nil {
+		return
+	}
+
+	var updated int64
+	f.Entries = make([]atomEntry, 0, len(threads))
+	for _, t := range threads {
+		if len(t.Posts) == 0 {
+			continue
+		}
+
+		e := postEntry(t.Posts[0])
+		e.Title = t.Subject
+		e.Updated = formatFeedTime(t.BumpedOn)
+		f.Entries = append(f.Entries, e)
+
+		if t.BumpedOn > updated {
+			updated = t.BumpedOn
+		}
+	}
+	f.Updated = formatFeedTime(updated)
+
+	return writeFeed(rw, f)
+}
+
+// Create a feed entry from a post
+func postEntry(p common.Post) atomEntry {
+	text := common.BodyText(p.Body)
+	author := "Anonymous"
+	if p.Name != nil && *p.Name != "" {
+		author = *p.Name
+	}
+	link := threadURL(p.Thread, p.Page) + fmt.Sprintf("#p%d", p.ID)
+	created := formatFeedTime(p.CreatedOn)
+
+	return atomEntry{
+		ID:        link,
+		Title:     entryTitle(p.ID, text),
+		Published: created,
+		Updated:   created,
+		Link:      atomLink{link},
+		Author:    atomAuthor{author},
+		Content: atomContent{
+			Type: "text",
+			Body: text,
+		},
+	}
+}
+
+// Derive an entry title from the first line of the post text
+func entryTitle(id uint64, text string) string {
+	if i := strings.IndexByte(text, '\n'); i != -1 {
+		text = text[:i]
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fmt.Sprintf("#%d", id)
+	}
+	if utf8.RuneCountInString(text) > maxLenEntryTitle {
+		text = string([]rune(text)[:maxLenEntryTitle]) + "…"
+	}
+	return text
+}
+
+func threadURL(thread uint64, page uint32) string {
+	return fmt.Sprintf("%s/threads/%d/%d", config.Get().RootURL, thread, page)
+}
+
+func formatFeedTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+func writeFeed(rw *recache.RecordWriter, f atomFeed) (err error) {
+	_, err = rw.Write([]byte(xml.Header))
+	if err != nil {
+		return
+	}
+	return xml.NewEncoder(rw).Encode(f)
+}
+
+// Write Atom feed of a thread's latest posts to w
+func WriteThreadFeed(
+	w http.ResponseWriter, r *http.Request,
+	id uint64,
+) (err error) {
+	_, err = threadFeedFrontend.WriteHTTP(id, w, r)
+	return
+}
+
+// Write Atom feed of the latest threads with a tag to w
+func WriteTagFeed(w http.ResponseWriter, r *http.Request, tag string) (
+	err error,
+) {
+	_, err = tagFeedFrontend.WriteHTTP(tag, w, r)
+	return
+}
+
+// Write Atom feed of the latest threads to w
+func WriteGlobalFeed(w http.ResponseWriter, r *http.Request) (err error) {
+	_, err = globalFeedFrontend.WriteHTTP(struct{}{}, w, r)
+	return
+}
@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"net/http"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+	"github.com/bakape/recache/v6"
+)
+
+var (
+	// Server-side rendered thread pages. Keyed by threadKey.
+	threadHTMLFrontend *recache.Frontend
+
+	// Server-side rendered thread index pages. Keyed by page number.
+	indexHTMLFrontend *recache.Frontend
+
+	// Server-side rendered thread catalog of the most recently bumped threads
+	catalogHTMLFrontend *recache.Frontend
+)
+
+func initHTML() {
+	threadHTMLFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		t, err := bindThread(rw, k.(threadKey))
+		if err != nil {
+			return
+		}
+		templates.WriteThread(rw, *config.Get(), t)
+		return
+	})
+
+	indexHTMLFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		page := k.(uint)
+		threads, err := bindThreadList(rw, threadIDFrontend, indexKey{
+			sort:  common.SortByBumpTime,
+			page:  page,
+			limit: common.DefaultIndexPageSize,
+		})
+		if err != nil {
+			return
+		}
+		templates.WriteIndex(
+			rw,
+			*config.Get(),
+			threads,
+			page,
+			len(threads) < common.DefaultIndexPageSize,
+		)
+		return
+	})
+
+	catalogHTMLFrontend = cache.NewFrontend(func(
+		k recache.Key,
+		rw *recache.RecordWriter,
+	) (err error) {
+		threads, err := bindThreadList(rw, threadIDFrontend, indexKey{
+			sort:  common.SortByBumpTime,
+			limit: common.MaxIndexPageSize,
+		})
+		if err != nil {
+			return
+		}
+		templates.WriteCatalog(rw, *config.Get(), threads)
+		return
+	})
+}
+
+// Bind to a thread page record and decode it
+func bindThread(rw *recache.RecordWriter, k threadKey) (
+	t common.Thread,
+	err error,
+) {
+	s, err := rw.Bind(threadFrontend, k)
+	if err != nil {
+		return
+	}
+	err = json.NewDecoder(s.Decompress()).Decode(&t)
+	return
+}
+
+// Bind to the threads listed in the record of idFrontend under key k with
+// their last 5 posts and decode them
+func bindThreadList(
+	rw *recache.RecordWriter,
+	idFrontend *recache.Frontend,
+	k recache.Key,
+) (
+	threads []common.Thread,
+	err error,
+) {
+	var ids []uint64
+	s, err := rw.Bind(idFrontend, k)
+	if err != nil {
+		return
+	}
+	err = gob.NewDecoder(s.Decompress()).Decode(&ids)
+	if err != nil {
+		return
+	}
+
+	threads = make([]common.Thread, len(ids))
+	for i, id := range ids {
+		threads[i], err = bindThread(rw, threadKey{id, -5})
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Evict all server-side rendered HTML and other documents depending on the
+// global configuration
+func evictConfigDependant() {
+	threadHTMLFrontend.EvictAll(0)
+	indexHTMLFrontend.EvictAll(0)
+	catalogHTMLFrontend.EvictAll(0)
+	threadFeedFrontend.EvictAll(0)
+	tagFeedFrontend.EvictAll(0)
+	globalFeedFrontend.EvictAll(0)
+}
+
+// Write server-side rendered thread page HTML to w
+//
+// page: page of the thread to write; -1 to get the last page
+func WriteThreadHTML(
+	w http.ResponseWriter, r *http.Request,
+	id uint64,
+	page int,
+) (err error) {
+	if page == -1 {
+		page, err = db.GetLastPage(id)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = threadHTMLFrontend.WriteHTTP(threadKey{id, page}, w, r)
+	return
+}
+
+// Write server-side rendered thread index page HTML to w
+func WriteIndexHTML(w http.ResponseWriter, r *http.Request, page uint) (
+	err error,
+) {
+	_, err = indexHTMLFrontend.WriteHTTP(page, w, r)
+	return
+}
+
+// Write server-side rendered thread catalog HTML to w
+func WriteCatalogHTML(w http.ResponseWriter, r *http.Request) (err error) {
+	_, err = catalogHTMLFrontend.WriteHTTP(struct{}{}, w, r)
+	return
+}
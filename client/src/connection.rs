@@ -476,7 +476,8 @@ impl Connection {
 
 		loop {
 			use protocol::payloads::{
-				FeedData, HandshakeRes, ThreadCreationNotice,
+				FeedData, HandshakeRes, ModeratePost, OpenBodyReset,
+				ThreadCreationNotice,
 			};
 
 			match dec.peek_type() {
@@ -523,8 +524,9 @@ impl Connection {
 						// switching.
 					}
 					InsertThreadAck => |id: u64| {
-						state::Agent::dispatcher()
-							.send(state::Request::SetMine(id));
+						let mut d = state::Agent::dispatcher();
+						d.send(state::Request::SetMine(id));
+						d.send(state::Request::SetOpenPost(id));
 						state::navigate_to(state::Location{
 							feed: state::FeedID::Thread{
 								id,
@@ -537,6 +539,21 @@ impl Connection {
 						state::Agent::dispatcher()
 							.send(state::Request::InsertThread(n))
 					}
+					ReportAck => |_: u64| {
+						// TODO: Notify user of successful report
+					}
+					ModeratePost => |req: ModeratePost| {
+						state::Agent::dispatcher()
+							.send(state::Request::ModeratePost(req))
+					}
+					RemoveThread => |id: u64| {
+						state::Agent::dispatcher()
+							.send(state::Request::RemoveThread(id))
+					}
+					ResetOpenBody => |req: OpenBodyReset| {
+						state::Agent::dispatcher()
+							.send(state::Request::ResetOpenBody(req))
+					}
 				},
 				None => return Ok(()),
 			};
@@ -1,7 +1,10 @@
 use crate::{post::image_search::Provider, util};
 use protocol::{
 	debug_log,
-	payloads::{post_body::Node, Image, ThreadCreationNotice},
+	payloads::{
+		post_body::Node, Image, ModeratePost, OpenBodyReset, PostModeration,
+		ThreadCreationNotice,
+	},
 	util::{DoubleSetMap, SetMap},
 };
 use serde::{Deserialize, Serialize};
@@ -323,6 +326,13 @@ pub struct State {
 
 	// Optional flags and contents for creating new posts (including OPs)
 	pub new_post_opts: NewPostOpts,
+
+	// Post this user is currently editing
+	pub open_post: Option<OpenPostBody>,
+
+	// The last fetched feed included posts hidden by moderation, because the
+	// user is logged in as staff
+	pub sees_hidden: bool,
 }
 
 impl State {
@@ -349,6 +359,12 @@ pub struct Thread {
 	pub created_on: u32,
 	pub post_count: u64,
 	pub image_count: u64,
+
+	// Thread does not accept new replies
+	pub locked: bool,
+
+	// Thread is pinned to the top of the thread index
+	pub sticky: bool,
 }
 
 // Post data
@@ -368,6 +384,18 @@ pub struct Post {
 
 	pub body: Node,
 	pub image: Option<Image>,
+
+	// Moderation state. Only included in posts served to staff.
+	pub deleted: Option<bool>,
+	pub shadow_binned: Option<bool>,
+}
+
+// Text body of a post being edited by this user, as stored by the server.
+// Body modifications sent to the server must be computed against it.
+#[derive(Serialize, Deserialize, Debug, Default)]
+pub struct OpenPostBody {
+	pub id: u64,
+	pub body: String,
 }
 
 // Decodes thread data received from the server as JSON
@@ -415,6 +443,18 @@ pub enum Request {
 
 	// Set post as created by this user
 	SetMine(u64),
+
+	// Apply a moderation action to a post
+	ModeratePost(ModeratePost),
+
+	// Remove a thread deleted from the server along with its posts
+	RemoveThread(u64),
+
+	// Set the post this user is currently editing
+	SetOpenPost(u64),
+
+	// Replace the text body of the post being edited with the server's copy
+	ResetOpenBody(OpenBodyReset),
 }
 
 // Selective changes of global state to be notified on
@@ -441,6 +481,9 @@ pub enum Change {
 
 	// Subscribe to any changes to a post
 	Post(u64),
+
+	// Change of the post being edited or its text body
+	OpenPost,
 }
 
 // Abstraction over AgentLink and ComponentLink
@@ -765,6 +808,8 @@ impl yew::agent::Agent for Agent {
 							created_on: n.time,
 							post_count: 1,
 							image_count: 0,
+							locked: false,
+							sticky: false,
 						},
 					);
 					s.register_post(Post {
@@ -783,6 +828,112 @@ impl yew::agent::Agent for Agent {
 				// TODO: persist to DB
 				write(|s| s.mine.insert(id));
 			}
+			ModeratePost(req) => {
+				let thread = write(|s| {
+					let sees_hidden = s.sees_hidden;
+					let p = s.posts.get_mut(&req.post)?;
+					let thread = p.thread;
+					match req.action {
+						// Authors can still see their shadow-binned posts
+						PostModeration::ShadowBin
+							if s.mine.contains(&req.post) =>
+						{
+							return None;
+						}
+						// Thread OPs are never hidden
+						PostModeration::Purge if p.id == p.thread => {
+							p.body = Default::default();
+							p.image = None;
+						}
+						PostModeration::SpoilerImage => {
+							if let Some(img) = &mut p.image {
+								img.common.spoilered = true;
+							}
+						}
+						PostModeration::DeleteImage => {
+							p.image = None;
+						}
+						// Staff are still served hidden posts, so only mark
+						// them as hidden
+						PostModeration::Purge if sees_hidden => {
+							p.body = Default::default();
+							p.image = None;
+							p.deleted = Some(true);
+						}
+						PostModeration::Delete if sees_hidden => {
+							p.deleted = Some(true);
+						}
+						PostModeration::ShadowBin if sees_hidden => {
+							p.shadow_binned = Some(true);
+						}
+						_ => {
+							let p = s.posts.remove(&req.post)?;
+							s.posts_by_thread_page
+								.remove(&(p.thread, p.page), &p.id);
+						}
+					}
+					Some(thread)
+				});
+				if let Some(thread) = thread {
+					self.trigger(&Change::Thread(thread));
+					self.trigger(&Change::Post(req.post));
+				}
+			}
+			SetOpenPost(id) => {
+				write(|s| {
+					s.open_post = Some(OpenPostBody {
+						id,
+						body: Default::default(),
+					})
+				});
+				self.trigger(&Change::OpenPost);
+			}
+			ResetOpenBody(req) => {
+				let reset = write(|s| match &mut s.open_post {
+					Some(p) if p.id == req.post => {
+						p.body = req.body;
+						true
+					}
+					_ => false,
+				});
+				if reset {
+					self.trigger(&Change::OpenPost);
+				}
+			}
+			RemoveThread(id) => {
+				let (posts, synced) = write(|s| {
+					s.threads.remove(&id);
+					let posts: Vec<u64> = s
+						.posts
+						.values()
+						.filter(|p| p.thread == id)
+						.map(|p| p.id)
+						.collect();
+					for post in posts.iter() {
+						if let Some(p) = s.posts.remove(post) {
+							s.posts_by_thread_page
+								.remove(&(p.thread, p.page), &p.id);
+						}
+					}
+					(posts, s.location.feed.as_u64() == id)
+				});
+				self.trigger(&Change::ThreadList);
+				self.trigger(&Change::Thread(id));
+				for post in posts {
+					self.trigger(&Change::Post(post));
+				}
+
+				// Redirect clients viewing the deleted thread to the index
+				if synced {
+					self.set_location(
+						Location {
+							feed: FeedID::Index,
+							focus: None,
+						},
+						PUSH_STATE | SET_STATE,
+					);
+				}
+			}
 		};
 	}
 
@@ -995,6 +1146,7 @@ impl Agent {
 				s.threads.insert(t_id, t.thread_data);
 				for p in t.posts {
 					add_hook(Change::Post(p.id));
+					s.sees_hidden = p.deleted.is_some();
 					s.register_post(p);
 				}
 			}
@@ -23,6 +23,14 @@ var (
 	ErrInvalidCaptcha      = ErrInvalidInput("captcha")
 	ErrTooManyConnections  = ErrAccessDenied("too many connections")
 	ErrNoPermissions       = ErrAccessDenied("insufficient permissions")
+	ErrBanned              = ErrAccessDenied("you are banned")
+	ErrInvalidCreds        = ErrAccessDenied("invalid login credentials")
+	ErrNotLoggedIn         = ErrAccessDenied("not logged in")
+	ErrUserIDTaken         = ErrInvalidInput("user ID already taken")
+	ErrThreadLocked        = ErrAccessDenied("thread locked")
+	ErrImageBanned         = ErrAccessDenied("image banned")
+	ErrBodyFiltered        = ErrAccessDenied("post body rejected by filter")
+	ErrRateLimited         = StatusError{errors.New("try again later"), 429}
 )
 
 // StatusError is a simple error with HTTP status code attached
@@ -40,6 +48,8 @@ func (e StatusError) Error() string {
 		prefix = "access denied"
 	case 404:
 		prefix = "not found"
+	case 429:
+		prefix = "too many requests"
 	case 500:
 		prefix = "internal server error"
 	}
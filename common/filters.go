@@ -0,0 +1,71 @@
+package common
+
+import "time"
+
+var filterActionStr = [...]string{
+	"replace",
+	"reject",
+	"spam_score",
+	"report",
+}
+
+// FilterAction is the action taken, when a post body filter matches
+type FilterAction uint8
+
+// Returns string representation of the filter action
+func (a FilterAction) String() string {
+	return filterActionStr[a]
+}
+
+func (a FilterAction) MarshalText() (text []byte, err error) {
+	return []byte(filterActionStr[a]), nil
+}
+
+func (a *FilterAction) UnmarshalText(text []byte) error {
+	s := string(text)
+	for i, v := range filterActionStr {
+		if s == v {
+			*a = FilterAction(i)
+			return nil
+		}
+	}
+	return ErrInvalidEnum(s)
+}
+
+// All supported post body filter actions
+const (
+	// Replace matched text
+	FilterReplace FilterAction = iota
+
+	// Reject the body modification
+	FilterReject
+
+	// Add to the spam score of the author
+	FilterSpamScore
+
+	// Report the post to staff
+	FilterReport
+)
+
+// Rule matched against post bodies
+type Filter struct {
+	ID uint64 `json:"id"`
+
+	// Literal text matched case-insensitively or a regular expression
+	Pattern string `json:"pattern"`
+	IsRegex bool   `json:"is_regex"`
+
+	// Restricts the filter to threads with this tag. Empty for global filters.
+	Tag string `json:"tag"`
+
+	Action FilterAction `json:"action"`
+
+	// Matched text is replaced with this by FilterReplace filters
+	Replacement string `json:"replacement"`
+
+	// Added to the author's spam score by FilterSpamScore filters
+	SpamScore uint64 `json:"spam_score"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedOn time.Time `json:"created_on"`
+}
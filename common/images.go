@@ -204,4 +204,8 @@ type ImageCommon struct {
 	Title       *string  `json:"title"`
 	MD5         MD5Hash  `json:"md5"`
 	SHA1        SHA1Hash `json:"sha1"`
+
+	// Perceptual hash of the thumbnail. Nil, if the image has no thumbnail.
+	// Not exposed to clients.
+	DHash *int64 `json:"-"`
 }
@@ -19,6 +19,16 @@ var (
 		"meido_vision",
 		"purge_post",
 		"shadow_bin_post",
+		"unlock_thread",
+		"sticky_thread",
+		"unsticky_thread",
+		"ban_image",
+		"add_filter",
+		"remove_filter",
+		"accept_ban_appeal",
+		"reject_ban_appeal",
+		"appoint_tag_staff",
+		"remove_tag_staff",
 	}
 )
 
@@ -52,6 +62,16 @@ const (
 	MeidoVision
 	PurgePost
 	ShadowBinPost
+	UnlockThread
+	StickyThread
+	UnstickyThread
+	BanImage
+	AddFilter
+	RemoveFilter
+	AcceptBanAppeal
+	RejectBanAppeal
+	AppointTagStaff
+	RemoveTagStaff
 )
 
 // Contains fields of a post moderation log entry
@@ -68,21 +88,22 @@ type ModerationLevel int8
 
 // Returns string representation of moderation level
 func (l ModerationLevel) String() string {
-	if l < Janitor {
+	if l < Janitor || l > Admin {
 		return ""
 	}
-	return modLevelStr[int(l)]
+	// modLevelStr[0] is NotStaff
+	return modLevelStr[int(l)+1]
 }
 
-func (m ModerationLevel) MarshalText() (text []byte, err error) {
-	return []byte(modLevelStr[m]), nil
+func (l ModerationLevel) MarshalText() (text []byte, err error) {
+	return []byte(l.String()), nil
 }
 
-func (m *ModerationLevel) UnmarshalText(text []byte) error {
+func (l *ModerationLevel) UnmarshalText(text []byte) error {
 	s := string(text)
-	for i, a := range modActionStr {
+	for i, a := range modLevelStr {
 		if s == a {
-			*m = ModerationLevel(i)
+			*l = ModerationLevel(i - 1)
 			return nil
 		}
 	}
@@ -94,6 +115,10 @@ const (
 	NotStaff ModerationLevel = iota - 1
 	Janitor
 	Moderator
-	BoardOwner
+
+	// Owner of a tag or, if held as an account's global level, of all tags.
+	// Tag owners can appoint janitors of their tags.
+	TagOwner
+
 	Admin
 )
@@ -0,0 +1,118 @@
+package common
+
+import (
+	"encoding/json"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// Thread as encoded for public consumption by the encode(threads) SQL
+// function
+type Thread struct {
+	ID         uint64   `json:"id"`
+	PostCount  uint64   `json:"post_count"`
+	ImageCount uint64   `json:"image_count"`
+	Page       uint32   `json:"page"`
+	LastPage   uint32   `json:"last_page"`
+	CreatedOn  int64    `json:"created_on"`
+	BumpedOn   int64    `json:"bumped_on"`
+	Subject    string   `json:"subject"`
+	Tags       []string `json:"tags"`
+	Locked     bool     `json:"locked"`
+	Sticky     bool     `json:"sticky"`
+	Posts      []Post   `json:"posts"`
+}
+
+// Post as encoded for public consumption by the encode(posts) SQL function
+type Post struct {
+	Open      bool    `json:"open"`
+	Sage      bool    `json:"sage"`
+	Page      uint32  `json:"page"`
+	ID        uint64  `json:"id"`
+	Thread    uint64  `json:"thread"`
+	CreatedOn int64   `json:"created_on"`
+	Name      *string `json:"name"`
+	Trip      *string `json:"trip"`
+	Flag      *string `json:"flag"`
+	Image     *Image  `json:"image"`
+
+	// Text body as JSON AST
+	Body json.RawMessage `json:"body"`
+}
+
+// BodyText extracts all user-visible text from a post body JSON AST
+func BodyText(body []byte) string {
+	var (
+		node interface{}
+		w    strings.Builder
+	)
+	if json.Unmarshal(body, &node) != nil {
+		return ""
+	}
+	writeBodyText(&w, node)
+	return strings.TrimSpace(w.String())
+}
+
+func writeBodyText(w *strings.Builder, node interface{}) {
+	switch n := node.(type) {
+	case string:
+		if n == "NewLine" {
+			w.WriteByte('\n')
+		}
+	case []interface{}:
+		for _, ch := range n {
+			writeBodyText(w, ch)
+		}
+	case map[string]interface{}:
+		for k, v := range n {
+			switch k {
+			case "Text", "URL":
+				if s, ok := v.(string); ok {
+					w.WriteString(s)
+				}
+			case "Code":
+				if s, ok := v.(string); ok {
+					writeCodeText(w, s)
+				}
+			case "Reference":
+				if m, ok := v.(map[string]interface{}); ok {
+					if s, ok := m["label"].(string); ok {
+						w.WriteString(s)
+					}
+				}
+			case "PostLink":
+				if m, ok := v.(map[string]interface{}); ok {
+					if id, ok := m["id"].(float64); ok {
+						w.WriteString(">>")
+						w.WriteString(strconv.FormatUint(uint64(id), 10))
+					}
+				}
+			case "Siblings", "Spoiler", "Bold", "Italic":
+				writeBodyText(w, v)
+			case "Quoted":
+				w.WriteByte('>')
+				writeBodyText(w, v)
+			}
+		}
+	}
+}
+
+// Code nodes contain syntax highlighted HTML. Write only the text.
+func writeCodeText(w *strings.Builder, code string) {
+	var (
+		text  strings.Builder
+		inTag bool
+	)
+	for _, r := range code {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			text.WriteRune(r)
+		}
+	}
+	w.WriteString(html.UnescapeString(text.String()))
+}
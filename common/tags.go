@@ -0,0 +1,69 @@
+package common
+
+var (
+	tagStatsPeriodStr = [...]string{
+		"1h",
+		"24h",
+		"7d",
+	}
+	tagSortModeStr = [...]string{
+		"trending",
+		"threads",
+		"posts",
+		"posters",
+		"activity",
+	}
+)
+
+// TagStatsPeriod specifies the time window tag statistics are aggregated over
+type TagStatsPeriod uint8
+
+func (p TagStatsPeriod) MarshalText() (text []byte, err error) {
+	return []byte(tagStatsPeriodStr[p]), nil
+}
+
+func (p *TagStatsPeriod) UnmarshalText(text []byte) error {
+	s := string(text)
+	for i, a := range tagStatsPeriodStr {
+		if s == a {
+			*p = TagStatsPeriod(i)
+			return nil
+		}
+	}
+	return ErrInvalidEnum(s)
+}
+
+// All supported tag statistics time windows
+const (
+	TagStatsHour TagStatsPeriod = iota
+	TagStatsDay
+	TagStatsWeek
+)
+
+// TagSortMode specifies the order tag statistics are listed in
+type TagSortMode uint8
+
+func (m TagSortMode) MarshalText() (text []byte, err error) {
+	return []byte(tagSortModeStr[m]), nil
+}
+
+func (m *TagSortMode) UnmarshalText(text []byte) error {
+	s := string(text)
+	for i, a := range tagSortModeStr {
+		if s == a {
+			*m = TagSortMode(i)
+			return nil
+		}
+	}
+	return ErrInvalidEnum(s)
+}
+
+// All supported tag statistics sort modes. All sort in descending order.
+const (
+	// Sort by unique posters and then post count
+	SortTagsByTrending TagSortMode = iota
+	SortTagsByThreadCount
+	SortTagsByPostCount
+	SortTagsByUniquePosters
+	SortTagsByLastActivity
+)
@@ -0,0 +1,42 @@
+package common
+
+var (
+	threadSortModeStr = [...]string{
+		"bump",
+		"creation",
+		"replies",
+		"images",
+	}
+)
+
+// ThreadSortMode specifies the order threads are listed in thread indexes
+type ThreadSortMode uint8
+
+func (m ThreadSortMode) MarshalText() (text []byte, err error) {
+	return []byte(threadSortModeStr[m]), nil
+}
+
+func (m *ThreadSortMode) UnmarshalText(text []byte) error {
+	s := string(text)
+	for i, a := range threadSortModeStr {
+		if s == a {
+			*m = ThreadSortMode(i)
+			return nil
+		}
+	}
+	return ErrInvalidEnum(s)
+}
+
+// All supported thread sort modes. All sort in descending order.
+const (
+	SortByBumpTime ThreadSortMode = iota
+	SortByCreationTime
+	SortByReplyCount
+	SortByImageCount
+)
+
+// Size limits of thread index and search result pages
+const (
+	DefaultIndexPageSize = 50
+	MaxIndexPageSize     = 100
+)
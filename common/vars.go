@@ -37,6 +37,7 @@ const (
 	MaxLenRules        = 5000
 	MaxLenEightball    = 2000
 	MaxLenReason       = 100
+	MaxLenTag          = 20
 	MaxNumBanners      = 20
 	MaxAssetSize       = 100 << 10
 	MaxDiceSides       = 10000
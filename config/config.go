@@ -36,7 +36,9 @@ var (
 			Char:         170,
 			PostCreation: 15000,
 			Image:        15000,
+			Report:       30000,
 		},
+		BannedImageDistance: 6,
 		CaptchaTags: []string{
 			"patchouli_knowledge",
 			"cirno",
@@ -5,6 +5,7 @@ type SpamScores struct {
 	Char         uint64 `json:"character"`
 	Image        uint64 `json:"image"`
 	PostCreation uint64 `json:"post_creation"`
+	Report       uint64 `json:"report"`
 }
 
 // Configs stores the global server configuration
@@ -24,6 +25,11 @@ type Configs struct {
 	FAQ            string
 	CaptchaTags    []string   `json:"captcha_tags"`
 	SpamScores     SpamScores `json:"spam_scores"`
+
+	// Maximum Hamming distance between the perceptual hashes of an uploaded
+	// and a banned image for the upload to be rejected. 0 disables perceptual
+	// matching.
+	BannedImageDistance uint8 `json:"banned_image_distance"`
 }
 
 // Public contains configurations exposeable through public availability APIs
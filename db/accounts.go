@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// Register a staff account with a bcrypt password hash and moderation level
+func RegisterAccount(
+	ctx context.Context,
+	id string,
+	hash []byte,
+	level common.ModerationLevel,
+) (err error) {
+	_, err = db.Exec(
+		ctx,
+		`insert into accounts (id, password, level)
+		values ($1, $2, $3)`,
+		id,
+		hash,
+		int16(level),
+	)
+	if IsConflictError(err) {
+		err = common.ErrUserIDTaken
+	}
+	return
+}
+
+// Get the bcrypt password hash of an account
+func GetPassword(ctx context.Context, id string) (hash []byte, err error) {
+	err = db.
+		QueryRow(
+			ctx,
+			`select password from accounts where id = $1`,
+			id,
+		).
+		Scan(&hash)
+	return
+}
+
+// Change the password hash of an account and log out all of its sessions
+func ChangePassword(ctx context.Context, id string, hash []byte) error {
+	return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		_, err = tx.Exec(
+			ctx,
+			`update accounts set password = $2 where id = $1`,
+			id,
+			hash,
+		)
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(ctx, `delete from sessions where account = $1`, id)
+		return
+	})
+}
+
+// Set the moderation level of an account
+func SetAccountLevel(
+	ctx context.Context,
+	id string,
+	level common.ModerationLevel,
+) (err error) {
+	c, err := db.Exec(
+		ctx,
+		`update accounts set level = $2 where id = $1`,
+		id,
+		int16(level),
+	)
+	if err != nil {
+		return
+	}
+	if c.RowsAffected() == 0 {
+		err = pgx.ErrNoRows
+	}
+	return
+}
+
+// Write a new login session of an account
+func WriteSession(ctx context.Context, account, token string) (err error) {
+	_, err = db.Exec(
+		ctx,
+		`insert into sessions (account, token, expires)
+		values ($1, $2, $3)`,
+		account,
+		token,
+		time.Now().Add(auth.SessionExpiry),
+	)
+	return
+}
+
+// Get the account and its moderation level of an active login session.
+// Returns pgx.ErrNoRows, if no such session exists.
+func GetSession(ctx context.Context, token string) (s auth.Session, err error) {
+	var level int16
+	err = db.
+		QueryRow(
+			ctx,
+			`select a.id, a.level
+			from sessions s
+			join accounts a on a.id = s.account
+			where s.token = $1 and s.expires > now()`,
+			token,
+		).
+		Scan(&s.UserID, &level)
+	s.Level = common.ModerationLevel(level)
+	return
+}
+
+// Log out a single session of an account
+func LogOut(ctx context.Context, token string) (err error) {
+	_, err = db.Exec(ctx, `delete from sessions where token = $1`, token)
+	return
+}
+
+// Log out all sessions of an account
+func LogOutAll(ctx context.Context, account string) (err error) {
+	_, err = db.Exec(ctx, `delete from sessions where account = $1`, account)
+	return
+}
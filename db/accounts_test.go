@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestAccounts(t *testing.T) {
+	t.Parallel()
+
+	const id = "accounts_test"
+	ctx := context.Background()
+
+	err := RegisterAccount(ctx, id, []byte("hash"), common.Moderator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("duplicate", func(t *testing.T) {
+		err := RegisterAccount(ctx, id, []byte("hash"), common.Janitor)
+		test.AssertEquals(t, err, common.ErrUserIDTaken)
+	})
+
+	t.Run("get password", func(t *testing.T) {
+		hash, err := GetPassword(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, string(hash), "hash")
+	})
+
+	token, err := auth.NewSessionToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteSession(ctx, id, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("get session", func(t *testing.T) {
+		s, err := GetSession(ctx, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, s, auth.Session{
+			UserID: id,
+			Level:  common.Moderator,
+		})
+	})
+
+	t.Run("set level", func(t *testing.T) {
+		err := SetAccountLevel(ctx, id, common.Admin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := GetSession(ctx, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, s.Level, common.Admin)
+
+		err = SetAccountLevel(ctx, "nonexistent", common.Admin)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("change password", func(t *testing.T) {
+		err := ChangePassword(ctx, id, []byte("new hash"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = GetSession(ctx, token)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+}
@@ -0,0 +1,203 @@
+package db
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Appeal an active ban matching an IP or a public key.
+// Either can be a zero value to only match by the other.
+// Returns the ID of the created appeal.
+//
+// Returns pgx.ErrNoRows, if no such ban matches the requester.
+func InsertBanAppeal(
+	ctx context.Context,
+	ban uint64,
+	ip net.IP,
+	pubKey uint64,
+	body string,
+) (id uint64, err error) {
+	err = db.
+		QueryRow(
+			ctx,
+			`insert into ban_appeals (ban, body)
+			select id, $4
+			from bans
+			where id = $3 and `+matchBans+`
+			on conflict (ban) do nothing
+			returning id`,
+			ipArg(ip),
+			pubKey,
+			ban,
+			body,
+		).
+		Scan(&id)
+	if err == pgx.ErrNoRows {
+		// Either the ban does not match or was already appealed
+		var appealed bool
+		err = db.
+			QueryRow(
+				ctx,
+				`select exists (
+					select
+					from ban_appeals a
+					join bans on bans.id = a.ban
+					where a.ban = $3 and `+matchBans+`
+				)`,
+				ipArg(ip),
+				pubKey,
+				ban,
+			).
+			Scan(&appealed)
+		if err == nil {
+			if appealed {
+				err = common.ErrInvalidInput("ban already appealed")
+			} else {
+				err = pgx.ErrNoRows
+			}
+		}
+	}
+	return
+}
+
+// Return the creation time of the appeal of a ban and, if the appeal was
+// reviewed, if it was accepted.
+//
+// Returns pgx.ErrNoRows, if the ban was not appealed.
+func GetBanAppealState(ctx context.Context, ban uint64) (
+	created time.Time,
+	accepted *bool,
+	err error,
+) {
+	var acc pgtype.Bool
+	err = db.
+		QueryRow(
+			ctx,
+			`select created_on, accepted
+			from ban_appeals
+			where ban = $1`,
+			ban,
+		).
+		Scan(&created, &acc)
+	if err != nil {
+		return
+	}
+	if acc.Status == pgtype.Present {
+		accepted = &acc.Bool
+	}
+	return
+}
+
+// Get all unreviewed appeals of active bans with the oldest coming first
+func GetBanAppealQueue(ctx context.Context) (
+	appeals []auth.BanAppeal,
+	err error,
+) {
+	r, err := db.Query(
+		ctx,
+		`select `+banColumns+`, a.id, a.body, a.created_on
+		from ban_appeals a
+		join bans on bans.id = a.ban
+		where a.reviewed_on is null and bans.expires > now()
+		order by a.id`,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	appeals = make([]auth.BanAppeal, 0)
+	for r.Next() {
+		var a auth.BanAppeal
+		err = scanBan(r, &a.Ban, &a.ID, &a.Body, &a.Created)
+		if err != nil {
+			return
+		}
+		appeals = append(appeals, a)
+	}
+	err = r.Err()
+	return
+}
+
+// Accept or reject a ban appeal on behalf of a staff member. Accepting an
+// appeal lifts the appealed ban.
+func ReviewBanAppeal(
+	ctx context.Context,
+	id uint64,
+	accept bool,
+	by string,
+) (err error) {
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var (
+			ban, forPost pgtype.Int8
+			reason       string
+		)
+		err = tx.
+			QueryRow(
+				ctx,
+				`update ban_appeals as a
+				set reviewed_by = $2,
+					reviewed_on = now(),
+					accepted = $3
+				from bans b
+				where a.id = $1 and b.id = a.ban and a.reviewed_on is null
+				returning b.id, b.for_post, b.reason`,
+				id,
+				by,
+				accept,
+			).
+			Scan(&ban, &forPost, &reason)
+		switch err {
+		case nil:
+		case pgx.ErrNoRows:
+			// Discern nonexistent appeals from already reviewed ones and
+			// appeals of lifted or expired bans
+			var exists bool
+			err = tx.
+				QueryRow(
+					ctx,
+					`select exists (select from ban_appeals where id = $1)`,
+					id,
+				).
+				Scan(&exists)
+			if err == nil {
+				if exists {
+					err = common.ErrInvalidInput("appeal already closed")
+				} else {
+					err = pgx.ErrNoRows
+				}
+			}
+			return
+		default:
+			return
+		}
+
+		typ := common.RejectBanAppeal
+		if accept {
+			typ = common.AcceptBanAppeal
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: typ,
+				By:   by,
+				Data: reason,
+			},
+			Post: uint64(forPost.Int),
+		})
+		if err != nil || !accept {
+			return
+		}
+		return liftBan(ctx, tx, uint64(ban.Int), by)
+	})
+	if err != nil || !accept {
+		return
+	}
+	bans.reloadAfterCommit()
+	return
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestBanAppeals(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		pubKey, _   = insertSamplePubKey(t)
+		otherKey, _ = insertSamplePubKey(t)
+		bans        [2]uint64
+		appeals     [2]uint64
+	)
+	for i := range bans {
+		var err error
+		bans[i], err = Ban(ctx, auth.BanRecord{
+			Ban: auth.Ban{
+				PublicKey: pubKey,
+			},
+			Reason:  "test",
+			By:      "admin",
+			Expires: time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("appeal", func(t *testing.T) {
+		for i, ban := range bans {
+			var err error
+			appeals[i], err = InsertBanAppeal(ctx, ban, nil, pubKey, "sorry")
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		_, accepted, err := GetBanAppealState(ctx, bans[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, accepted, (*bool)(nil))
+	})
+
+	t.Run("appeal twice", func(t *testing.T) {
+		_, err := InsertBanAppeal(ctx, bans[0], nil, pubKey, "sorry")
+		test.AssertEquals(t, err, common.ErrInvalidInput("ban already appealed"))
+	})
+
+	t.Run("appeal other's ban", func(t *testing.T) {
+		_, err := InsertBanAppeal(ctx, bans[0], nil, otherKey, "sorry")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+
+		_, err = InsertBanAppeal(ctx, 1<<40, nil, otherKey, "sorry")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		queue, err := GetBanAppealQueue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var found int
+		for _, a := range queue {
+			for _, id := range appeals {
+				if a.ID == id {
+					found++
+					test.AssertEquals(t, a.Body, "sorry")
+					test.AssertEquals(t, a.Ban.PublicKey, pubKey)
+				}
+			}
+		}
+		test.AssertEquals(t, found, len(appeals))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		err := ReviewBanAppeal(ctx, appeals[0], false, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, accepted, err := GetBanAppealState(ctx, bans[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, *accepted, false)
+
+		err = ReviewBanAppeal(ctx, appeals[0], true, "admin")
+		test.AssertEquals(t, err, common.ErrInvalidInput("appeal already closed"))
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		err := ReviewBanAppeal(ctx, appeals[1], true, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := GetBan(ctx, nil, pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, b.ID, bans[0])
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		for _, typ := range [...]common.ModerationAction{
+			common.AcceptBanAppeal,
+			common.RejectBanAppeal,
+		} {
+			typ := typ
+			entries, err := GetModLog(ctx, ModLogFilter{
+				Type: &typ,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, len(entries), 1)
+		}
+	})
+
+	t.Run("nonexistent", func(t *testing.T) {
+		err := ReviewBanAppeal(ctx, 1<<40, true, "admin")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bakape/pg_util"
+	"github.com/go-playground/log"
+	"github.com/jackc/pgtype"
+)
+
+// In-memory matcher of active bans for cheap checks on the hot path.
+// Kept in sync with the database through "bans.updated" notifications.
+var bans = banMatcher{
+	keys: make(map[uint64]time.Time),
+}
+
+type banMatcher struct {
+	mu     sync.RWMutex
+	ranges []bannedRange
+	keys   map[uint64]time.Time // Zero time for permanent bans
+}
+
+type bannedRange struct {
+	net.IPNet
+	expires time.Time // Zero time for permanent bans
+}
+
+// Returns, if an expiry time is still in the future
+func isActive(expires, now time.Time) bool {
+	return expires.IsZero() || expires.After(now)
+}
+
+// Returns, if an IP or public key is matched by an active ban
+func (m *banMatcher) match(ip net.IP, pubKey uint64) bool {
+	now := time.Now()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if pubKey != 0 {
+		if exp, ok := m.keys[pubKey]; ok && isActive(exp, now) {
+			return true
+		}
+	}
+	if ip != nil {
+		for _, r := range m.ranges {
+			if r.Contains(ip) && isActive(r.expires, now) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Replace matcher contents with all active bans from the database
+func (m *banMatcher) reload() (err error) {
+	var (
+		ctx     = context.Background()
+		ranges  []bannedRange
+		keys    = make(map[uint64]time.Time)
+		expires pgtype.Timestamptz
+	)
+
+	convertExpiry := func() time.Time {
+		if expires.InfinityModifier != pgtype.None {
+			return time.Time{}
+		}
+		return expires.Time
+	}
+
+	r, err := db.Query(
+		ctx,
+		`select ip, expires
+		from bans
+		where ip is not null and expires > now()`,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	for r.Next() {
+		var inet pgtype.Inet
+		err = r.Scan(&inet, &expires)
+		if err != nil {
+			return
+		}
+		ranges = append(ranges, bannedRange{
+			IPNet:   *inet.IPNet,
+			expires: convertExpiry(),
+		})
+	}
+	err = r.Err()
+	if err != nil {
+		return
+	}
+
+	// Directly banned public keys and all keys seen from IP ranges with all
+	// keys banned
+	r, err = db.Query(
+		ctx,
+		`select public_key, expires
+		from bans
+		where public_key is not null and expires > now()
+		union all
+		select k.public_key, b.expires
+		from bans b
+		join public_key_ips k on b.ip >>= k.ip
+		where b.all_keys and b.expires > now()`,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	for r.Next() {
+		var pubKey uint64
+		err = r.Scan(&pubKey, &expires)
+		if err != nil {
+			return
+		}
+
+		// Keep the longest lasting ban
+		exp := convertExpiry()
+		if prev, ok := keys[pubKey]; ok {
+			if prev.IsZero() || (!exp.IsZero() && prev.After(exp)) {
+				continue
+			}
+		}
+		keys[pubKey] = exp
+	}
+	err = r.Err()
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	m.ranges = ranges
+	m.keys = keys
+	m.mu.Unlock()
+	return
+}
+
+// Reload the matcher after committing a change to bans, so it takes effect in
+// this process without waiting for the notification round trip. Errors are
+// only logged, as the change itself has already succeeded and the
+// notification reloads the matcher again.
+func (m *banMatcher) reloadAfterCommit() {
+	err := m.reload()
+	if err != nil {
+		log.Errorf("reloading bans: %s", err)
+	}
+}
+
+// Load active bans and update on each change
+func loadBans() (err error) {
+	err = bans.reload()
+	if err != nil {
+		return
+	}
+
+	return Listen(pg_util.ListenOpts{
+		Channel: "bans.updated",
+		OnMsg: func(_ string) error {
+			err := bans.reload()
+			if err != nil {
+				return fmt.Errorf("reloading bans: %w", err)
+			}
+			return nil
+		},
+	})
+}
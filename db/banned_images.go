@@ -0,0 +1,278 @@
+package db
+
+import (
+	"context"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// Request to ban images by their hashes
+type ImageBanRequest struct {
+	SHA1 []common.SHA1Hash
+	MD5  []common.MD5Hash
+
+	// Reason for the ban
+	Reason string
+
+	// Also purge all posts currently using any of the banned images
+	Purge bool
+}
+
+// Return, if an image is banned by either of its hashes.
+// If md5 is nil, the MD5 hash of an already stored image is matched, if any.
+//
+// maxDistance: also match already stored images, whose perceptual hash is
+// within this Hamming distance of a banned image's; 0 to disable
+func IsImageBanned(
+	ctx context.Context,
+	sha1 common.SHA1Hash,
+	md5 *common.MD5Hash,
+	maxDistance uint8,
+) (banned bool, err error) {
+	var md5Arg []byte
+	if md5 != nil {
+		md5Arg = md5[:]
+	}
+	err = db.
+		QueryRow(
+			ctx,
+			`select exists (
+				select
+				from banned_images b
+				where b.sha1 = $1
+					or b.md5 = coalesce(
+						$2::bytea,
+						(select i.md5 from images i where i.sha1 = $1)
+					)
+					or (
+						$3 != 0
+						and hamming_distance(
+							b.dhash,
+							(select i.dhash from images i where i.sha1 = $1)
+						) <= $3
+					)
+			)`,
+			sha1[:],
+			md5Arg,
+			int(maxDistance),
+		).
+		Scan(&banned)
+	return
+}
+
+// Return, if a perceptual hash is within maxDistance Hamming distance of the
+// perceptual hash of any banned image
+func IsSimilarImageBanned(
+	ctx context.Context,
+	dhash int64,
+	maxDistance uint8,
+) (banned bool, err error) {
+	err = db.
+		QueryRow(
+			ctx,
+			`select exists (
+				select
+				from banned_images
+				where hamming_distance(dhash, $1) <= $2
+			)`,
+			dhash,
+			int(maxDistance),
+		).
+		Scan(&banned)
+	return
+}
+
+// Ban the image of a post on behalf of a staff member by both of its hashes.
+// Returns the IDs of purged posts.
+func BanPostImage(
+	ctx context.Context,
+	post uint64,
+	by, reason string,
+	purge bool,
+) (purged []uint64, err error) {
+	var (
+		sha1 common.SHA1Hash
+		md5  common.MD5Hash
+	)
+	err = db.
+		QueryRow(
+			ctx,
+			`select i.sha1, i.md5
+			from posts p
+			join images i on i.sha1 = p.image
+			where p.id = $1`,
+			post,
+		).
+		Scan(&sha1, &md5)
+	switch err {
+	case nil:
+	case pgx.ErrNoRows:
+		// Discern nonexistent posts from posts without images
+		var exists bool
+		err = db.
+			QueryRow(
+				ctx,
+				`select exists (select from posts where id = $1)`,
+				post,
+			).
+			Scan(&exists)
+		if err == nil {
+			if exists {
+				err = common.ErrInvalidInput("post has no image")
+			} else {
+				err = pgx.ErrNoRows
+			}
+		}
+		return
+	default:
+		return
+	}
+
+	return BanImages(ctx, by, ImageBanRequest{
+		SHA1:   []common.SHA1Hash{sha1},
+		MD5:    []common.MD5Hash{md5},
+		Reason: reason,
+		Purge:  purge,
+	})
+}
+
+// Ban images by their hashes on behalf of a staff member. Already banned
+// hashes are ignored. The perceptual hashes of already stored images are
+// recorded for matching similar images.
+// Returns the IDs of purged posts.
+func BanImages(ctx context.Context, by string, req ImageBanRequest) (
+	purged []uint64,
+	err error,
+) {
+	sha1s := make([][]byte, len(req.SHA1))
+	for i := range req.SHA1 {
+		sha1s[i] = req.SHA1[i][:]
+	}
+	md5s := make([][]byte, len(req.MD5))
+	for i := range req.MD5 {
+		md5s[i] = req.MD5[i][:]
+	}
+
+	var imgs [][]byte
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		r, err := tx.Query(
+			ctx,
+			`insert into banned_images (sha1, md5, reason, staff, dhash)
+			select
+				s,
+				null::bytea,
+				$3,
+				$4,
+				(select i.dhash from images i where i.sha1 = s)
+			from unnest($1::bytea[]) s
+
+			union all
+
+			select
+				null::bytea,
+				m,
+				$3,
+				$4,
+				(select i.dhash from images i where i.md5 = m limit 1)
+			from unnest($2::bytea[]) m
+
+			on conflict do nothing
+			returning encode(coalesce(sha1, md5), 'hex')`,
+			sha1s,
+			md5s,
+			req.Reason,
+			by,
+		)
+		if err != nil {
+			return
+		}
+		var banned []string
+		for r.Next() {
+			var hash string
+			err = r.Scan(&hash)
+			if err != nil {
+				r.Close()
+				return
+			}
+			banned = append(banned, hash)
+		}
+		err = r.Err()
+		if err != nil {
+			return
+		}
+
+		for _, hash := range banned {
+			_, err = logModAction(ctx, tx, auth.ModLogEntry{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.BanImage,
+					By:   by,
+					Data: hash,
+				},
+			})
+			if err != nil {
+				return
+			}
+		}
+
+		if !req.Purge {
+			return
+		}
+		r, err = tx.Query(
+			ctx,
+			`select p.id
+			from posts p
+			join images i on i.sha1 = p.image
+			where i.sha1 = any($1::bytea[]) or i.md5 = any($2::bytea[])
+			order by p.id`,
+			sha1s,
+			md5s,
+		)
+		if err != nil {
+			return
+		}
+		for r.Next() {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				r.Close()
+				return
+			}
+			purged = append(purged, id)
+		}
+		err = r.Err()
+		if err != nil {
+			return
+		}
+
+		for _, id := range purged {
+			var img []byte
+			_, img, err = moderatePost(
+				ctx,
+				tx,
+				id,
+				by,
+				common.PurgePost,
+				req.Reason,
+			)
+			if err != nil {
+				return
+			}
+			imgs = append(imgs, img)
+		}
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	// Duplicates are simply not found on subsequent calls
+	for _, img := range imgs {
+		err = deleteImageIfUnused(ctx, img)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
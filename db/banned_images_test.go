@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestBanImages(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		thread, pubKey = insertSampleThread(t)
+		sha1           common.SHA1Hash
+		md5            common.MD5Hash
+	)
+	img, _, close := prepareSampleImage(t)
+	defer close()
+	copy(sha1[:], test.GenBuf(20))
+	copy(md5[:], test.GenBuf(16))
+
+	assertBanned := func(
+		t *testing.T,
+		sha1 common.SHA1Hash,
+		md5 *common.MD5Hash,
+		std bool,
+	) {
+		t.Helper()
+
+		banned, err := IsImageBanned(ctx, sha1, md5, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, banned, std)
+	}
+
+	t.Run("by hashes", func(t *testing.T) {
+		assertBanned(t, sha1, nil, false)
+
+		purged, err := BanImages(ctx, "admin", ImageBanRequest{
+			SHA1:   []common.SHA1Hash{sha1},
+			MD5:    []common.MD5Hash{md5},
+			Reason: "spam",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(purged), 0)
+
+		var other common.SHA1Hash
+		copy(other[:], test.GenBuf(20))
+		assertBanned(t, sha1, nil, true)
+		assertBanned(t, other, &md5, true)
+		assertBanned(t, other, nil, false)
+
+		// Duplicate bans are ignored
+		_, err = BanImages(ctx, "admin", ImageBanRequest{
+			SHA1:   []common.SHA1Hash{sha1},
+			Reason: "spam",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("by post", func(t *testing.T) {
+		err := InTransaction(ctx, func(tx pgx.Tx) (err error) {
+			_, _, err = InsertImage(ctx, tx, pubKey, img.SHA1, "spam", false)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertBanned(t, img.SHA1, nil, false)
+
+		purged, err := BanPostImage(ctx, thread, "admin", "spam", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, purged, []uint64{thread})
+		assertNoImage(t, img.SHA1)
+
+		var other common.SHA1Hash
+		copy(other[:], test.GenBuf(20))
+		assertBanned(t, img.SHA1, nil, true)
+		assertBanned(t, other, &img.MD5, true)
+
+		_, err = BanPostImage(ctx, thread, "admin", "spam", true)
+		test.AssertEquals(t, err, common.ErrInvalidInput("post has no image"))
+	})
+}
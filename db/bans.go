@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Matches active bans against an IP in $1 and a public key in $2
+const matchBans = `expires > now()
+	and (
+		ip >>= $1
+		or public_key = $2
+		or (
+			all_keys
+			and exists (
+				select
+				from public_key_ips k
+				where k.public_key = $2 and bans.ip >>= k.ip
+			)
+		)
+	)`
+
+// Convert an optional IP to a query argument
+func ipArg(ip net.IP) interface{} {
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		// Prevent IPv4 addresses being stored as IPv4-mapped IPv6 addresses
+		ip = ip4
+	}
+	return ip
+}
+
+// Convert an optional IP range to a query argument
+func ipRangeArg(r *auth.IPRange) interface{} {
+	if r == nil {
+		return nil
+	}
+	return &r.IPNet
+}
+
+// Convert an optional time to a query argument. Zero time is converted to
+// infinity.
+func expiryArg(t time.Time) pgtype.Timestamptz {
+	if t.IsZero() {
+		return pgtype.Timestamptz{
+			Status:           pgtype.Present,
+			InfinityModifier: pgtype.Infinity,
+		}
+	}
+	return pgtype.Timestamptz{
+		Time:   t,
+		Status: pgtype.Present,
+	}
+}
+
+// Ban an IP range, a public key or both.
+// A zero b.Expires issues a permanent ban.
+// Returns the ID of the created ban.
+func Ban(ctx context.Context, b auth.BanRecord) (id uint64, err error) {
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		id, _, err = insertBan(ctx, tx, b)
+		return
+	})
+	if err != nil {
+		return
+	}
+	bans.reloadAfterCommit()
+	return
+}
+
+// Insert a ban and log it to the moderation log.
+// Returns the IDs of the ban and the log entry.
+func insertBan(ctx context.Context, tx pgx.Tx, b auth.BanRecord) (
+	id, logID uint64,
+	err error,
+) {
+	err = tx.
+		QueryRow(
+			ctx,
+			`insert into bans (
+				ip, public_key, all_keys, for_post, reason, banned_by, expires
+			)
+			values ($1, nullif($2, 0), $3, nullif($4, 0), $5, $6, $7)
+			returning id`,
+			ipRangeArg(b.IP),
+			b.PublicKey,
+			b.AllKeys,
+			b.ForPost,
+			b.Reason,
+			b.By,
+			expiryArg(b.Expires),
+		).
+		Scan(&id)
+	if err != nil {
+		return
+	}
+
+	var length uint64
+	if !b.Expires.IsZero() {
+		length = uint64(time.Until(b.Expires) / time.Second)
+	}
+	logID, err = logModAction(ctx, tx, auth.ModLogEntry{
+		ModerationEntry: common.ModerationEntry{
+			Type:   common.BanPost,
+			Length: length,
+			By:     b.By,
+			Data:   b.Reason,
+		},
+		Post: b.ForPost,
+		Ban:  &b.Ban,
+	})
+	return
+}
+
+// Lift a ban by its ID on behalf of a staff member
+func LiftBan(ctx context.Context, id uint64, by string) (err error) {
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		return liftBan(ctx, tx, id, by)
+	})
+	if err != nil {
+		return
+	}
+	bans.reloadAfterCommit()
+	return
+}
+
+func liftBan(ctx context.Context, tx pgx.Tx, id uint64, by string) (
+	err error,
+) {
+	var b auth.BanRecord
+	err = scanBan(
+		tx.QueryRow(
+			ctx,
+			`delete from bans
+			where id = $1
+			returning `+banColumns,
+			id,
+		),
+		&b,
+	)
+	if err != nil {
+		return
+	}
+	_, err = logModAction(ctx, tx, auth.ModLogEntry{
+		ModerationEntry: common.ModerationEntry{
+			Type: common.UnbanPost,
+			By:   by,
+			Data: b.Reason,
+		},
+		Post: b.ForPost,
+		Ban:  &b.Ban,
+	})
+	return
+}
+
+// Columns of the bans table read by scanBan
+const banColumns = `bans.id, bans.ip, bans.public_key, bans.all_keys,
+	bans.for_post, bans.reason, bans.banned_by, bans.created_on, bans.expires`
+
+// Scan a ban selected with banColumns. Any extra destinations are scanned from
+// the columns following the ban columns.
+func scanBan(r rowScanner, b *auth.BanRecord, extra ...interface{}) (
+	err error,
+) {
+	var (
+		inet             pgtype.Inet
+		pk, forPost      pgtype.Int8
+		created, expires pgtype.Timestamptz
+	)
+	err = r.Scan(
+		append(
+			[]interface{}{
+				&b.ID, &inet, &pk, &b.AllKeys, &forPost, &b.Reason, &b.By,
+				&created, &expires,
+			},
+			extra...,
+		)...,
+	)
+	if err != nil {
+		return
+	}
+
+	if inet.Status == pgtype.Present {
+		b.IP = &auth.IPRange{IPNet: *inet.IPNet}
+	}
+	b.PublicKey = uint64(pk.Int)
+	b.ForPost = uint64(forPost.Int)
+	b.Created = created.Time
+	if expires.InfinityModifier == pgtype.None {
+		b.Expires = expires.Time
+	}
+	return
+}
+
+// Get the longest lasting active ban matching an IP or a public key.
+// Either can be a zero value to only match by the other.
+// Public keys used from IP ranges with all keys banned match as well.
+// Returns pgx.ErrNoRows, if not banned.
+func GetBan(ctx context.Context, ip net.IP, pubKey uint64) (
+	b auth.BanRecord,
+	err error,
+) {
+	err = scanBan(
+		db.QueryRow(
+			ctx,
+			`select `+banColumns+`
+			from bans
+			where `+matchBans+`
+			order by expires desc
+			limit 1`,
+			ipArg(ip),
+			pubKey,
+		),
+		&b,
+	)
+	return
+}
+
+// Returns, if an IP or public key is banned.
+// Either can be a zero value to only check the other.
+// Public keys used from IP ranges with all keys banned are banned as well.
+func IsBanned(ip net.IP, pubKey uint64) bool {
+	return bans.match(ip, pubKey)
+}
+
+// Minimum interval between writes of a public key being used from an IP
+const keyIPWriteInterval = time.Hour
+
+// Public key and IP pair
+type keyIP struct {
+	pubKey uint64
+	ip     string
+}
+
+// Public key and IP pairs written to the database within the last
+// keyIPWriteInterval
+var recentKeyIPs = struct {
+	sync.Mutex
+	lastSweep time.Time
+	written   map[keyIP]time.Time
+}{
+	written: make(map[keyIP]time.Time),
+}
+
+// Record a public key being used from an IP. Pairs already recorded within
+// keyIPWriteInterval are skipped.
+func RecordPublicKeyIP(ctx context.Context, pubKey uint64, ip net.IP) (
+	err error,
+) {
+	var (
+		now = time.Now()
+		k   = keyIP{pubKey, ip.String()}
+	)
+	recentKeyIPs.Lock()
+	if now.Sub(recentKeyIPs.lastSweep) > keyIPWriteInterval {
+		for k, t := range recentKeyIPs.written {
+			if now.Sub(t) >= keyIPWriteInterval {
+				delete(recentKeyIPs.written, k)
+			}
+		}
+		recentKeyIPs.lastSweep = now
+	}
+	t, ok := recentKeyIPs.written[k]
+	recentKeyIPs.Unlock()
+	if ok && now.Sub(t) < keyIPWriteInterval {
+		return
+	}
+
+	_, err = db.Exec(
+		ctx,
+		`insert into public_key_ips (public_key, ip)
+		values ($1, $2)
+		on conflict (public_key, ip) do update
+			set last_seen = now()`,
+		pubKey,
+		ipArg(ip),
+	)
+	if err != nil {
+		return
+	}
+
+	recentKeyIPs.Lock()
+	recentKeyIPs.written[k] = now
+	recentKeyIPs.Unlock()
+	return
+}
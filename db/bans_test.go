@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestBans(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		pubKey, _ = insertSamplePubKey(t)
+		ip        = net.ParseIP("192.0.2.1")
+		otherIP   = net.ParseIP("192.0.2.2")
+	)
+
+	assertBanned := func(t *testing.T, ip net.IP, pubKey uint64, std bool) {
+		t.Helper()
+
+		test.AssertEquals(t, IsBanned(ip, pubKey), std)
+	}
+
+	assertBanned(t, ip, pubKey, false)
+
+	expires := time.Now().Add(time.Hour).Round(time.Second)
+	id, err := Ban(ctx, auth.BanRecord{
+		Ban: auth.Ban{
+			IP: auth.NewIPRange(ip),
+		},
+		Reason:  "test",
+		By:      "admin",
+		Expires: expires,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired, but not yet cleaned up
+	_, err = Ban(ctx, auth.BanRecord{
+		Ban: auth.Ban{
+			IP: auth.NewIPRange(otherIP),
+		},
+		Reason:  "test",
+		By:      "admin",
+		Expires: time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("by IP", func(t *testing.T) {
+		assertBanned(t, ip, 0, true)
+		assertBanned(t, otherIP, 0, false)
+		assertBanned(t, nil, pubKey, false)
+	})
+
+	t.Run("get ban", func(t *testing.T) {
+		b, err := GetBan(ctx, ip, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, b.ID, id)
+		test.AssertEquals(t, b.IP.IP.Equal(ip), true)
+		test.AssertEquals(t, b.Reason, "test")
+		test.AssertEquals(t, b.Expires.Equal(expires), true)
+
+		_, err = GetBan(ctx, otherIP, 0)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("IPv4 from request", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = "192.0.2.3:1234"
+		reqIP, err := auth.GetIP(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Must be the 16 byte form parsed from the request
+		test.AssertEquals(t, len(reqIP), net.IPv6len)
+
+		id, err := Ban(ctx, auth.BanRecord{
+			Ban: auth.Ban{
+				IP: auth.NewIPRange(reqIP),
+			},
+			Reason:  "test",
+			By:      "admin",
+			Expires: time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertBanned(t, reqIP, 0, true)
+
+		b, err := GetBan(ctx, reqIP, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, b.ID, id)
+	})
+
+	t.Run("permanent public key ban", func(t *testing.T) {
+		_, err := Ban(ctx, auth.BanRecord{
+			Ban: auth.Ban{
+				PublicKey: pubKey,
+			},
+			Reason: "test",
+			By:     "admin",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertBanned(t, nil, pubKey, true)
+		assertBanned(t, otherIP, pubKey, true)
+
+		b, err := GetBan(ctx, nil, pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, b.Expires.IsZero(), true)
+	})
+
+	t.Run("IP range", func(t *testing.T) {
+		var r auth.IPRange
+		err := r.UnmarshalText([]byte("198.51.100.0/24"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = Ban(ctx, auth.BanRecord{
+			Ban: auth.Ban{
+				IP: &r,
+			},
+			Reason: "test",
+			By:     "admin",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertBanned(t, net.ParseIP("198.51.100.7"), 0, true)
+		assertBanned(t, net.ParseIP("198.51.101.7"), 0, false)
+	})
+
+	t.Run("all keys from IP range", func(t *testing.T) {
+		var (
+			key, _ = insertSamplePubKey(t)
+			keyIP  = net.ParseIP("203.0.113.9")
+		)
+		err := RecordPublicKeyIP(ctx, key, keyIP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Repeated use must not fail
+		err = RecordPublicKeyIP(ctx, key, keyIP)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var r auth.IPRange
+		err = r.UnmarshalText([]byte("203.0.113.0/28"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = Ban(ctx, auth.BanRecord{
+			Ban: auth.Ban{
+				IP:      &r,
+				AllKeys: true,
+			},
+			Reason: "test",
+			By:     "admin",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Key banned even when used from a different IP
+		assertBanned(t, nil, key, true)
+		assertBanned(t, net.ParseIP("203.0.113.200"), key, true)
+		assertBanned(t, net.ParseIP("203.0.113.200"), 0, false)
+
+		b, err := GetBan(ctx, nil, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, b.AllKeys, true)
+		test.AssertEquals(t, b.IP.String(), "203.0.113.0/28")
+	})
+
+	t.Run("lift", func(t *testing.T) {
+		err := LiftBan(ctx, id, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertBanned(t, ip, 0, false)
+
+		err = LiftBan(ctx, id, "admin")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+}
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/pg_util"
+)
+
+// Maximum number of threads to cache the tags of for matching tag-scoped
+// filters
+const maxCachedThreadTags = 1 << 14
+
+// In-memory set of compiled post body filters for cheap application on each
+// body modification.
+// Kept in sync with the database through "filters.updated" notifications.
+var filters filterMatcher
+
+type filterMatcher struct {
+	mu    sync.RWMutex
+	rules []compiledFilter
+
+	// Thread tags never change, so they can be cached indefinitely
+	tagsMu sync.Mutex
+	tags   map[uint64][]string
+}
+
+type compiledFilter struct {
+	common.Filter
+	re *regexp.Regexp
+}
+
+// Result of applying filters to a post body
+type FilterResult struct {
+	// Post body with all replacements applied
+	Body string
+
+	// Body modification must be rejected
+	Reject bool
+
+	// Sum of the spam scores to add to the author's spam score
+	SpamScore uint64
+
+	// Reasons to report the post to staff with
+	Reports []string
+}
+
+// Compile the pattern of a filter. Literal patterns are matched
+// case-insensitively.
+func compileFilter(f common.Filter) (c compiledFilter, err error) {
+	c.Filter = f
+	pattern := f.Pattern
+	if !f.IsRegex {
+		pattern = "(?i)" + regexp.QuoteMeta(pattern)
+	}
+	c.re, err = regexp.Compile(pattern)
+	if err != nil {
+		err = common.ErrInvalidInput(err.Error())
+	}
+	return
+}
+
+// Replace matcher contents with all filters from the database
+func (m *filterMatcher) reload() (err error) {
+	fs, err := GetFilters(context.Background())
+	if err != nil {
+		return
+	}
+	rules := make([]compiledFilter, 0, len(fs))
+	for _, f := range fs {
+		var c compiledFilter
+		c, err = compileFilter(f)
+		if err != nil {
+			return fmt.Errorf("filter %d: %w", f.ID, err)
+		}
+		rules = append(rules, c)
+	}
+
+	m.mu.Lock()
+	m.rules = rules
+	m.mu.Unlock()
+	return
+}
+
+// Return the tags of a thread
+func (m *filterMatcher) threadTags(ctx context.Context, thread uint64) (
+	tags []string,
+	err error,
+) {
+	m.tagsMu.Lock()
+	tags, ok := m.tags[thread]
+	m.tagsMu.Unlock()
+	if ok {
+		return
+	}
+
+	err = db.
+		QueryRow(ctx, `select tags from threads where id = $1`, thread).
+		Scan(&tags)
+	if err != nil {
+		return
+	}
+
+	m.tagsMu.Lock()
+	defer m.tagsMu.Unlock()
+	if m.tags == nil || len(m.tags) >= maxCachedThreadTags {
+		m.tags = make(map[uint64][]string)
+	}
+	m.tags[thread] = tags
+	return
+}
+
+// Apply all filters matching the thread to a modified post body.
+//
+// Actions other than replacement are only taken for filters that did not
+// already match the previous version of the body, so they are not repeated on
+// each subsequent modification.
+func ApplyFilters(ctx context.Context, thread uint64, prev, body string) (
+	res FilterResult,
+	err error,
+) {
+	res.Body = body
+
+	filters.mu.RLock()
+	rules := filters.rules
+	filters.mu.RUnlock()
+
+	var tags []string
+	for _, f := range rules {
+		if f.Tag != "" {
+			if tags == nil {
+				tags, err = filters.threadTags(ctx, thread)
+				if err != nil {
+					return
+				}
+			}
+			if !containsString(tags, f.Tag) {
+				continue
+			}
+		}
+
+		if f.Action == common.FilterReplace {
+			res.Body = f.re.ReplaceAllLiteralString(res.Body, f.Replacement)
+			continue
+		}
+		if !f.re.MatchString(res.Body) || f.re.MatchString(prev) {
+			continue
+		}
+		switch f.Action {
+		case common.FilterReject:
+			res.Reject = true
+		case common.FilterSpamScore:
+			res.SpamScore += f.SpamScore
+		case common.FilterReport:
+			res.Reports = append(
+				res.Reports,
+				fmt.Sprintf("matched filter %d", f.ID),
+			)
+		}
+	}
+	return
+}
+
+// Returns, if arr contains s
+func containsString(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Load post body filters and update on each change
+func loadFilters() (err error) {
+	err = filters.reload()
+	if err != nil {
+		return
+	}
+
+	return Listen(pg_util.ListenOpts{
+		Channel: "filters.updated",
+		OnMsg: func(_ string) error {
+			err := filters.reload()
+			if err != nil {
+				return fmt.Errorf("reloading filters: %w", err)
+			}
+			return nil
+		},
+	})
+}
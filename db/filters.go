@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// Read all post body filters in order of creation
+func GetFilters(ctx context.Context) (filters []common.Filter, err error) {
+	r, err := db.Query(
+		ctx,
+		`select
+			id,
+			pattern,
+			is_regex,
+			coalesce(tag, ''),
+			action,
+			replacement,
+			spam_score,
+			created_by,
+			created_on
+		from filters
+		order by id`,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	filters = make([]common.Filter, 0)
+	for r.Next() {
+		var f common.Filter
+		err = r.Scan(
+			&f.ID,
+			&f.Pattern,
+			&f.IsRegex,
+			&f.Tag,
+			&f.Action,
+			&f.Replacement,
+			&f.SpamScore,
+			&f.CreatedBy,
+			&f.CreatedOn,
+		)
+		if err != nil {
+			return
+		}
+		filters = append(filters, f)
+	}
+	err = r.Err()
+	return
+}
+
+// Insert a post body filter on behalf of a staff member.
+// Returns the ID of the created filter.
+//
+// Returns common.ErrInvalidInput, if the pattern of the filter does not
+// compile.
+func InsertFilter(ctx context.Context, f common.Filter, by string) (
+	id uint64,
+	err error,
+) {
+	_, err = compileFilter(f)
+	if err != nil {
+		return
+	}
+
+	var tag *string
+	if f.Tag != "" {
+		tag = &f.Tag
+	}
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		err = tx.
+			QueryRow(
+				ctx,
+				`insert into filters (
+					pattern,
+					is_regex,
+					tag,
+					action,
+					replacement,
+					spam_score,
+					created_by
+				)
+				values ($1, $2, $3, $4, $5, $6, $7)
+				returning id`,
+				f.Pattern,
+				f.IsRegex,
+				tag,
+				f.Action,
+				f.Replacement,
+				f.SpamScore,
+				by,
+			).
+			Scan(&id)
+		if err != nil {
+			return
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.AddFilter,
+				By:   by,
+				Data: filterLogData(id, f.Action),
+			},
+		})
+		return
+	})
+	return
+}
+
+// Delete a post body filter on behalf of a staff member
+func DeleteFilter(ctx context.Context, id uint64, by string) error {
+	return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var action common.FilterAction
+		err = tx.
+			QueryRow(
+				ctx,
+				`delete from filters
+				where id = $1
+				returning action`,
+				id,
+			).
+			Scan(&action)
+		if err != nil {
+			return
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.RemoveFilter,
+				By:   by,
+				Data: filterLogData(id, action),
+			},
+		})
+		return
+	})
+}
+
+// Describe a filter in the moderation log. Patterns are not logged, as the
+// log is public and would allow evading the filters.
+func filterLogData(id uint64, action common.FilterAction) string {
+	return fmt.Sprintf("%d: %s", id, action)
+}
+
+// Report a post to staff on behalf of the system, unless an identical open
+// system report for the post already exists
+func InsertFilterReport(ctx context.Context, post uint64, reason string) (
+	err error,
+) {
+	_, err = db.Exec(
+		ctx,
+		`insert into reports (target, reason)
+		select p.id, $2
+		from posts p
+		where p.id = $1
+			and not exists (
+				select
+				from reports r
+				where r.target = p.id
+					and r.public_key is null
+					and r.reason = $2
+					and r.closed_on is null
+			)`,
+		post,
+		reason,
+	)
+	return
+}
@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+)
+
+func TestFilters(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		thread, _    = insertSampleThread(t)
+		ids          []uint64
+		report, junk uint64
+	)
+
+	for _, f := range [...]common.Filter{
+		{
+			Pattern:     "Badword",
+			Action:      common.FilterReplace,
+			Replacement: "***",
+		},
+		{
+			Pattern: `buy\s+now`,
+			IsRegex: true,
+			Action:  common.FilterReject,
+		},
+		{
+			Pattern:   "spam.example",
+			Action:    common.FilterSpamScore,
+			SpamScore: 1000,
+		},
+		{
+			Pattern: "lolicon",
+			Tag:     "mango",
+			Action:  common.FilterReport,
+		},
+		{
+			Pattern: "unrelated",
+			Tag:     "cooking",
+			Action:  common.FilterReject,
+		},
+	} {
+		id, err := InsertFilter(ctx, f, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	report, junk = ids[3], ids[4]
+
+	err := filters.reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := InsertFilter(
+			ctx,
+			common.Filter{
+				Pattern: "(",
+				IsRegex: true,
+				Action:  common.FilterReject,
+			},
+			"admin",
+		)
+		if _, ok := err.(common.StatusError); !ok {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("read", func(t *testing.T) {
+		res, err := GetFilters(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(res), len(ids))
+		test.AssertEquals(t, res[3].Tag, "mango")
+		test.AssertEquals(t, res[0].Tag, "")
+	})
+
+	cases := [...]struct {
+		name, prev, body string
+		res              FilterResult
+	}{
+		{
+			name: "no match",
+			body: "unrelated",
+			res: FilterResult{
+				Body: "unrelated",
+			},
+		},
+		{
+			name: "replace",
+			body: "a BADWORD b",
+			res: FilterResult{
+				Body: "a *** b",
+			},
+		},
+		{
+			name: "reject",
+			body: "buy  now",
+			res: FilterResult{
+				Body:   "buy  now",
+				Reject: true,
+			},
+		},
+		{
+			name: "spam score",
+			body: "spam.example",
+			res: FilterResult{
+				Body:      "spam.example",
+				SpamScore: 1000,
+			},
+		},
+		{
+			name: "already matched",
+			prev: "spam.example",
+			body: "spam.example ",
+			res: FilterResult{
+				Body: "spam.example ",
+			},
+		},
+		{
+			name: "report",
+			body: "lolicon",
+			res: FilterResult{
+				Body:    "lolicon",
+				Reports: []string{fmt.Sprintf("matched filter %d", report)},
+			},
+		},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := ApplyFilters(ctx, thread, c.prev, c.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, res, c.res)
+		})
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		err := DeleteFilter(ctx, junk, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = filters.reload()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		typ := common.RemoveFilter
+		entries, err := GetModLog(ctx, ModLogFilter{
+			Type: &typ,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(entries), 1)
+		test.AssertEquals(t, entries[0].Data, fmt.Sprintf("%d: reject", junk))
+	})
+
+	t.Run("filter report", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			err := InsertFilterReport(ctx, thread, "matched filter 1")
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var n int
+		err := db.
+			QueryRow(
+				ctx,
+				`select count(*)
+				from reports
+				where target = $1 and public_key is null`,
+				thread,
+			).
+			Scan(&n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, n, 1)
+	})
+}
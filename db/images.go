@@ -7,6 +7,7 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/pg_util"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -123,9 +124,86 @@ func GetImage(ctx context.Context, tx pgx.Tx, id common.SHA1Hash) (
 	return
 }
 
+// Read images perceptually similar to an image as a JSON array ordered by
+// ascending Hamming distance of their perceptual hashes. Each image contains
+// its distance and the IDs of posts using it.
+//
+// maxDistance: maximum Hamming distance of matched images
+// limit: maximum number of matched images
+func GetSimilarImages(
+	ctx context.Context,
+	id common.SHA1Hash,
+	maxDistance uint8,
+	limit uint,
+) (
+	buf []byte,
+	err error,
+) {
+	var dhash pgtype.Int8
+	err = db.
+		QueryRow(ctx, `select dhash from images where sha1 = $1`, id).
+		Scan(&dhash)
+	if err != nil {
+		return
+	}
+	if dhash.Status != pgtype.Present {
+		err = common.ErrInvalidInput("image has no thumbnail")
+		return
+	}
+
+	err = db.
+		QueryRow(
+			ctx,
+			`with similar as (
+				select
+					i.*,
+					hamming_distance(i.dhash, $2) as distance
+				from images i
+				where i.sha1 != $1
+					and i.dhash is not null
+					and hamming_distance(i.dhash, $2) <= $3
+				order by distance, i.sha1
+				limit $4
+			)
+			select coalesce(
+				jsonb_agg(
+					jsonb_build_object(
+						'sha1', encode(s.sha1, 'hex'),
+						'md5', encode(s.md5, 'hex'),
+						'file_type', s.file_type,
+						'thumb_type', s.thumb_type,
+						'width', s.width,
+						'height', s.height,
+						'thumb_width', s.thumb_width,
+						'thumb_height', s.thumb_height,
+						'size', s.size,
+						'distance', s.distance,
+						'posts', coalesce(
+							(
+								select jsonb_agg(p.id order by p.id)
+								from posts p
+								where p.image = s.sha1
+							),
+							'[]'::jsonb
+						)
+					)
+					order by s.distance, s.sha1
+				),
+				'[]'::jsonb
+			)
+			from similar s`,
+			id,
+			dhash.Int,
+			int(maxDistance),
+			limit,
+		).
+		Scan(&buf)
+	return
+}
+
 // SpoilerImage spoilers an already allocated image
-func SpoilerImage(ctx context.Context, id uint64) error {
-	_, err := db.Exec(
+func SpoilerImage(ctx context.Context, tx pgx.Tx, id uint64) error {
+	_, err := tx.Exec(
 		ctx,
 		`update posts
 		set image_spoilered = true
@@ -166,6 +244,8 @@ func deleteUnusedImages() (err error) {
 	if err != nil {
 		return
 	}
+	defer r.Close()
+
 	var (
 		sha1                common.SHA1Hash
 		fileType, thumbType common.FileType
@@ -214,9 +214,109 @@ func TestInsertImage(t *testing.T) {
 
 	assertPost(false)
 
-	err = SpoilerImage(context.Background(), thread)
+	err = InTransaction(context.Background(), func(tx pgx.Tx) error {
+		return SpoilerImage(context.Background(), tx, thread)
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
 	assertPost(true)
 }
+
+func TestSimilarImages(t *testing.T) {
+	ctx := context.Background()
+	src, files, close := prepareSampleImage(t)
+	defer close()
+
+	t.Run("no thumbnail", func(t *testing.T) {
+		_, err := GetSimilarImages(ctx, src.SHA1, 10, 10)
+		test.AssertEquals(t, err, common.ErrInvalidInput("image has no thumbnail"))
+	})
+
+	// Allocate images with perceptual hashes differing from the base hash by
+	// the respective number of bits
+	const base int64 = 0x0f0f0f0f0f0f0f0f
+	var imgs [3]common.ImageCommon
+	for i, bits := range [...]uint{0, 3, 20} {
+		img := src
+		copy(img.SHA1[:], test.GenBuf(20))
+		copy(img.MD5[:], test.GenBuf(16))
+		h := base ^ (1<<bits - 1)
+		img.DHash = &h
+
+		for _, f := range files {
+			_, err := f.Seek(0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err := InTransaction(ctx, func(tx pgx.Tx) error {
+			return AllocateImage(ctx, tx, img, files[0], files[1])
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		imgs[i] = img
+	}
+
+	t.Run("similar", func(t *testing.T) {
+		buf, err := GetSimilarImages(ctx, imgs[0].SHA1, 10, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		type match struct {
+			SHA1     common.SHA1Hash `json:"sha1"`
+			Distance int             `json:"distance"`
+			Posts    []uint64        `json:"posts"`
+		}
+		var res []match
+		err = json.Unmarshal(buf, &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, res, []match{
+			{
+				SHA1:     imgs[1].SHA1,
+				Distance: 3,
+				Posts:    []uint64{},
+			},
+		})
+	})
+
+	t.Run("banned", func(t *testing.T) {
+		banned, err := IsSimilarImageBanned(ctx, *imgs[2].DHash, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, banned, false)
+
+		_, err = BanImages(ctx, "admin", ImageBanRequest{
+			SHA1:   []common.SHA1Hash{imgs[2].SHA1},
+			Reason: "spam",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		banned, err = IsSimilarImageBanned(ctx, *imgs[2].DHash^1, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, banned, true)
+
+		for _, c := range [...]struct {
+			dist uint8
+			std  bool
+		}{
+			{0, false},
+			{6, false},
+			{20, true},
+		} {
+			banned, err = IsImageBanned(ctx, imgs[0].SHA1, nil, c.dist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, banned, c.std)
+		}
+	})
+}
@@ -106,6 +106,14 @@ func loadDB(connURL, dbSuffix string) (err error) {
 	if err != nil {
 		return
 	}
+	err = loadBans()
+	if err != nil {
+		return
+	}
+	err = loadFilters()
+	if err != nil {
+		return
+	}
 
 	if !common.IsTest {
 		go runCleanupTasks()
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Filters for reading the moderation log. Zero values of optional filters
+// disable them.
+type ModLogFilter struct {
+	// Only match entries of this action type
+	Type *common.ModerationAction
+
+	// Only match actions by this staff member
+	By string
+
+	// Only match actions on posts in this thread
+	Thread uint64
+
+	// Only match entries created within this time range
+	From, To time.Time
+
+	// Page of the results to fetch, starting at 0
+	Page uint
+
+	// Maximum number of entries on a page
+	Limit uint
+}
+
+// Write a moderation action to the moderation log. Must be called inside the
+// same transaction as the action itself.
+// If e.Thread is not set, it is derived from e.Post.
+// Returns the ID of the created log entry.
+func logModAction(ctx context.Context, tx pgx.Tx, e auth.ModLogEntry) (
+	id uint64,
+	err error,
+) {
+	var ban auth.Ban
+	if e.Ban != nil {
+		ban = *e.Ban
+	}
+	err = tx.QueryRow(
+		ctx,
+		`insert into mod_log (
+			type, staff, post, thread, length, data,
+			ban_ip, ban_public_key, ban_all_keys
+		)
+		values (
+			$1,
+			$2,
+			nullif($3, 0),
+			coalesce(
+				nullif($4, 0),
+				(select p.thread from posts p where p.id = $3)
+			),
+			$5,
+			$6,
+			$7,
+			nullif($8, 0),
+			$9
+		)
+		returning id`,
+		int16(e.Type),
+		e.By,
+		e.Post,
+		e.Thread,
+		e.Length,
+		e.Data,
+		ipRangeArg(ban.IP),
+		ban.PublicKey,
+		ban.AllKeys,
+	).
+		Scan(&id)
+	return
+}
+
+// Read a page of moderation log entries sorted from newest to oldest
+func GetModLog(ctx context.Context, f ModLogFilter) (
+	entries []auth.ModLogEntry,
+	err error,
+) {
+	var (
+		args  []interface{}
+		conds []string
+	)
+	addCond := func(format string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(format, len(args)))
+	}
+
+	if f.Type != nil {
+		addCond("type = $%d", int16(*f.Type))
+	}
+	if f.By != "" {
+		addCond("staff = $%d", f.By)
+	}
+	if f.Thread != 0 {
+		addCond("thread = $%d", f.Thread)
+	}
+	if !f.From.IsZero() {
+		addCond("created_on >= $%d", f.From)
+	}
+	if !f.To.IsZero() {
+		addCond("created_on < $%d", f.To)
+	}
+	where := ""
+	if len(conds) != 0 {
+		where = "where " + strings.Join(conds, " and ")
+	}
+	args = append(args, f.Limit, f.Page*f.Limit)
+
+	r, err := db.Query(
+		ctx,
+		fmt.Sprintf(
+			`select id, type, staff, post, thread, length, data, created_on,
+				ban_ip, ban_public_key, ban_all_keys
+			from mod_log
+			%s
+			order by id desc
+			limit $%d
+			offset $%d`,
+			where,
+			len(args)-1,
+			len(args),
+		),
+		args...,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	entries = make([]auth.ModLogEntry, 0, f.Limit)
+	for r.Next() {
+		var (
+			e            auth.ModLogEntry
+			typ          int16
+			post, thread pgtype.Int8
+			banIP        pgtype.Inet
+			banKey       pgtype.Int8
+			allKeys      bool
+		)
+		err = r.Scan(
+			&e.ID, &typ, &e.By, &post, &thread, &e.Length, &e.Data,
+			&e.Created, &banIP, &banKey, &allKeys,
+		)
+		if err != nil {
+			return
+		}
+		e.Type = common.ModerationAction(typ)
+		e.Post = uint64(post.Int)
+		e.Thread = uint64(thread.Int)
+		if banIP.Status == pgtype.Present || banKey.Status == pgtype.Present {
+			e.Ban = &auth.Ban{
+				PublicKey: uint64(banKey.Int),
+				AllKeys:   allKeys,
+			}
+			if banIP.Status == pgtype.Present {
+				e.Ban.IP = &auth.IPRange{IPNet: *banIP.IPNet}
+			}
+		}
+		entries = append(entries, e)
+	}
+	err = r.Err()
+	return
+}
@@ -0,0 +1,189 @@
+package db
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestModLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		thread, _ = insertSampleThread(t)
+		staff     = "modlog_test"
+		banned    = &auth.Ban{
+			IP: &auth.IPRange{
+				IPNet: net.IPNet{
+					IP:   net.IP{192, 0, 2, 0},
+					Mask: net.CIDRMask(24, 32),
+				},
+			},
+			PublicKey: 1,
+			AllKeys:   true,
+		}
+	)
+
+	err := InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		for _, e := range [...]auth.ModLogEntry{
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.DeletePost,
+					By:   staff,
+				},
+				Post: thread,
+			},
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type:   common.BanPost,
+					By:     staff,
+					Length: 3600,
+					Data:   "spam",
+				},
+				Thread: thread,
+				Ban:    banned,
+			},
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.DeletePost,
+					By:   staff,
+				},
+			},
+		} {
+			_, err = logModAction(ctx, tx, e)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typ := common.DeletePost
+	cases := [...]struct {
+		name   string
+		filter ModLogFilter
+		count  int
+	}{
+		{
+			name: "by staff",
+			filter: ModLogFilter{
+				By: staff,
+			},
+			count: 3,
+		},
+		{
+			name: "by action",
+			filter: ModLogFilter{
+				By:   staff,
+				Type: &typ,
+			},
+			count: 2,
+		},
+		{
+			name: "by thread",
+			filter: ModLogFilter{
+				By:     staff,
+				Thread: thread,
+			},
+			count: 2,
+		},
+		{
+			name: "by date",
+			filter: ModLogFilter{
+				By:   staff,
+				From: time.Now().Add(time.Hour),
+			},
+		},
+		{
+			name: "paginated",
+			filter: ModLogFilter{
+				By:   staff,
+				Page: 1,
+			},
+			count: 1,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := c.filter
+			if f.Limit == 0 {
+				f.Limit = 2
+			}
+			res, err := GetModLog(ctx, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, len(res), c.count)
+		})
+	}
+
+	t.Run("thread derived from post", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := GetModLog(ctx, ModLogFilter{
+			By:    staff,
+			Type:  &typ,
+			Limit: 1,
+			Page:  1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(res), 1)
+		test.AssertEquals(t, res[0].Post, thread)
+		test.AssertEquals(t, res[0].Thread, thread)
+	})
+
+	t.Run("ban target", func(t *testing.T) {
+		t.Parallel()
+
+		typ := common.BanPost
+		res, err := GetModLog(ctx, ModLogFilter{
+			By:    staff,
+			Type:  &typ,
+			Limit: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(res), 1)
+		ban := res[0].Ban
+		if ban == nil {
+			t.Fatal("no ban target")
+		}
+		test.AssertEquals(t, ban.IP.String(), banned.IP.String())
+		test.AssertEquals(t, ban.PublicKey, banned.PublicKey)
+		test.AssertEquals(t, ban.AllKeys, banned.AllKeys)
+	})
+
+	t.Run("no ban target", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := GetModLog(ctx, ModLogFilter{
+			By:    staff,
+			Type:  &typ,
+			Limit: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(res), 1)
+		if res[0].Ban != nil {
+			t.Fatal("unexpected ban target")
+		}
+	})
+}
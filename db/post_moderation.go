@@ -0,0 +1,240 @@
+package db
+
+import (
+	"context"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Apply a moderation action to a single post on behalf of a staff member.
+//
+// common.DeletePost hides the post from everyone except staff.
+// common.PurgePost removes the body and image of the post and hides it.
+// common.ShadowBinPost hides the post from everyone except its author and staff.
+// common.SpoilerImage spoilers the image of the post.
+// common.DeleteImage removes the image from the post.
+//
+// Images no longer used by any post are deleted along with their files.
+func ModeratePost(
+	ctx context.Context,
+	id uint64,
+	by string,
+	action common.ModerationAction,
+) (err error) {
+	var img []byte
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		_, img, err = moderatePost(ctx, tx, id, by, action, "")
+		return
+	})
+	if err != nil {
+		return
+	}
+	if img != nil {
+		err = deleteImageIfUnused(ctx, img)
+	}
+	return
+}
+
+// Apply a moderation action to a single post and log it with an optional
+// reason.
+// Returns the ID of the created moderation log entry and the SHA1 hash of the
+// image removed from the post, if any.
+func moderatePost(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uint64,
+	by string,
+	action common.ModerationAction,
+	reason string,
+) (logID uint64, img []byte, err error) {
+	var set string
+	switch action {
+	case common.DeletePost:
+		set = `deleted = true`
+	case common.PurgePost:
+		set = `deleted = true,
+			body = '"Empty"',
+			image = null,
+			image_name = '',
+			image_spoilered = false`
+	case common.ShadowBinPost:
+		set = `shadow_binned = true`
+	case common.SpoilerImage:
+		// Applied with SpoilerImage
+	case common.DeleteImage:
+		set = `image = null, image_name = '', image_spoilered = false`
+	default:
+		err = common.ErrInvalidInput("unsupported post moderation action")
+		return
+	}
+
+	var thread uint64
+	err = tx.
+		QueryRow(
+			ctx,
+			`select thread, image
+			from posts
+			where id = $1
+			for update`,
+			id,
+		).
+		Scan(&thread, &img)
+	if err != nil {
+		return
+	}
+	switch action {
+	case common.DeletePost, common.ShadowBinPost:
+		if thread == id {
+			// Hiding OPs would render the thread unreadable
+			err = common.ErrInvalidInput("thread OPs can not be hidden")
+			return
+		}
+	case common.SpoilerImage, common.DeleteImage:
+		if img == nil {
+			err = common.ErrInvalidInput("post has no image")
+			return
+		}
+	}
+	switch action {
+	case common.PurgePost, common.DeleteImage:
+	default:
+		// Image not removed from the post
+		img = nil
+	}
+
+	if action == common.SpoilerImage {
+		err = SpoilerImage(ctx, tx, id)
+	} else {
+		_, err = tx.Exec(ctx, `update posts set `+set+` where id = $1`, id)
+	}
+	if err != nil {
+		return
+	}
+	logID, err = logModAction(ctx, tx, auth.ModLogEntry{
+		ModerationEntry: common.ModerationEntry{
+			Type: action,
+			By:   by,
+			Data: reason,
+		},
+		Post:   id,
+		Thread: thread,
+	})
+	return
+}
+
+// Delete an image and its assets, if it is no longer used in any posts
+func deleteImageIfUnused(ctx context.Context, sha1 []byte) (err error) {
+	var (
+		hash                common.SHA1Hash
+		fileType, thumbType common.FileType
+	)
+	err = db.
+		QueryRow(
+			ctx,
+			`delete from images as i
+			where i.sha1 = $1
+				and not exists (
+					select
+					from posts p
+					where p.image = i.sha1
+				)
+			returning i.sha1, i.file_type, i.thumb_type`,
+			sha1,
+		).
+		Scan(&hash, &fileType, &thumbType)
+	switch err {
+	case nil:
+		return assets.Delete(hash, fileType, thumbType)
+	case pgx.ErrNoRows:
+		return nil
+	default:
+		return
+	}
+}
+
+// Read all posts made by the author of a post on behalf of a staff member and
+// log the lookup. Returns a JSON array of threads the posts were made in,
+// ordered by the author's most recent post. Each thread contains the matched
+// posts with their moderation state.
+//
+// sameIP: also include posts by public keys seen from any IP the author's
+// public key was seen from
+func MeidoVision(ctx context.Context, post uint64, sameIP bool, by string) (
+	buf []byte,
+	err error,
+) {
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var pubKey pgtype.Int8
+		err = tx.
+			QueryRow(ctx, `select public_key from posts where id = $1`, post).
+			Scan(&pubKey)
+		if err != nil {
+			return
+		}
+		if pubKey.Status != pgtype.Present {
+			return common.ErrInvalidInput("post has no public key")
+		}
+
+		err = tx.
+			QueryRow(
+				ctx,
+				`with keys as (
+					select $1::bigint as public_key
+
+					union
+
+					select o.public_key
+					from public_key_ips k
+					join public_key_ips o on o.ip = k.ip
+					where $2 and k.public_key = $1
+				),
+				matched as (
+					select
+						p.thread,
+						max(p.id) as last_post,
+						jsonb_agg(encode(p, true) order by p.id) as posts
+					from posts p
+					join keys k on k.public_key = p.public_key
+					group by p.thread
+				)
+				select coalesce(
+					jsonb_agg(
+						jsonb_build_object(
+							'id', t.id,
+							'subject', t.subject,
+							'tags', t.tags,
+							'posts', m.posts
+						)
+						order by m.last_post desc
+					),
+					'[]'::jsonb
+				)
+				from matched m
+				join threads t on t.id = m.thread`,
+				pubKey.Int,
+				sameIP,
+			).
+			Scan(&buf)
+		if err != nil {
+			return
+		}
+
+		e := auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.MeidoVision,
+				By:   by,
+			},
+			Post: post,
+		}
+		if sameIP {
+			e.Data = "same IP"
+		}
+		_, err = logModAction(ctx, tx, e)
+		return
+	})
+	return
+}
@@ -0,0 +1,255 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestModeratePost(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx            = context.Background()
+		thread, author = insertSampleThread(t)
+		replies        [3]uint64
+	)
+	err := InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		for i := range replies {
+			replies[i], _, err = InsertPost(tx, ReplyInsertParams{
+				Thread: thread,
+				PostInsertParamsCommon: PostInsertParamsCommon{
+					PublicKey: &author,
+					Body:      []byte("{}"),
+				},
+			})
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, purged, binned := replies[0], replies[1], replies[2]
+
+	for _, c := range [...]struct {
+		post   uint64
+		action common.ModerationAction
+	}{
+		{deleted, common.DeletePost},
+		{purged, common.PurgePost},
+		{binned, common.ShadowBinPost},
+	} {
+		err = ModeratePost(ctx, c.post, "admin", c.action)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Read the IDs of posts visible to the viewer
+	visible := func(t *testing.T, viewer uint64, isStaff bool) []uint64 {
+		t.Helper()
+
+		buf, err := GetThreadFor(ctx, thread, 0, viewer, isStaff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var res struct {
+			Posts []common.Post `json:"posts"`
+		}
+		err = json.Unmarshal(buf, &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := make([]uint64, len(res.Posts))
+		for i, p := range res.Posts {
+			ids[i] = p.ID
+		}
+		return ids
+	}
+
+	t.Run("public", func(t *testing.T) {
+		test.AssertEquals(t, visible(t, 0, false), []uint64{thread})
+
+		_, err := GetPost(ctx, deleted)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("author", func(t *testing.T) {
+		test.AssertEquals(
+			t,
+			visible(t, author, false),
+			[]uint64{thread, binned},
+		)
+	})
+
+	t.Run("staff", func(t *testing.T) {
+		test.AssertEquals(
+			t,
+			visible(t, 0, true),
+			[]uint64{thread, deleted, purged, binned},
+		)
+	})
+
+	t.Run("purged contents", func(t *testing.T) {
+		var body string
+		err := db.
+			QueryRow(ctx, `select body::text from posts where id = $1`, purged).
+			Scan(&body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, body, `"Empty"`)
+	})
+
+	t.Run("hide OP", func(t *testing.T) {
+		err := ModeratePost(ctx, thread, "admin", common.DeletePost)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		entries, err := GetModLog(ctx, ModLogFilter{
+			Thread: thread,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(entries), 3)
+	})
+}
+
+func TestMeidoVision(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx                = context.Background()
+		thread, author     = insertSampleThread(t)
+		otherThread, other = insertSampleThread(t)
+		reply              uint64
+		ip                 = net.IPv4(100, 64, 7, 7)
+	)
+	err := InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		reply, _, err = InsertPost(tx, ReplyInsertParams{
+			Thread: otherThread,
+			PostInsertParamsCommon: PostInsertParamsCommon{
+				PublicKey: &author,
+				Body:      []byte("{}"),
+			},
+		})
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range [...]uint64{author, other} {
+		err = RecordPublicKeyIP(ctx, k, ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Read the IDs of matched posts grouped by thread
+	read := func(t *testing.T, sameIP bool) map[uint64][]uint64 {
+		t.Helper()
+
+		buf, err := MeidoVision(ctx, thread, sameIP, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var threads []common.Thread
+		err = json.Unmarshal(buf, &threads)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := make(map[uint64][]uint64, len(threads))
+		for _, th := range threads {
+			for _, p := range th.Posts {
+				res[th.ID] = append(res[th.ID], p.ID)
+			}
+		}
+		return res
+	}
+
+	t.Run("same public key", func(t *testing.T) {
+		test.AssertEquals(t, read(t, false), map[uint64][]uint64{
+			thread:      {thread},
+			otherThread: {reply},
+		})
+	})
+
+	t.Run("same IP", func(t *testing.T) {
+		test.AssertEquals(t, read(t, true), map[uint64][]uint64{
+			thread:      {thread},
+			otherThread: {otherThread, reply},
+		})
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		typ := common.MeidoVision
+		entries, err := GetModLog(ctx, ModLogFilter{
+			Type:   &typ,
+			Thread: thread,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(entries), 2)
+	})
+}
+
+func TestModerateImage(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		thread, pubKey = insertSampleThread(t)
+	)
+	img, _, close := prepareSampleImage(t)
+	defer close()
+
+	err := InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		_, _, err = InsertImage(ctx, tx, pubKey, img.SHA1, "fuko_da", false)
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("spoiler", func(t *testing.T) {
+		err := ModeratePost(ctx, thread, "admin", common.SpoilerImage)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var spoilered bool
+		err = db.
+			QueryRow(
+				ctx,
+				`select image_spoilered from posts where id = $1`,
+				thread,
+			).
+			Scan(&spoilered)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, spoilered, true)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := ModeratePost(ctx, thread, "admin", common.DeleteImage)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertNoImage(t, img.SHA1)
+
+		err = ModeratePost(ctx, thread, "admin", common.DeleteImage)
+		test.AssertEquals(t, err, common.ErrInvalidInput("post has no image"))
+	})
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/pg_util"
 	"github.com/jackc/pgx/v4"
 )
@@ -47,10 +48,32 @@ type OPInsertparams struct {
 }
 
 // Insert a new post into a specific thread. Returns post ID and page.
+// Returns common.ErrThreadLocked, if inserting a reply into a locked thread.
 //
 // params: either ReplyInsertParams or OPInsertparams.
 func InsertPost(tx pgx.Tx, params interface{},
 ) (id uint64, page uint32, err error) {
+	if p, ok := params.(ReplyInsertParams); ok {
+		var locked bool
+		err = tx.
+			QueryRow(
+				context.Background(),
+				`select locked
+				from threads
+				where id = $1
+				for share`,
+				p.Thread,
+			).
+			Scan(&locked)
+		if err != nil {
+			return
+		}
+		if locked {
+			err = common.ErrThreadLocked
+			return
+		}
+	}
+
 	q, args := pg_util.BuildInsert(pg_util.InsertOpts{
 		Table:  "posts",
 		Data:   params,
@@ -60,14 +83,15 @@ func InsertPost(tx pgx.Tx, params interface{},
 	return
 }
 
-// GetPost reads a single post from the database
+// GetPost reads a single post from the database. Posts hidden by moderation
+// are treated as nonexistent.
 func GetPost(ctx context.Context, id uint64) (post []byte, err error) {
 	err = db.
 		QueryRow(
 			ctx,
 			`select encode(p)
 			from posts p
-			where p.id = $1`,
+			where p.id = $1 and is_visible(p, 0, false)`,
 			id,
 		).
 		Scan(&post)
@@ -88,3 +112,17 @@ func GetPostParenthood(id uint64) (thread uint64, page uint32, err error) {
 		Scan(&thread, &page)
 	return
 }
+
+// Call fn with the ID of each post modified in the database
+func ListenPostUpdates(fn func(id uint64) error) error {
+	return Listen(pg_util.ListenOpts{
+		Channel: "post.updated",
+		OnMsg: func(msg string) error {
+			arr, err := SplitUint64s(msg, 1)
+			if err != nil {
+				return err
+			}
+			return fn(arr[0])
+		},
+	})
+}
@@ -0,0 +1,246 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Moderation action to resolve reports of a post with
+type ReportResolution struct {
+	Type common.ModerationAction
+
+	// Reason for the action
+	Reason string
+
+	// Ban duration. Zero for permanent bans.
+	Duration time.Duration
+}
+
+// Report a post on behalf of a public key.
+// Returns the ID of the created report.
+func InsertReport(ctx context.Context, pubKey, post uint64, reason string) (
+	id uint64,
+	err error,
+) {
+	err = db.
+		QueryRow(
+			ctx,
+			`insert into reports (target, public_key, reason)
+			select p.id, $2, $3
+			from posts p
+			where p.id = $1
+			on conflict (public_key, target) do nothing
+			returning id`,
+			post,
+			pubKey,
+			reason,
+		).
+		Scan(&id)
+	if err == pgx.ErrNoRows {
+		// Either the post does not exist or was already reported by this
+		// public key
+		var exists bool
+		err = db.
+			QueryRow(
+				ctx,
+				`select exists (select from posts where id = $1)`,
+				post,
+			).
+			Scan(&exists)
+		if err == nil {
+			if exists {
+				err = common.ErrInvalidInput("post already reported")
+			} else {
+				err = pgx.ErrNoRows
+			}
+		}
+	}
+	return
+}
+
+// Get all open reports grouped by target post. Posts with the oldest reports
+// come first.
+func GetReportQueue(ctx context.Context) (groups []auth.ReportGroup, err error) {
+	return getReportQueue(ctx, "")
+}
+
+// Like GetReportQueue, but only includes reports of posts in threads with any
+// tag the account is appointed to as staff
+func GetTagReportQueue(ctx context.Context, account string) (
+	groups []auth.ReportGroup,
+	err error,
+) {
+	return getReportQueue(ctx, account)
+}
+
+// Get open reports grouped by target post, optionally filtered to threads
+// with tags the account is appointed to
+func getReportQueue(ctx context.Context, account string) (
+	groups []auth.ReportGroup,
+	err error,
+) {
+	r, err := db.Query(
+		ctx,
+		`select r.id, r.target, p.thread, r.reason, r.created_on
+		from reports r
+		join posts p on p.id = r.target
+		where r.closed_on is null
+			and (
+				$1 = ''
+				or exists (
+					select
+					from tag_staff s
+					join threads t on t.tags @> array[s.tag]
+					where s.account = $1 and t.id = p.thread
+				)
+			)
+		order by min(r.id) over (partition by r.target), r.id`,
+		account,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	groups = make([]auth.ReportGroup, 0)
+	for r.Next() {
+		var (
+			rep    auth.Report
+			thread uint64
+		)
+		err = r.Scan(&rep.ID, &rep.Target, &thread, &rep.Reason, &rep.Created)
+		if err != nil {
+			return
+		}
+
+		if len(groups) == 0 || groups[len(groups)-1].Post != rep.Target {
+			groups = append(groups, auth.ReportGroup{
+				Post:   rep.Target,
+				Thread: thread,
+			})
+		}
+		g := &groups[len(groups)-1]
+		g.Reports = append(g.Reports, rep)
+	}
+	err = r.Err()
+	return
+}
+
+// Dismiss all open reports of a post on behalf of a staff member.
+// Returns pgx.ErrNoRows, if the post has no open reports.
+func DismissReports(ctx context.Context, post uint64, by string) error {
+	return InTransaction(ctx, func(tx pgx.Tx) error {
+		return closeReports(ctx, tx, post, by, 0)
+	})
+}
+
+// Resolve all open reports of a post by performing a moderation action on
+// behalf of a staff member. The moderation log entry of the action is linked
+// to the reports.
+// Returns pgx.ErrNoRows, if the post has no open reports.
+func ResolveReports(
+	ctx context.Context,
+	post uint64,
+	by string,
+	res ReportResolution,
+) (err error) {
+	var (
+		banned bool
+		img    []byte
+	)
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var logID uint64
+		switch res.Type {
+		case common.BanPost:
+			var pubKey pgtype.Int8
+			err = tx.
+				QueryRow(
+					ctx,
+					`select public_key from posts where id = $1`,
+					post,
+				).
+				Scan(&pubKey)
+			if err != nil {
+				return
+			}
+			if pubKey.Status != pgtype.Present {
+				return common.ErrInvalidInput("post has no public key")
+			}
+
+			b := auth.BanRecord{
+				Ban: auth.Ban{
+					PublicKey: uint64(pubKey.Int),
+				},
+				ForPost: post,
+				Reason:  res.Reason,
+				By:      by,
+			}
+			if res.Duration != 0 {
+				b.Expires = time.Now().Add(res.Duration)
+			}
+			_, logID, err = insertBan(ctx, tx, b)
+			banned = true
+		case common.DeletePost, common.PurgePost, common.ShadowBinPost,
+			common.SpoilerImage, common.DeleteImage:
+			logID, img, err = moderatePost(
+				ctx,
+				tx,
+				post,
+				by,
+				res.Type,
+				res.Reason,
+			)
+		default:
+			err = common.ErrInvalidInput("unsupported report resolution")
+		}
+		if err != nil {
+			return
+		}
+
+		return closeReports(ctx, tx, post, by, logID)
+	})
+	if err != nil {
+		return
+	}
+	if banned {
+		bans.reloadAfterCommit()
+	}
+	if img != nil {
+		err = deleteImageIfUnused(ctx, img)
+	}
+	return
+}
+
+// Close all open reports of a post and link them to an optional moderation log
+// entry
+func closeReports(
+	ctx context.Context,
+	tx pgx.Tx,
+	post uint64,
+	by string,
+	logID uint64,
+) (err error) {
+	c, err := tx.Exec(
+		ctx,
+		`update reports
+		set closed_by = $2,
+			closed_on = now(),
+			resolution = nullif($3, 0)
+		where target = $1 and closed_on is null`,
+		post,
+		by,
+		logID,
+	)
+	if err != nil {
+		return
+	}
+	if c.RowsAffected() == 0 {
+		err = pgx.ErrNoRows
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestReports(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx               = context.Background()
+		reporter, _       = insertSamplePubKey(t)
+		otherReporter, _  = insertSamplePubKey(t)
+		banTarget, banKey = insertSampleThread(t)
+		dismissTarget, _  = insertSampleThread(t)
+	)
+
+	insert := func(t *testing.T, pubKey, post uint64) uint64 {
+		t.Helper()
+
+		id, err := InsertReport(ctx, pubKey, post, "rule violation")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+
+	first := insert(t, reporter, banTarget)
+	second := insert(t, otherReporter, banTarget)
+	insert(t, reporter, dismissTarget)
+
+	t.Run("duplicate", func(t *testing.T) {
+		_, err := InsertReport(ctx, reporter, banTarget, "again")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		test.AssertEquals(t, err.Error(), "post already reported")
+	})
+
+	t.Run("nonexistent post", func(t *testing.T) {
+		_, err := InsertReport(ctx, reporter, 1<<40, "test")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		groups, err := GetReportQueue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, g := range groups {
+			if g.Post != banTarget {
+				continue
+			}
+			test.AssertEquals(t, g.Thread, banTarget)
+			test.AssertEquals(t, len(g.Reports), 2)
+			test.AssertEquals(t, g.Reports[0].ID, first)
+			test.AssertEquals(t, g.Reports[1].ID, second)
+			return
+		}
+		t.Fatal("report group not found")
+	})
+
+	t.Run("resolve with ban", func(t *testing.T) {
+		err := ResolveReports(ctx, banTarget, "admin", ReportResolution{
+			Type:   common.BanPost,
+			Reason: "spam",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, IsBanned(nil, banKey), true)
+
+		err = ResolveReports(ctx, banTarget, "admin", ReportResolution{
+			Type:   common.BanPost,
+			Reason: "spam",
+		})
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("dismiss", func(t *testing.T) {
+		err := DismissReports(ctx, dismissTarget, "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = DismissReports(ctx, dismissTarget, "admin")
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Parameters of a full-text post search. Zero values of optional filters
+// disable them.
+type SearchParams struct {
+	// Search query in web search engine syntax. Required.
+	Query string
+
+	// Only match posts in threads with this tag
+	Tag string
+
+	// Only match posts in this thread
+	Thread uint64
+
+	// Only match posts created within this time range
+	From, To time.Time
+
+	// Only match posts with an image
+	HasImage bool
+
+	// Page of the results to fetch, starting at 0
+	Page uint
+
+	// Maximum number of posts on a page
+	Limit uint
+}
+
+// Search post bodies by text. Returns a JSON array of matched posts sorted by
+// relevance.
+func SearchPosts(ctx context.Context, p SearchParams) (buf []byte, err error) {
+	var (
+		args  = []interface{}{p.Query}
+		conds = []string{"p.body_tsv @@ q", "is_visible(p, 0, false)"}
+	)
+	addCond := func(format string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(format, len(args)))
+	}
+
+	if p.Tag != "" {
+		addCond(
+			`exists (
+				select
+				from threads t
+				where t.id = p.thread and t.tags @> array[$%d::varchar(20)]
+			)`,
+			p.Tag,
+		)
+	}
+	if p.Thread != 0 {
+		addCond("p.thread = $%d", p.Thread)
+	}
+	if !p.From.IsZero() {
+		addCond("p.created_on >= $%d", p.From)
+	}
+	if !p.To.IsZero() {
+		addCond("p.created_on < $%d", p.To)
+	}
+	if p.HasImage {
+		conds = append(conds, "p.image is not null")
+	}
+	args = append(args, p.Limit, p.Page*p.Limit)
+
+	err = db.
+		QueryRow(
+			ctx,
+			fmt.Sprintf(
+				`select coalesce(
+					jsonb_agg(encode(p) order by r.rank desc, p.id desc),
+					'[]'::jsonb
+				)
+				from (
+					select p.id, ts_rank(p.body_tsv, q) as rank
+					from posts p,
+						websearch_to_tsquery('simple', $1) q
+					where %s
+					order by rank desc, p.id desc
+					limit $%d
+					offset $%d
+				) r
+				join posts p on p.id = r.id`,
+				strings.Join(conds, " and "),
+				len(args)-1,
+				len(args),
+			),
+			args...,
+		).
+		Scan(&buf)
+	return
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestSearchPosts(t *testing.T) {
+	t.Parallel()
+
+	pubKey, _ := insertSamplePubKey(t)
+	thread, err := InsertThread(ThreadInsertParams{
+		Subject: "test",
+		Tags:    []string{"search_test"},
+		PostInsertParamsCommon: PostInsertParamsCommon{
+			PublicKey: &pubKey,
+			Body: []byte(`{"Siblings":[` +
+				`{"Text":"ribbon snake"},` +
+				`{"Bold":{"Text":"cucumber"}},` +
+				`{"Code":"<span class=\"source\">parsnip</span> &amp;"}` +
+				`]}`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name   string
+		params SearchParams
+		std    []uint64
+	}{
+		{
+			name: "text node",
+			params: SearchParams{
+				Query: "ribbon",
+			},
+			std: []uint64{thread},
+		},
+		{
+			name: "nested text node",
+			params: SearchParams{
+				Query: "cucumber snake",
+			},
+			std: []uint64{thread},
+		},
+		{
+			name: "code node",
+			params: SearchParams{
+				Query: "parsnip",
+			},
+			std: []uint64{thread},
+		},
+		{
+			name: "code markup not indexed",
+			params: SearchParams{
+				Query: "ribbon -span",
+			},
+			std: []uint64{thread},
+		},
+		{
+			name: "no match",
+			params: SearchParams{
+				Query: "ribbon -cucumber",
+			},
+		},
+		{
+			name: "tag filter",
+			params: SearchParams{
+				Query: "ribbon",
+				Tag:   "animu",
+			},
+		},
+		{
+			name: "thread filter",
+			params: SearchParams{
+				Query:  "ribbon",
+				Thread: thread,
+			},
+			std: []uint64{thread},
+		},
+		{
+			name: "date range",
+			params: SearchParams{
+				Query: "ribbon",
+				From:  time.Now().Add(time.Hour),
+			},
+		},
+		{
+			name: "has image",
+			params: SearchParams{
+				Query:    "ribbon",
+				HasImage: true,
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			c.params.Limit = 10
+			buf, err := SearchPosts(context.Background(), c.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var res []struct {
+				ID uint64
+			}
+			err = json.Unmarshal(buf, &res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, 0, len(res))
+			for _, p := range res {
+				ids = append(ids, p.ID)
+			}
+			if c.std == nil {
+				c.std = []uint64{}
+			}
+			test.AssertEquals(t, ids, c.std)
+		})
+	}
+}
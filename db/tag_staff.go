@@ -0,0 +1,213 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// Get the moderation level an account holds in a thread. This is the highest
+// of the account's global level and its levels in any of the thread's tags.
+// Returns common.NotStaff for nonexistent accounts.
+func GetThreadStaffLevel(ctx context.Context, account string, thread uint64) (
+	level common.ModerationLevel,
+	err error,
+) {
+	var l int16
+	err = db.
+		QueryRow(
+			ctx,
+			`select greatest(
+				(select level from accounts where id = $1),
+				(
+					select max(s.level)
+					from tag_staff s
+					join threads t on t.tags @> array[s.tag]
+					where s.account = $1 and t.id = $2
+				),
+				-1
+			)`,
+			account,
+			thread,
+		).
+		Scan(&l)
+	level = common.ModerationLevel(l)
+	return
+}
+
+// Get the moderation level an account holds in a tag. This is the higher of
+// the account's global level and its level in the tag.
+// Returns common.NotStaff for nonexistent accounts.
+func GetTagStaffLevel(ctx context.Context, account, tag string) (
+	level common.ModerationLevel,
+	err error,
+) {
+	var l int16
+	err = db.
+		QueryRow(
+			ctx,
+			`select greatest(
+				(select level from accounts where id = $1),
+				(
+					select level
+					from tag_staff
+					where account = $1 and tag = $2
+				),
+				-1
+			)`,
+			account,
+			tag,
+		).
+		Scan(&l)
+	level = common.ModerationLevel(l)
+	return
+}
+
+// Get all staff appointed to a tag ordered by descending level
+func GetTagStaff(ctx context.Context, tag string) (
+	staff []auth.TagStaff,
+	err error,
+) {
+	r, err := db.Query(
+		ctx,
+		`select account, tag, level, appointed_by, created_on
+		from tag_staff
+		where tag = $1
+		order by level desc, account`,
+		tag,
+	)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	staff = make([]auth.TagStaff, 0)
+	for r.Next() {
+		var (
+			s     auth.TagStaff
+			level int16
+		)
+		err = r.Scan(&s.Account, &s.Tag, &level, &s.AppointedBy, &s.Created)
+		if err != nil {
+			return
+		}
+		s.Level = common.ModerationLevel(level)
+		staff = append(staff, s)
+	}
+	err = r.Err()
+	return
+}
+
+// Appoint an account to a staff position in a tag on behalf of a staff
+// member or change the level of an existing position.
+// Returns common.ErrNoPermissions, if the existing position is above maxLevel.
+func AppointTagStaff(
+	ctx context.Context,
+	tag, account string,
+	level, maxLevel common.ModerationLevel,
+	by string,
+) error {
+	return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var l int16
+		err = tx.
+			QueryRow(
+				ctx,
+				`select level
+				from tag_staff
+				where account = $1 and tag = $2
+				for update`,
+				account,
+				tag,
+			).
+			Scan(&l)
+		switch err {
+		case nil:
+			if common.ModerationLevel(l) > maxLevel {
+				return common.ErrNoPermissions
+			}
+		case pgx.ErrNoRows:
+		default:
+			return
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`insert into tag_staff (account, tag, level, appointed_by)
+			values ($1, $2, $3, $4)
+			on conflict (account, tag) do update
+				set level = excluded.level,
+					appointed_by = excluded.appointed_by`,
+			account,
+			tag,
+			int16(level),
+			by,
+		)
+		if err != nil {
+			if IsForeignKeyError(err) {
+				err = pgx.ErrNoRows
+			}
+			return
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.AppointTagStaff,
+				By:   by,
+				Data: fmt.Sprintf("%s: %s %s", tag, account, level),
+			},
+		})
+		return
+	})
+}
+
+// Remove an account from its staff position in a tag on behalf of a staff
+// member.
+// Returns common.ErrNoPermissions, if the position is above maxLevel.
+func RemoveTagStaff(
+	ctx context.Context,
+	tag, account string,
+	maxLevel common.ModerationLevel,
+	by string,
+) error {
+	return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var l int16
+		err = tx.
+			QueryRow(
+				ctx,
+				`select level
+				from tag_staff
+				where account = $1 and tag = $2
+				for update`,
+				account,
+				tag,
+			).
+			Scan(&l)
+		if err != nil {
+			return
+		}
+		level := common.ModerationLevel(l)
+		if level > maxLevel {
+			return common.ErrNoPermissions
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`delete from tag_staff where account = $1 and tag = $2`,
+			account,
+			tag,
+		)
+		if err != nil {
+			return
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.RemoveTagStaff,
+				By:   by,
+				Data: fmt.Sprintf("%s: %s %s", tag, account, level),
+			},
+		})
+		return
+	})
+}
@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestTagStaff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		owner      = "tag_staff_owner"
+		otherOwner = "tag_staff_other_owner"
+		janitor    = "tag_staff_janitor"
+		global     = "tag_staff_global"
+	)
+	var (
+		ctx       = context.Background()
+		thread, _ = insertSampleThread(t)
+	)
+	for _, a := range [...]struct {
+		id    string
+		level common.ModerationLevel
+	}{
+		{owner, common.NotStaff},
+		{otherOwner, common.NotStaff},
+		{janitor, common.NotStaff},
+		{global, common.Moderator},
+	} {
+		err := RegisterAccount(ctx, a.id, []byte("hash"), a.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("appoint", func(t *testing.T) {
+		for _, c := range [...]struct {
+			tag, account string
+			level        common.ModerationLevel
+			by           string
+		}{
+			{"mango", owner, common.TagOwner, "admin"},
+			{"animu", janitor, common.Janitor, owner},
+			{"vidya", janitor, common.Moderator, owner},
+		} {
+			err := AppointTagStaff(
+				ctx,
+				c.tag,
+				c.account,
+				c.level,
+				common.TagOwner,
+				c.by,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("appoint nonexistent account", func(t *testing.T) {
+		err := AppointTagStaff(
+			ctx,
+			"mango",
+			"tag_staff_nonexistent",
+			common.Janitor,
+			common.TagOwner,
+			"admin",
+		)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		staff, err := GetTagStaff(ctx, "mango")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(staff), 1)
+		test.AssertEquals(t, staff[0].Account, owner)
+		test.AssertEquals(t, staff[0].Level, common.TagOwner)
+		test.AssertEquals(t, staff[0].AppointedBy, "admin")
+	})
+
+	t.Run("thread level", func(t *testing.T) {
+		cases := [...]struct {
+			name, account string
+			level         common.ModerationLevel
+		}{
+			{"tag owner", owner, common.TagOwner},
+			{"ignores other tags", janitor, common.Janitor},
+			{"global level", global, common.Moderator},
+			{"nonexistent", "tag_staff_nonexistent", common.NotStaff},
+		}
+		for i := range cases {
+			c := cases[i]
+			t.Run(c.name, func(t *testing.T) {
+				level, err := GetThreadStaffLevel(ctx, c.account, thread)
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEquals(t, level, c.level)
+			})
+		}
+	})
+
+	t.Run("tag level", func(t *testing.T) {
+		level, err := GetTagStaffLevel(ctx, janitor, "vidya")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, level, common.Moderator)
+
+		level, err = GetTagStaffLevel(ctx, janitor, "mango")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, level, common.NotStaff)
+	})
+
+	t.Run("demote above max level", func(t *testing.T) {
+		err := AppointTagStaff(
+			ctx,
+			"tag_staff_demote",
+			otherOwner,
+			common.TagOwner,
+			common.TagOwner,
+			"admin",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = AppointTagStaff(
+			ctx,
+			"tag_staff_demote",
+			otherOwner,
+			common.Janitor,
+			common.Janitor,
+			owner,
+		)
+		test.AssertEquals(t, err, common.ErrNoPermissions)
+
+		level, err := GetTagStaffLevel(ctx, otherOwner, "tag_staff_demote")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, level, common.TagOwner)
+	})
+
+	t.Run("remove above max level", func(t *testing.T) {
+		err := RemoveTagStaff(ctx, "vidya", janitor, common.Janitor, owner)
+		test.AssertEquals(t, err, common.ErrNoPermissions)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := RemoveTagStaff(ctx, "animu", janitor, common.Janitor, owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		level, err := GetThreadStaffLevel(ctx, janitor, thread)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, level, common.NotStaff)
+
+		err = RemoveTagStaff(ctx, "animu", janitor, common.Janitor, owner)
+		test.AssertEquals(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("mod log", func(t *testing.T) {
+		for _, c := range [...]struct {
+			typ common.ModerationAction
+			n   int
+		}{
+			{common.AppointTagStaff, 4},
+			{common.RemoveTagStaff, 1},
+		} {
+			typ := c.typ
+			entries, err := GetModLog(ctx, ModLogFilter{
+				Type: &typ,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, len(entries), c.n)
+		}
+	})
+}
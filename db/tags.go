@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Recalculate the tag statistics materialized view
+func refreshTagStats() (err error) {
+	_, err = db.Exec(
+		context.Background(),
+		`refresh materialized view concurrently tag_stats`,
+	)
+	return
+}
+
+// Get statistics of all tags active within the specified time window as a
+// JSON array.
+// The statistics are refreshed once a minute.
+func GetTagStats(
+	ctx context.Context,
+	period common.TagStatsPeriod,
+	mode common.TagSortMode,
+) (
+	buf []byte,
+	err error,
+) {
+	var order string
+	switch mode {
+	case common.SortTagsByThreadCount:
+		order = "s.thread_count desc"
+	case common.SortTagsByPostCount:
+		order = "s.post_count desc"
+	case common.SortTagsByUniquePosters:
+		order = "s.unique_posters desc"
+	case common.SortTagsByLastActivity:
+		order = "s.last_activity desc"
+	default:
+		order = "s.unique_posters desc, s.post_count desc"
+	}
+
+	p, err := period.MarshalText()
+	if err != nil {
+		return
+	}
+	err = db.
+		QueryRow(
+			ctx,
+			fmt.Sprintf(
+				`select coalesce(
+					jsonb_agg(encode(s) order by %s, s.tag),
+					'[]'::jsonb
+				)
+				from tag_stats s
+				where s.period = $1`,
+				order,
+			),
+			string(p),
+		).
+		Scan(&buf)
+	return
+}
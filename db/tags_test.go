@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+)
+
+func TestGetTagStats(t *testing.T) {
+	t.Parallel()
+
+	const tag = "tag_stats_test"
+
+	pubKey, _ := insertSamplePubKey(t)
+	_, err := InsertThread(ThreadInsertParams{
+		Subject: "test",
+		Tags:    []string{tag},
+		PostInsertParamsCommon: PostInsertParamsCommon{
+			PublicKey: &pubKey,
+			Body:      []byte("{}"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = refreshTagStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type stats struct {
+		Tag           string `json:"tag"`
+		ThreadCount   uint64 `json:"thread_count"`
+		PostCount     uint64 `json:"post_count"`
+		UniquePosters uint64 `json:"unique_posters"`
+	}
+
+	for _, p := range [...]common.TagStatsPeriod{
+		common.TagStatsHour,
+		common.TagStatsDay,
+		common.TagStatsWeek,
+	} {
+		buf, err := GetTagStats(
+			context.Background(),
+			p,
+			common.SortTagsByTrending,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var res []stats
+		err = json.Unmarshal(buf, &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found bool
+		for _, s := range res {
+			if s.Tag == tag {
+				found = true
+				test.AssertEquals(t, s, stats{
+					Tag:           tag,
+					ThreadCount:   1,
+					PostCount:     1,
+					UniquePosters: 1,
+				})
+			}
+		}
+		if !found {
+			t.Fatalf("no stats for tag in period %d", p)
+		}
+	}
+}
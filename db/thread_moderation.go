@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// Lock or unlock a thread on behalf of a staff member. Locked threads do not
+// accept new replies.
+func SetThreadLocked(
+	ctx context.Context,
+	id uint64,
+	locked bool,
+	by string,
+) error {
+	action := common.LockThread
+	if !locked {
+		action = common.UnlockThread
+	}
+	return setThreadFlag(ctx, id, "locked", locked, action, by)
+}
+
+// Sticky or unsticky a thread on behalf of a staff member. Sticky threads are
+// pinned to the top of the thread index and tag views.
+func SetThreadSticky(
+	ctx context.Context,
+	id uint64,
+	sticky bool,
+	by string,
+) error {
+	action := common.StickyThread
+	if !sticky {
+		action = common.UnstickyThread
+	}
+	return setThreadFlag(ctx, id, "sticky", sticky, action, by)
+}
+
+// Set a boolean thread column and log the change to the moderation log.
+// Returns pgx.ErrNoRows, if the thread does not exist.
+func setThreadFlag(
+	ctx context.Context,
+	id uint64,
+	column string,
+	val bool,
+	action common.ModerationAction,
+	by string,
+) error {
+	return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		c, err := tx.Exec(
+			ctx,
+			`update threads set `+column+` = $2 where id = $1`,
+			id,
+			val,
+		)
+		if err != nil {
+			return
+		}
+		if c.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		_, err = logModAction(ctx, tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: action,
+				By:   by,
+			},
+			Thread: id,
+		})
+		return
+	})
+}
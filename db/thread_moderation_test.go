@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/test"
+	"github.com/jackc/pgx/v4"
+)
+
+func TestLockThread(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx            = context.Background()
+		thread, pubKey = insertSampleThread(t)
+	)
+
+	insert := func() error {
+		return InTransaction(ctx, func(tx pgx.Tx) (err error) {
+			_, _, err = InsertPost(tx, ReplyInsertParams{
+				Thread: thread,
+				PostInsertParamsCommon: PostInsertParamsCommon{
+					PublicKey: &pubKey,
+					Body:      []byte("{}"),
+				},
+			})
+			return
+		})
+	}
+
+	err := SetThreadLocked(ctx, thread, true, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, insert(), common.ErrThreadLocked)
+
+	err = SetThreadLocked(ctx, thread, false, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = insert()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = SetThreadLocked(ctx, 1<<40, true, "admin")
+	test.AssertEquals(t, err, pgx.ErrNoRows)
+}
+
+func TestStickyThread(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var threads [2]uint64
+	for i := range threads {
+		pubKey, _ := insertSamplePubKey(t)
+		var err error
+		threads[i], err = InsertThread(ThreadInsertParams{
+			Subject: "test",
+			Tags:    []string{"sticky"},
+			PostInsertParamsCommon: PostInsertParamsCommon{
+				PublicKey: &pubKey,
+				Body:      []byte("{}"),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The older thread must be pinned above the more recently bumped one
+	err := SetThreadSticky(ctx, threads[0], true, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := GetTagThreadIDs("sticky", common.SortByBumpTime, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, ids, []uint64{threads[0], threads[1]})
+
+	entries, err := GetModLog(ctx, ModLogFilter{
+		Thread: threads[0],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, len(entries), 1)
+	test.AssertEquals(t, entries[0].Type, common.StickyThread)
+}
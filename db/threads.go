@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/pg_util"
 	"github.com/jackc/pgx/v4"
 )
@@ -43,6 +45,20 @@ func InsertThread(p ThreadInsertParams) (id uint64, err error) {
 	return
 }
 
+// Call fn with the ID of each thread deleted from the database
+func ListenThreadDeletion(fn func(id uint64) error) error {
+	return Listen(pg_util.ListenOpts{
+		Channel: "thread.deleted",
+		OnMsg: func(msg string) error {
+			arr, err := SplitUint64s(msg, 1)
+			if err != nil {
+				return err
+			}
+			return fn(arr[0])
+		},
+	})
+}
+
 // Check, if thread exists in the database
 func ThreadExists(ctx context.Context, id uint64) (exists bool, err error) {
 	err = db.
@@ -82,6 +98,7 @@ func GetFeedData() (buf []byte, err error) {
 					) val
 				from posts r
 				where r.created_on > now() - interval '16 minutes'
+					and is_visible(r, 0, false)
 				group by r.thread
 			) r on r.thread = t.id
 			left join (
@@ -98,7 +115,7 @@ func GetFeedData() (buf []byte, err error) {
 						)
 					) val
 				from posts o
-				where o.open
+				where o.open and is_visible(o, 0, false)
 				group by o.thread
 			) o on o.thread = t.id`,
 		).
@@ -130,9 +147,103 @@ func GetThread(id uint64, page int) (thread []byte, err error) {
 	return
 }
 
-// Get all existing thread IDs
-func GetThreadIDs() (ids []uint64, err error) {
-	r, err := db.Query(context.Background(), "select id from threads")
+// Like GetThread, but includes posts hidden by moderation, that are visible to
+// the viewer. Staff can see all hidden posts and their moderation state.
+// Authors can see their own shadow-binned posts.
+//
+// viewer: public key ID of the viewer or 0, if unknown
+func GetThreadFor(
+	ctx context.Context,
+	id uint64,
+	page int,
+	viewer uint64,
+	isStaff bool,
+) (thread []byte, err error) {
+	err = db.
+		QueryRow(
+			ctx,
+			"select get_thread($1, $2, $3, $4)",
+			id,
+			page,
+			viewer,
+			isStaff,
+		).
+		Scan(&thread)
+	castNoRows(&thread, &err)
+	return
+}
+
+// Get a page of thread IDs sorted by the specified sort mode
+//
+// page: page of the index to fetch, starting at 0
+// limit: maximum number of thread IDs on a page
+func GetThreadIDs(mode common.ThreadSortMode, page, limit uint) (
+	[]uint64,
+	error,
+) {
+	return getThreadIDs("", mode, page, limit)
+}
+
+// Get a page of IDs of threads with the specified tag sorted by the specified
+// sort mode
+//
+// page: page of the index to fetch, starting at 0
+// limit: maximum number of thread IDs on a page; 0 for no limit
+func GetTagThreadIDs(
+	tag string,
+	mode common.ThreadSortMode,
+	page, limit uint,
+) (
+	[]uint64,
+	error,
+) {
+	return getThreadIDs(tag, mode, page, limit)
+}
+
+// Get sorted thread IDs, optionally filtered by a tag and limited to a page
+func getThreadIDs(
+	tag string,
+	mode common.ThreadSortMode,
+	page, limit uint,
+) (
+	ids []uint64,
+	err error,
+) {
+	var (
+		where, order string
+		args         = make([]interface{}, 0, 3)
+	)
+	if tag != "" {
+		args = append(args, tag)
+		where = "where t.tags @> array[$1::varchar(20)]"
+	}
+
+	switch mode {
+	case common.SortByCreationTime:
+		order = "t.created_on desc"
+	case common.SortByReplyCount:
+		order = "t.post_count desc"
+	case common.SortByImageCount:
+		order = "t.image_count desc"
+	default:
+		order = "t.bumped_on desc"
+	}
+
+	// Sticky threads are always pinned to the top
+	q := fmt.Sprintf(
+		`select t.id
+		from threads t
+		%s
+		order by t.sticky desc, %s, t.id desc`,
+		where,
+		order,
+	)
+	if limit != 0 {
+		args = append(args, limit, page*limit)
+		q += fmt.Sprintf(" limit $%d offset $%d", len(args)-1, len(args))
+	}
+
+	r, err := db.Query(context.Background(), q, args...)
 	if err != nil {
 		return
 	}
@@ -150,6 +261,53 @@ func GetThreadIDs() (ids []uint64, err error) {
 	return
 }
 
+// Compare known thread post counts to the current ones and return a JSON object
+// of changed threads mapped by ID. Each changed thread contains its current
+// post count and last bump time. Deleted threads are mapped to null.
+//
+// known: post counts of threads mapped by thread ID
+func DiffThreadPostCounts(ctx context.Context, known map[uint64]uint64) (
+	buf []byte,
+	err error,
+) {
+	var (
+		ids    = make([]uint64, 0, len(known))
+		counts = make([]uint64, 0, len(known))
+	)
+	for id, n := range known {
+		ids = append(ids, id)
+		counts = append(counts, n)
+	}
+
+	err = db.
+		QueryRow(
+			ctx,
+			`select coalesce(
+				jsonb_object_agg(
+					k.id,
+					case
+						when t.id is null then null
+						else jsonb_build_object(
+							'post_count', c.val,
+							'bumped_on', to_unix(t.bumped_on)
+						)
+					end
+				),
+				'{}'::jsonb
+			)
+			from unnest($1::bigint[], $2::bigint[]) as k(id, known)
+			left join threads t on t.id = k.id
+			left join lateral (
+				select post_count(t.id) val
+			) c on true
+			where t.id is null or c.val != k.known`,
+			ids,
+			counts,
+		).
+		Scan(&buf)
+	return
+}
+
 // Get the number of the last page of a thread
 func GetLastPage(id uint64) (n int, err error) {
 	err = db.
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/test"
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
@@ -116,6 +117,29 @@ func TestGetFeedData(t *testing.T) {
 		}
 	}
 
+	// Hidden open posts must not be exposed
+	err = InTransaction(ctx, func(tx pgx.Tx) (err error) {
+		var hidden uint64
+		hidden, _, err = InsertPost(tx, ReplyInsertParams{
+			Thread: threads[0],
+			PostInsertParamsCommon: PostInsertParamsCommon{
+				Body: []byte("{}"),
+			},
+		})
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(
+			ctx,
+			`update posts set deleted = true where id = $1`,
+			hidden,
+		)
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	buf, err := GetFeedData()
 	if err != nil {
 		t.Fatal(err)
@@ -196,6 +220,8 @@ func TestReadThreads(t *testing.T) {
 			"bumped_on":   unix,
 			"subject":     "test",
 			"tags":        []string{"animu", "mango"},
+			"locked":      false,
+			"sticky":      false,
 			"posts":       []map[string]interface{}{genPost(id, id, 0)},
 		}
 	}
@@ -389,7 +415,7 @@ func TestReadThreads(t *testing.T) {
 	t.Run("get thread IDs", func(t *testing.T) {
 		t.Parallel()
 
-		ids, err := GetThreadIDs()
+		ids, err := GetThreadIDs(common.SortByBumpTime, 0, 10)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -397,6 +423,23 @@ func TestReadThreads(t *testing.T) {
 		test.AssertEquals(t, ids, []uint64{thread, thread2})
 	})
 
+	t.Run("get tag thread IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ids, err := GetTagThreadIDs("animu", common.SortByCreationTime, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Sort(idSorter(ids))
+		test.AssertEquals(t, ids, []uint64{thread, thread2})
+
+		ids, err = GetTagThreadIDs("nope", common.SortByCreationTime, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(ids), 0)
+	})
+
 	t.Run("get page counts", func(t *testing.T) {
 		t.Parallel()
 
@@ -463,3 +506,151 @@ func TestReadThreads(t *testing.T) {
 		test.AssertEquals(t, page, uint32(0))
 	})
 }
+
+func TestDiffThreadPostCounts(t *testing.T) {
+	t.Parallel()
+
+	unchanged, _ := insertSampleThread(t)
+	changed, pubKey := insertSampleThread(t)
+	const deleted = 456636351
+
+	err := InTransaction(context.Background(), func(tx pgx.Tx) (err error) {
+		_, _, err = InsertPost(tx, ReplyInsertParams{
+			Thread: changed,
+			PostInsertParamsCommon: PostInsertParamsCommon{
+				PublicKey: &pubKey,
+				Body:      []byte("{}"),
+			},
+		})
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bumpTime int64
+	err = db.
+		QueryRow(
+			context.Background(),
+			`select to_unix(bumped_on)
+			from threads
+			where id = $1`,
+			changed,
+		).
+		Scan(&bumpTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := DiffThreadPostCounts(context.Background(), map[uint64]uint64{
+		unchanged: 1,
+		changed:   1,
+		deleted:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertJSON(t, bytes.NewReader(buf), map[uint64]interface{}{
+		changed: map[string]interface{}{
+			"post_count": 2,
+			"bumped_on":  bumpTime,
+		},
+		deleted: nil,
+	})
+}
+
+func TestThreadSortByCounters(t *testing.T) {
+	const tag = "thread_counters"
+
+	img, _, closeFiles := prepareSampleImage(t)
+	defer closeFiles()
+
+	var ids [2]uint64
+	for i := range ids {
+		pubKey, _ := insertSamplePubKey(t)
+		id, err := InsertThread(ThreadInsertParams{
+			Subject: "test",
+			Tags:    []string{tag},
+			PostInsertParamsCommon: PostInsertParamsCommon{
+				PublicKey: &pubKey,
+				Body:      []byte("{}"),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+
+		err = InTransaction(context.Background(), func(tx pgx.Tx) (err error) {
+			if i == 0 {
+				// More replies
+				for j := 0; j < 2; j++ {
+					_, _, err = InsertPost(tx, ReplyInsertParams{
+						Thread: id,
+						PostInsertParamsCommon: PostInsertParamsCommon{
+							Body: []byte("{}"),
+						},
+					})
+					if err != nil {
+						return
+					}
+				}
+				return
+			}
+
+			// More images
+			_, _, err = InsertImage(
+				context.Background(),
+				tx,
+				pubKey,
+				img.SHA1,
+				"fuko_da.jpeg",
+				false,
+			)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, c := range [...]struct{ postCount, imageCount uint64 }{
+		{3, 0},
+		{1, 1},
+	} {
+		var postCount, imageCount uint64
+		err := db.
+			QueryRow(
+				context.Background(),
+				`select post_count, image_count
+				from threads
+				where id = $1`,
+				ids[i],
+			).
+			Scan(&postCount, &imageCount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, postCount, c.postCount)
+		test.AssertEquals(t, imageCount, c.imageCount)
+	}
+
+	cases := [...]struct {
+		name string
+		mode common.ThreadSortMode
+		std  []uint64
+	}{
+		{"reply count", common.SortByReplyCount, []uint64{ids[0], ids[1]}},
+		{"image count", common.SortByImageCount, []uint64{ids[1], ids[0]}},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := GetTagThreadIDs(tag, c.mode, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, res, c.std)
+		})
+	}
+}
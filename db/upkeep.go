@@ -6,9 +6,17 @@ import (
 	"context"
 	"time"
 
+	"github.com/bakape/meguca/config"
 	"github.com/go-playground/log"
 )
 
+// Thread retention is extended by another full thread expiry period for each
+// pruneRetentionStep posts in the thread, up to pruneMaxRetentionSteps times
+const (
+	pruneRetentionStep     = 100
+	pruneMaxRetentionSteps = 3
+)
+
 // Run database clean up tasks at server start and regular intervals. Must be
 // launched in separate goroutine.
 func runCleanupTasks() {
@@ -37,6 +45,7 @@ func runCleanupTasks() {
 				)
 				return err
 			})
+			logError("tag stats refresh", refreshTagStats)
 		case <-hour:
 			runHourTasks()
 		}
@@ -44,9 +53,9 @@ func runCleanupTasks() {
 }
 
 func runHourTasks() {
-	// TODO
-	// logError("thread cleanup", deleteOldThreads())
-	// logError("image cleanup", deleteUnusedImages())
+	logError("thread cleanup", deleteOldThreads)
+	// Pruned threads can leave images without any posts referencing them
+	logError("image cleanup", deleteUnusedImages)
 }
 
 func logError(prefix string, fn func() error) {
@@ -113,83 +122,33 @@ func logError(prefix string, fn func() error) {
 // 	return nil
 // }
 
-// // Delete stale threads. Thread retention measured in a bump time threshold,
-// // that is calculated as a function of post count till bump limit with an N days
-// // floor and ceiling.
-// func deleteOldThreads() (err error) {
-// 	conf := config.Get()
-// 	if !conf.PruneThreads {
-// 		return
-// 	}
-
-// 	return InTransaction(func(tx *pgx.Tx) (err error) {
-// 		// TODO: Store all dates as timestamptz and simplify this deletion code
-
-// 		// Find threads to delete
-// 		r, err := db.
-// 			Query(
-// 				fmt.Sprintf(
-// 					`delete from threads
-// 					where bump_time < (now() - )
-// 						t.id,
-// 						bump_time,
-// 						post_count(t.id),
-// 						(
-// 							select exists (
-// 								select
-// 								from post_moderation
-// 								where post_id = t.id and type = %d
-// 							)
-// 						)
-// 					from threads t
-// 					join posts p on t.id = p.id`,
-// 					common.DeletePost,
-// 				),
-// 			)
-// 		if err != nil {
-// 			return
-// 		}
-
-// 		var (
-// 			now           = time.Now().Unix()
-// 			expiry        = float64(*24 * 3600)
-// 			toDel         = make([]uint64, 0, 16)
-// 			id, postCount uint64
-// 			bumpTime      int64
-// 			deleted       sql.NullBool
-// 		)
-// 		for r.Next() {
-// 			err = r.Scan(&id, &bumpTime, &postCount, &deleted)
-// 			if err != nil {
-// 				return
-// 			}
-// 			threshold := min + (-max+min)*math.Pow(float64(postCount)/common.BumpLimit-1, 3)
-// 			if deleted.Bool {
-// 				threshold /= 3
-// 			}
-// 			if threshold < min {
-// 				threshold = min
-// 			}
-// 			if float64(now-bumpTime) > threshold {
-// 				toDel = append(toDel, id)
-// 			}
-// 		}
-
-// 		var q *sql.Stmt
-// 		if len(toDel) != 0 {
-// 			// Deleted any matched threads
-// 			q, err = tx.Prepare(`delete from threads where id = $1`)
-// 			if err != nil {
-// 				return
-// 			}
-// 			for _, id := range toDel {
-// 				_, err = q.Exec(id)
-// 				if err != nil {
-// 					return
-// 				}
-// 			}
-// 		}
+// Delete stale threads, if thread pruning is enabled
+func deleteOldThreads() error {
+	conf := config.Get()
+	if !conf.PruneThreads {
+		return nil
+	}
+	return pruneThreads(context.Background(), conf.ThreadExpiry)
+}
 
-// 		return
-// 	})
-// }
+// Delete threads not bumped within their retention period. The retention
+// period is the thread expiry in days, extended by one more expiry for every
+// full pruneRetentionStep posts in the thread.
+// Sticky threads are never pruned.
+//
+// Clients and caches are notified of each deletion through the
+// thread.deleted channel.
+func pruneThreads(ctx context.Context, expiry uint) (err error) {
+	_, err = db.Exec(
+		ctx,
+		`delete from threads t
+		where not t.sticky
+			and t.bumped_on < now() - $1::int * interval '1 day' * (
+				1 + least(floor(post_count(t.id)::float8 / $2), $3)
+			)`,
+		expiry,
+		pruneRetentionStep,
+		pruneMaxRetentionSteps,
+	)
+	return
+}
@@ -30,3 +30,73 @@ func assertThreadDeleted(t *testing.T, id uint64, del bool) {
 	q := fmt.Sprintf(`from threads where id = '%d'`, id)
 	assertDeleted(t, q, del)
 }
+
+func TestPruneThreads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Insert a thread bumped the specified number of days ago with n extra
+	// posts
+	insert := func(t *testing.T, days, n int, sticky bool) uint64 {
+		t.Helper()
+
+		id, _ := insertSampleThread(t)
+		_, err := db.Exec(
+			ctx,
+			`insert into posts (thread, body)
+			select $1, '{}'
+			from generate_series(1, $2)`,
+			id,
+			n,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(
+			ctx,
+			`update threads
+			set bumped_on = now() - $2::int * interval '1 day',
+				sticky = $3
+			where id = $1`,
+			id,
+			days,
+			sticky,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+
+	cases := [...]struct {
+		name            string
+		days, posts     int
+		sticky, deleted bool
+	}{
+		{"fresh", 1, 0, false, false},
+		{"expired", 8, 0, false, true},
+		{"below retention step", 8, 50, false, true},
+		{"extended by post count", 13, 100, false, false},
+		{"extension expired", 15, 100, false, true},
+		{"extension capped", 29, 1000, false, true},
+		{"sticky", 30, 0, true, false},
+	}
+	ids := make([]uint64, len(cases))
+	for i, c := range cases {
+		ids[i] = insert(t, c.days, c.posts, c.sticky)
+	}
+
+	err := pruneThreads(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range cases {
+		c := cases[i]
+		id := ids[i]
+		t.Run(c.name, func(t *testing.T) {
+			assertThreadDeleted(t, id, c.deleted)
+		})
+	}
+}
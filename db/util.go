@@ -20,7 +20,14 @@ func InTransaction(ctx context.Context, fn func(pgx.Tx) error) (err error) {
 
 // IsConflictError returns if an error is a unique key conflict error
 func IsConflictError(err error) bool {
-	return extractException(err) == "unique_violation"
+	err_, ok := err.(*pgconn.PgError)
+	return ok && err_.Code == "23505" // unique_violation
+}
+
+// IsForeignKeyError returns if an error is a foreign key violation error
+func IsForeignKeyError(err error) bool {
+	err_, ok := err.(*pgconn.PgError)
+	return ok && err_.Code == "23503" // foreign_key_violation
 }
 
 // Listen assigns a function to listen to Postgres notifications on a channel.
@@ -60,14 +67,6 @@ fail:
 	return
 }
 
-// Try to extract an exception message, if err is *pq.Error
-func extractException(err error) string {
-	if err, ok := err.(*pgconn.PgError); ok {
-		return err.Message
-	}
-	return ""
-}
-
 type idSorter []uint64
 
 func (p idSorter) Len() int           { return len(p) }
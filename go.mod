@@ -32,6 +32,7 @@ require (
 	github.com/satori/go.uuid v1.2.0
 	github.com/ulikunitz/xz v0.5.7
 	github.com/valyala/quicktemplate v1.5.0
+	golang.org/x/crypto v0.0.0-20200323165209-0ec3e9974c59
 	gopkg.in/alexcesaro/quotedprintable.v3 v3.0.0-20150716171945-2caba252f4dc // indirect
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 	nhooyr.io/websocket v1.8.6
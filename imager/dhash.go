@@ -0,0 +1,67 @@
+package imager
+
+import "image"
+
+// Dimensions of the grid perceptual hashes are computed over. Each row yields
+// 8 bits by comparing 9 adjacent cells.
+const (
+	dHashWidth  = 9
+	dHashHeight = 8
+)
+
+// Compute a 64 bit perceptual difference hash of an image.
+//
+// The image is reduced to a grid of average cell luminances and each bit is
+// set, if a cell is brighter than its right neighbour. The hash is resistant
+// to scaling, re-encoding and minor color adjustments, so similar images have
+// hashes with a small Hamming distance.
+func dHash(img image.Image) uint64 {
+	var (
+		cells  [dHashHeight][dHashWidth]float64
+		bounds = img.Bounds()
+		w, h   = bounds.Dx(), bounds.Dy()
+	)
+	if w == 0 || h == 0 {
+		return 0
+	}
+
+	for y := 0; y < dHashHeight; y++ {
+		y0, y1 := cellBounds(y, h, dHashHeight)
+		for x := 0; x < dHashWidth; x++ {
+			x0, x1 := cellBounds(x, w, dHashWidth)
+			var sum float64
+			for py := y0; py < y1; py++ {
+				for px := x0; px < x1; px++ {
+					r, g, b, _ := img.
+						At(bounds.Min.X+px, bounds.Min.Y+py).
+						RGBA()
+					sum += 0.299*float64(r) + 0.587*float64(g) +
+						0.114*float64(b)
+				}
+			}
+			cells[y][x] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+
+	var hash uint64
+	for y := 0; y < dHashHeight; y++ {
+		for x := 0; x < dHashWidth-1; x++ {
+			hash <<= 1
+			if cells[y][x] > cells[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// Return the pixel range of the i-th of n cells along a dimension of size
+// pixels. Cells of images smaller than the grid span at least one pixel.
+func cellBounds(i, size, n int) (start, end int) {
+	start = i * size / n
+	end = (i + 1) * size / n
+	if end <= start {
+		end = start + 1
+	}
+	return
+}
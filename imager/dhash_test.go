@@ -0,0 +1,54 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	"math/bits"
+	"testing"
+)
+
+// Generate a horizontal gradient image with a dark band in the middle
+func genGradient(w, h int, flip bool) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := uint8(x * 255 / w)
+			if y > h/3 && y < 2*h/3 {
+				v /= 2
+			}
+			if flip {
+				v = 255 - v
+			}
+			img.Set(x, y, color.RGBA{v, v, v, 255})
+		}
+	}
+	return img
+}
+
+func TestDHash(t *testing.T) {
+	t.Parallel()
+
+	std := dHash(genGradient(150, 100, false))
+
+	cases := [...]struct {
+		name     string
+		img      image.Image
+		min, max int
+	}{
+		{"identical", genGradient(150, 100, false), 0, 0},
+		{"scaled", genGradient(75, 50, false), 0, 4},
+		{"tiny", genGradient(5, 4, false), 0, 32},
+		{"inverted", genGradient(150, 100, true), 48, 64},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := bits.OnesCount64(std ^ dHash(c.img))
+			if d < c.min || d > c.max {
+				t.Fatalf("distance %d not in range [%d, %d]", d, c.min, c.max)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/bakape/meguca/websockets"
 
+	"github.com/bakape/meguca/cache"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/imager/assets"
@@ -38,6 +39,11 @@ func TestMain(m *testing.M) {
 		if err != nil {
 			return
 		}
+		config.Server.CacheSize = 100
+		err = cache.Init()
+		if err != nil {
+			return
+		}
 
 		code = m.Run()
 		return assets.DeleteDirs()
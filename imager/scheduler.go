@@ -1,11 +1,14 @@
 package imager
 
 import (
+	"crypto/md5"
 	"crypto/sha1"
 	"hash"
 	"io"
 	"mime/multipart"
 	"runtime"
+
+	"github.com/bakape/meguca/common"
 )
 
 var (
@@ -103,11 +106,24 @@ func hashFile(
 }
 
 func processRequest(req thumbnailingRequest) (err error) {
-	var id [20]byte
+	var (
+		id      common.SHA1Hash
+		md5Hash common.MD5Hash
+	)
 	_, err = hashFile(id[:], req.file, sha1.New())
 	if err != nil {
 		return
 	}
+	_, err = hashFile(md5Hash[:], req.file, md5.New())
+	if err != nil {
+		return
+	}
+
+	// Reject banned images before any further processing
+	err = assertImageNotBanned(req.ctx, id, &md5Hash)
+	if err != nil {
+		return
+	}
 
 	err = tryInsertExisting(req.insertionRequest, id)
 	if err != errNotProcessed {
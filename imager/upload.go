@@ -24,6 +24,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/cache"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
@@ -172,6 +173,52 @@ func validateUploader(w http.ResponseWriter, r *http.Request) (
 	pubKeyID uint64,
 	err error,
 ) {
+	return ValidateRequester(r, config.Get().SpamScores.Image)
+}
+
+// Authenticate a request signed by a client's public key and apply security
+// restrictions to the requester. Increments the requester's spam score by
+// spamScore.
+func ValidateRequester(r *http.Request, spamScore uint64) (
+	pubKeyID uint64,
+	err error,
+) {
+	pubKeyID, err = VerifyPublicKey(r)
+	if err != nil {
+		return
+	}
+
+	ip, err := auth.GetIP(r)
+	if err != nil {
+		return
+	}
+	err = db.RecordPublicKeyIP(r.Context(), pubKeyID, ip)
+	if err != nil {
+		return
+	}
+	if db.IsBanned(ip, pubKeyID) {
+		err = common.ErrBanned
+		return
+	}
+
+	need, err := db.NeedCaptcha(r.Context(), pubKeyID)
+	if err != nil {
+		return
+	}
+	if need {
+		err = common.StatusError{
+			Err:  errors.New("captcha required"),
+			Code: 403,
+		}
+		return
+	}
+	db.IncrementSpamScore(pubKeyID, spamScore)
+	return
+}
+
+// Authenticate a request signed by a client's public key without applying any
+// further restrictions to the requester
+func VerifyPublicKey(r *http.Request) (pubKeyID uint64, err error) {
 	type keyStore struct {
 		id  uint64
 		key *rsa.PublicKey
@@ -265,19 +312,6 @@ func validateUploader(w http.ResponseWriter, r *http.Request) (
 		}
 		return
 	}
-
-	need, err := db.NeedCaptcha(r.Context(), pubKeyID)
-	if err != nil {
-		return
-	}
-	if need {
-		err = common.StatusError{
-			Err:  errors.New("captcha required"),
-			Code: 403,
-		}
-		return
-	}
-	db.IncrementSpamScore(pubKeyID, config.Get().SpamScores.Image)
 	return
 }
 
@@ -351,11 +385,54 @@ func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			return
 		}
+		err = assertImageNotBanned(req.ctx, req.id, nil)
+		if err != nil {
+			return
+		}
 
 		return tryInsertExisting(req.insertionRequest, req.id)
 	})
 }
 
+// Reject images banned by either of their hashes or already stored images
+// perceptually similar to a banned image.
+// If md5Hash is nil, only the MD5 hash of an already stored image is matched.
+func assertImageNotBanned(
+	ctx context.Context,
+	sha1Hash common.SHA1Hash,
+	md5Hash *common.MD5Hash,
+) error {
+	banned, err := db.IsImageBanned(
+		ctx,
+		sha1Hash,
+		md5Hash,
+		config.Get().BannedImageDistance,
+	)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return common.ErrImageBanned
+	}
+	return nil
+}
+
+// Reject newly thumbnailed images perceptually similar to any banned image
+func assertNotSimilarToBanned(ctx context.Context, dhash int64) error {
+	dist := config.Get().BannedImageDistance
+	if dist == 0 {
+		return nil
+	}
+	banned, err := db.IsSimilarImageBanned(ctx, dhash, dist)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return common.ErrImageBanned
+	}
+	return nil
+}
+
 // Try finding and inserting an already processed image into the post
 func tryInsertExisting(req insertionRequest, id common.SHA1Hash,
 ) error {
@@ -386,6 +463,7 @@ func insertImage(tx pgx.Tx, req insertionRequest, img common.ImageCommon,
 	)
 	switch err {
 	case nil:
+		cache.EvictPost(post)
 		return websockets.InsertImage(thread, post, common.Image{
 			ImageCommon: img,
 			Spoilered:   req.spoiler,
@@ -454,6 +532,12 @@ func insertNewThumbnail(req thumbnailingRequest, id common.SHA1Hash) (err error)
 		}
 		return
 	}
+	if img.DHash != nil {
+		err = assertNotSimilarToBanned(req.ctx, *img.DHash)
+		if err != nil {
+			return
+		}
+	}
 
 	// Being done in one transaction prevents the image DB record from getting
 	// garbage-collected between the calls
@@ -530,6 +614,9 @@ func processFile(
 		b := thumbImage.Bounds()
 		img.ThumbWidth = uint16(b.Dx())
 		img.ThumbHeight = uint16(b.Dy())
+
+		h := int64(dHash(thumbImage))
+		img.DHash = &h
 	}
 
 	n, err := hashFile(img.MD5[:], f, md5.New())
@@ -2,10 +2,24 @@
 
 package main
 
-import "github.com/bakape/meguca/server"
+import (
+	"fmt"
+	"os"
+
+	"github.com/bakape/meguca/server"
+)
 
 func main() {
-	err := server.Start()
+	var err error
+	switch {
+	case len(os.Args) == 1:
+		err = server.Start()
+	case len(os.Args) == 3 && os.Args[1] == "create-admin":
+		err = server.CreateAdmin(os.Args[2])
+	default:
+		fmt.Fprintln(os.Stderr, "usage: meguca [create-admin <id>]")
+		os.Exit(2)
+	}
 	if err != nil {
 		panic(err)
 	}
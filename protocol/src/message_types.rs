@@ -48,4 +48,20 @@ pub enum MessageType {
 
 	// Send server's current Unix timestamp
 	CurrentTime,
+
+	// Report a post to staff
+	Report,
+
+	// Acknowledgment of a post report. Response to Report from server.
+	ReportAck,
+
+	// Moderation action applied to a post
+	ModeratePost,
+
+	// Thread deleted from the server
+	RemoveThread,
+
+	// Body of an open post replaced by the server after applying post body
+	// filters. Only sent to the author of the post.
+	ResetOpenBody,
 }
@@ -92,6 +92,12 @@ payload! { PostCreationReq {
 	opts: NewPostOpts,
 }}
 
+// Request to report a post to staff
+payload! { ReportReq {
+	post: u64,
+	reason: String,
+}}
+
 payload! { PostCreationNotice {
 	id: u64,
 	thread: u64,
@@ -256,3 +262,34 @@ payload! { InsertImage {
 	post: u64,
 	image: Image,
 }}
+
+// Moderation actions applicable to a single post
+#[derive(Serialize, Deserialize, Debug, Copy, Clone, PartialEq, Eq)]
+pub enum PostModeration {
+	// Hide post from everyone except staff
+	Delete,
+
+	// Remove post body and image and hide post from everyone except staff
+	Purge,
+
+	// Hide post from everyone except its author and staff
+	ShadowBin,
+
+	// Spoiler the image of the post
+	SpoilerImage,
+
+	// Remove the image from the post
+	DeleteImage,
+}
+
+// Moderation action applied to a post
+payload! { ModeratePost {
+	post: u64,
+	action: PostModeration,
+}}
+
+// Body of an open post replaced by the server
+payload! { OpenBodyReset {
+	post: u64,
+	body: String,
+}}
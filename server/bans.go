@@ -0,0 +1,174 @@
+package server
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
+	"github.com/jackc/pgx/v4"
+)
+
+// Maximum length of a ban appeal
+const maxLenBanAppeal = 1000
+
+// Ban status of a requester
+type banStatus struct {
+	ID      uint64    `json:"id"`
+	Reason  string    `json:"reason"`
+	Created time.Time `json:"created"`
+
+	// Nil for permanent bans
+	Expires *time.Time `json:"expires"`
+
+	// Post the ban was issued for, if any and still visible
+	ForPost uint64          `json:"for_post,omitempty"`
+	Post    json.RawMessage `json:"post,omitempty"`
+
+	// Nil, if the ban was not appealed
+	Appeal *banAppealStatus `json:"appeal"`
+}
+
+// State of a ban appeal
+type banAppealStatus struct {
+	Created time.Time `json:"created"`
+
+	// Nil, if not yet reviewed
+	Accepted *bool `json:"accepted"`
+}
+
+// Read the IP and the public key of a requester. The public key is only read
+// from signed requests and is 0 otherwise.
+func readBanRequester(r *http.Request) (ip net.IP, pubKey uint64, err error) {
+	ip, err = auth.GetIP(r)
+	if err != nil {
+		return
+	}
+	if r.Header.Get("X-Public-Key-ID") != "" {
+		pubKey, err = imager.VerifyPublicKey(r)
+	}
+	return
+}
+
+// Serve the status of the longest lasting ban of the requester or null, if
+// not banned
+func serveBanStatus(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		ip, pubKey, err := readBanRequester(r)
+		if err != nil {
+			return
+		}
+		b, err := db.GetBan(r.Context(), ip, pubKey)
+		switch err {
+		case nil:
+		case pgx.ErrNoRows:
+			return serveJSON(w, r, nil)
+		default:
+			return
+		}
+
+		s := banStatus{
+			ID:      b.ID,
+			Reason:  b.Reason,
+			Created: b.Created,
+			ForPost: b.ForPost,
+		}
+		if !b.Expires.IsZero() {
+			s.Expires = &b.Expires
+		}
+		if b.ForPost != 0 {
+			s.Post, err = db.GetPost(r.Context(), b.ForPost)
+			switch err {
+			case nil:
+			case pgx.ErrNoRows:
+				err = nil
+			default:
+				return
+			}
+		}
+
+		created, accepted, err := db.GetBanAppealState(r.Context(), b.ID)
+		switch err {
+		case nil:
+			s.Appeal = &banAppealStatus{
+				Created:  created,
+				Accepted: accepted,
+			}
+		case pgx.ErrNoRows:
+			err = nil
+		default:
+			return
+		}
+
+		return serveJSON(w, r, s)
+	})
+}
+
+// Appeal the ban specified in the URL on behalf of the banned requester and
+// serve the ID of the appeal
+func appealBan(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		ban, err := extractUint64Param(r, "id")
+		if err != nil {
+			return
+		}
+		ip, pubKey, err := readBanRequester(r)
+		if err != nil {
+			return
+		}
+
+		var body string
+		err = decodeJSON(r, &body)
+		if err != nil {
+			return
+		}
+		body = strings.TrimSpace(body)
+		switch {
+		case body == "":
+			return common.ErrInvalidInput("empty appeal")
+		case utf8.RuneCountInString(body) > maxLenBanAppeal:
+			return common.ErrTooLong("appeal")
+		}
+
+		id, err := db.InsertBanAppeal(r.Context(), ban, ip, pubKey, body)
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, id)
+	})
+}
+
+// Serve unreviewed ban appeals
+func serveBanAppealQueue(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		appeals, err := db.GetBanAppealQueue(r.Context())
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, appeals)
+	})
+}
+
+// Accept or reject the ban appeal specified in the URL
+func reviewBanAppeal(accept bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleError(w, r, func() (err error) {
+			id, err := extractUint64Param(r, "id")
+			if err != nil {
+				return
+			}
+			return db.ReviewBanAppeal(
+				r.Context(),
+				id,
+				accept,
+				staffSession(r).UserID,
+			)
+		})
+	}
+}
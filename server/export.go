@@ -0,0 +1,261 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/templates"
+)
+
+// Archives include every file of a thread and are thus expensive to generate.
+// Limit the number of exports per IP.
+var exportLimiter = newRateLimiter(5, 10*time.Minute)
+
+// Streaming writer of files into an archive
+type archiveWriter interface {
+	// Write a file of known size to the archive.
+	// compress: compress the file, if the archive format supports it.
+	WriteFile(name string, size int64, compress bool, r io.Reader) error
+	Close() error
+}
+
+type zipArchive struct {
+	w *zip.Writer
+}
+
+func (a zipArchive) WriteFile(
+	name string,
+	size int64,
+	compress bool,
+	r io.Reader,
+) (err error) {
+	h := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: time.Now(),
+	}
+	if compress {
+		h.Method = zip.Deflate
+	}
+	w, err := a.w.CreateHeader(h)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(w, r)
+	return
+}
+
+func (a zipArchive) Close() error {
+	return a.w.Close()
+}
+
+type tarArchive struct {
+	w *tar.Writer
+}
+
+func (a tarArchive) WriteFile(
+	name string,
+	size int64,
+	_ bool,
+	r io.Reader,
+) (err error) {
+	err = a.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return
+	}
+	_, err = io.CopyN(a.w, r, size)
+	return
+}
+
+func (a tarArchive) Close() error {
+	return a.w.Close()
+}
+
+// Stream an archive of a thread with the JSON and a static HTML viewer of
+// every page and all referenced files and thumbnails.
+// The archive format is selected with the "format" query parameter and can be
+// either "zip" or "tar". Defaults to "zip".
+func serveThreadExport(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		id, err := strconv.ParseUint(extractParam(r, "thread"), 10, 64)
+		if err != nil {
+			return common.StatusError{
+				Err:  err,
+				Code: 400,
+			}
+		}
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return
+		}
+		if !exportLimiter.allow(ip.String()) {
+			return common.ErrRateLimited
+		}
+
+		format := r.URL.Query().Get("format")
+		switch format {
+		case "":
+			format = "zip"
+		case "zip", "tar":
+		default:
+			return common.StatusError{
+				Err:  errors.New("unsupported archive format: " + format),
+				Code: 400,
+			}
+		}
+
+		// Errors can only be reported with a status code before streaming
+		// starts
+		exists, err := db.ThreadExists(r.Context(), id)
+		if err != nil {
+			return
+		}
+		if !exists {
+			return common.StatusError{
+				Err:  errors.New("thread does not exist"),
+				Code: 404,
+			}
+		}
+		lastPage, err := db.GetLastPage(id)
+		if err != nil {
+			return
+		}
+
+		var a archiveWriter
+		head := w.Header()
+		switch format {
+		case "zip":
+			head.Set("Content-Type", "application/zip")
+			a = zipArchive{zip.NewWriter(w)}
+		case "tar":
+			head.Set("Content-Type", "application/x-tar")
+			a = tarArchive{tar.NewWriter(w)}
+		}
+		head.Set(
+			"Content-Disposition",
+			fmt.Sprintf(`attachment; filename="thread_%d.%s"`, id, format),
+		)
+
+		err = writeThreadArchive(a, id, lastPage)
+		if err == nil {
+			err = a.Close()
+		}
+		if err != nil {
+			logError(r, err)
+		}
+		return nil
+	})
+}
+
+// Write all pages and files of a thread into an archive
+func writeThreadArchive(a archiveWriter, id uint64, lastPage int) (err error) {
+	var (
+		dir    = strconv.FormatUint(id, 10)
+		images = make(map[common.SHA1Hash]common.Image)
+		html   bytes.Buffer
+	)
+
+	// Make image paths relative to the archive
+	conf := *config.Get()
+	conf.ImageRootOverride = "images"
+
+	writeBuf := func(name string, buf []byte) error {
+		return a.WriteFile(
+			path.Join(dir, name),
+			int64(len(buf)),
+			true,
+			bytes.NewReader(buf),
+		)
+	}
+
+	for page := 0; page <= lastPage; page++ {
+		var buf []byte
+		buf, err = db.GetThread(id, page)
+		if err != nil {
+			return
+		}
+		err = writeBuf(fmt.Sprintf("json/%d.json", page), buf)
+		if err != nil {
+			return
+		}
+
+		var t common.Thread
+		err = json.Unmarshal(buf, &t)
+		if err != nil {
+			return
+		}
+		for _, p := range t.Posts {
+			if p.Image != nil {
+				images[p.Image.SHA1] = *p.Image
+
+				// No spoiler images in the archive
+				p.Image.Spoilered = false
+			}
+		}
+
+		html.Reset()
+		templates.WriteThreadArchive(&html, conf, t)
+		err = writeBuf(templates.ArchivePageName(uint32(page)), html.Bytes())
+		if err != nil {
+			return
+		}
+	}
+
+	for _, img := range images {
+		paths := assets.GetFilePaths(img.SHA1, img.FileType, img.ThumbType)
+		if img.ThumbType == common.NoFile {
+			paths[1] = ""
+		}
+		for _, p := range paths {
+			if p == "" {
+				continue
+			}
+			err = writeArchiveFile(a, path.Join(dir, filepath.ToSlash(p)), p)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// Stream a file from disk into an archive. Missing files are skipped.
+func writeArchiveFile(a archiveWriter, name, filePath string) (err error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	defer f.Close()
+
+	stats, err := f.Stat()
+	if err != nil {
+		return
+	}
+	return a.WriteFile(name, stats.Size(), false, f)
+}
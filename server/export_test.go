@@ -0,0 +1,79 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestArchiveWriters(t *testing.T) {
+	t.Parallel()
+
+	const content = "foo bar"
+
+	write := func(t *testing.T, a archiveWriter) {
+		t.Helper()
+
+		err := a.WriteFile(
+			"1/json/0.json",
+			int64(len(content)),
+			true,
+			strings.NewReader(content),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = a.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("zip", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		write(t, zipArchive{zip.NewWriter(&buf)})
+
+		r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(r.File), 1)
+		test.AssertEquals(t, r.File[0].Name, "1/json/0.json")
+		f, err := r.File[0].Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		res, err := ioutil.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, string(res), content)
+	})
+
+	t.Run("tar", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		write(t, tarArchive{tar.NewWriter(&buf)})
+
+		r := tar.NewReader(&buf)
+		h, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, h.Name, "1/json/0.json")
+		res, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, string(res), content)
+	})
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+)
+
+func setAtomHeaders(w http.ResponseWriter) {
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("Content-Type", "application/atom+xml")
+}
+
+// Extract a URL parameter with an ".atom" file extension and strip the
+// extension
+func extractAtomParam(r *http.Request, key string) (string, error) {
+	s := extractParam(r, key)
+	if !strings.HasSuffix(s, ".atom") {
+		return "", common.StatusError{
+			Err:  errors.New("not an Atom feed"),
+			Code: 404,
+		}
+	}
+	return strings.TrimSuffix(s, ".atom"), nil
+}
+
+// Serve Atom feed of a thread's latest posts
+func serveThreadFeed(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		s, err := extractAtomParam(r, "thread")
+		if err != nil {
+			return
+		}
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return common.StatusError{
+				Err:  err,
+				Code: 400,
+			}
+		}
+
+		setAtomHeaders(w)
+		return cache.WriteThreadFeed(w, r, id)
+	})
+}
+
+// Serve Atom feed of the latest threads with a tag
+func serveTagFeed(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractAtomParam(r, "tag")
+		if err != nil {
+			return
+		}
+		err = common.WrapError(400, func() error {
+			return validateTag(tag)
+		})
+		if err != nil {
+			return
+		}
+
+		setAtomHeaders(w)
+		return cache.WriteTagFeed(w, r, tag)
+	})
+}
+
+// Serve Atom feed of the latest threads
+func serveGlobalFeed(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() error {
+		setAtomHeaders(w)
+		return cache.WriteGlobalFeed(w, r)
+	})
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+// Maximum length of a filter pattern or replacement
+const maxLenFilterPattern = 200
+
+// Serve all post body filters
+func serveFilters(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		filters, err := db.GetFilters(r.Context())
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, filters)
+	})
+}
+
+// Create a new post body filter and serve its ID
+func createFilter(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var f common.Filter
+		err = decodeJSON(r, &f)
+		if err != nil {
+			return
+		}
+
+		switch {
+		case f.Pattern == "":
+			return common.ErrInvalidInput("no pattern provided")
+		case utf8.RuneCountInString(f.Pattern) > maxLenFilterPattern:
+			return common.ErrTooLong("pattern")
+		case utf8.RuneCountInString(f.Replacement) > maxLenFilterPattern:
+			return common.ErrTooLong("replacement")
+		case f.Action > common.FilterReport:
+			return common.ErrInvalidInput("invalid filter action")
+		case f.Action == common.FilterSpamScore && f.SpamScore == 0:
+			return common.ErrInvalidInput("no spam score provided")
+		}
+		if f.Tag != "" {
+			err = validateTag(f.Tag)
+			if err != nil {
+				return common.StatusError{
+					Err:  err,
+					Code: 400,
+				}
+			}
+		}
+
+		id, err := db.InsertFilter(r.Context(), f, staffSession(r).UserID)
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, id)
+	})
+}
+
+// Delete the post body filter specified in the URL
+func deleteFilter(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		id, err := extractUint64Param(r, "id")
+		if err != nil {
+			return
+		}
+		return db.DeleteFilter(r.Context(), id, staffSession(r).UserID)
+	})
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+)
+
+// Serve server-side rendered thread index page
+func serveIndexHTML(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		page, err := parseUintParam(r.URL.Query(), "page", 0)
+		if err != nil {
+			return common.StatusError{
+				Err:  err,
+				Code: 400,
+			}
+		}
+
+		setHTMLHeaders(w)
+		return cache.WriteIndexHTML(w, r, page)
+	})
+}
+
+// Serve server-side rendered thread page
+func serveThreadHTML(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var (
+			thread uint64
+			page   int
+		)
+		err = common.WrapError(404, func() (err error) {
+			thread, err = strconv.ParseUint(extractParam(r, "thread"), 10, 64)
+			if err != nil {
+				return
+			}
+			page, err = strconv.Atoi(extractParam(r, "page"))
+			if err != nil {
+				return
+			}
+			if page < -1 {
+				return strconv.ErrRange
+			}
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		setHTMLHeaders(w)
+		return cache.WriteThreadHTML(w, r, thread, page)
+	})
+}
+
+// Serve server-side rendered thread catalog
+func serveCatalogHTML(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() error {
+		setHTMLHeaders(w)
+		return cache.WriteCatalogHTML(w, r)
+	})
+}
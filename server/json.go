@@ -1,12 +1,20 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/cache"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -18,26 +26,35 @@ func setJSONHeaders(w http.ResponseWriter) {
 	head.Set("Content-Type", "application/json")
 }
 
-// // Serve a single post as JSON
-// func servePost(w http.ResponseWriter, r *http.Request) {
-// 	httpError(w, r, func() (err error) {
-// 		id, err := strconv.ParseUint(extractParam(r, "post"), 10, 64)
-// 		if err != nil {
-// 			httpError(w, r, common.StatusError{
-// 				Err:  err,
-// 				Code: 400,
-// 			})
-// 			return
-// 		}
-
-// 		post, err := db.GetPost(id)
-// 		if err != nil {
-// 			httpError(w, r, err)
-// 			return
-// 		}
-// 		serveJSON(w, r, "", post)
-// 	}())
-// }
+// Serve a single post as JSON
+func servePost(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		id, err := strconv.ParseUint(extractParam(r, "id"), 10, 64)
+		if err != nil {
+			return common.StatusError{
+				Err:  err,
+				Code: 400,
+			}
+		}
+
+		setJSONHeaders(w)
+		return cache.WritePost(w, r, id)
+	})
+}
+
+// Serve a JSON array of posts from an array of post IDs. Nonexistent posts are
+// omitted.
+func servePosts(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		ids, err := decodePostIDArray(r)
+		if err != nil {
+			return
+		}
+
+		setJSONHeaders(w)
+		return cache.WritePosts(w, ids)
+	})
+}
 
 // Serves thread page JSON
 func serveThread(w http.ResponseWriter, r *http.Request) {
@@ -73,8 +90,28 @@ func serveThread(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
-		setJSONHeaders(w)
-		err = cache.WriteThread(w, r, thread, page)
+		viewer, isStaff, err := threadViewer(r)
+		if err != nil {
+			return
+		}
+		if viewer != 0 || isStaff {
+			// Views including hidden posts are never cached
+			var buf []byte
+			buf, err = db.GetThreadFor(
+				r.Context(),
+				thread,
+				page,
+				viewer,
+				isStaff,
+			)
+			if err == nil {
+				setJSONHeaders(w)
+				_, err = w.Write(buf)
+			}
+		} else {
+			setJSONHeaders(w)
+			err = cache.WriteThread(w, r, thread, page)
+		}
 		if err == pgx.ErrNoRows {
 			err = common.StatusError{
 				Err:  err,
@@ -85,9 +122,171 @@ func serveThread(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Identify the viewer of a thread, that can see posts hidden by moderation.
+// Staff are identified by their session cookie and post authors by a request
+// signed with their public key. Returns zero values for all other requests.
+func threadViewer(r *http.Request) (
+	viewer uint64,
+	isStaff bool,
+	err error,
+) {
+	s, err := getStaffSession(r)
+	switch err {
+	case nil:
+		isStaff = s.Level >= common.Janitor
+		return
+	case common.ErrNotLoggedIn:
+		err = nil
+	default:
+		return
+	}
+
+	if r.Header.Get("X-Public-Key-ID") != "" {
+		viewer, err = imager.VerifyPublicKey(r)
+	}
+	return
+}
+
 // Serves thread index page JSON
 func serveIndex(w http.ResponseWriter, r *http.Request) {
-	serverJSONFromCache(w, r, cache.WriteIndex)
+	handleError(w, r, func() (err error) {
+		sort, page, limit, err := parseIndexParams(r)
+		if err != nil {
+			return
+		}
+
+		setJSONHeaders(w)
+		return cache.WriteIndex(w, r, sort, page, limit)
+	})
+}
+
+// Serves a page of the thread index of a specific tag as JSON
+func serveTagIndex(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractTag(r)
+		if err != nil {
+			return
+		}
+		sort, page, limit, err := parseIndexParams(r)
+		if err != nil {
+			return
+		}
+
+		setJSONHeaders(w)
+		return cache.WriteTagIndex(w, r, tag, sort, page, limit)
+	})
+}
+
+// Serves all threads of a specific tag as JSON
+func serveTagCatalog(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractTag(r)
+		if err != nil {
+			return
+		}
+		sort, _, _, err := parseIndexParams(r)
+		if err != nil {
+			return
+		}
+
+		setJSONHeaders(w)
+		return cache.WriteTagCatalog(w, r, tag, sort)
+	})
+}
+
+// Extract and validate the thread tag URL parameter
+func extractTag(r *http.Request) (tag string, err error) {
+	tag = extractParam(r, "tag")
+	err = common.WrapError(400, func() error {
+		return validateTag(tag)
+	})
+	return
+}
+
+// Validate a thread tag supplied by the client
+func validateTag(tag string) error {
+	switch {
+	case tag == "":
+		return errors.New("empty tag")
+	case utf8.RuneCountInString(tag) > common.MaxLenTag:
+		return fmt.Errorf("tag too long: %s", tag)
+	case strings.ToLower(tag) != tag:
+		return fmt.Errorf("tag not lowercase: %s", tag)
+	default:
+		return nil
+	}
+}
+
+// Parse thread index sorting and pagination query parameters.
+// All parameters are optional.
+func parseIndexParams(r *http.Request) (
+	sort common.ThreadSortMode,
+	page, limit uint,
+	err error,
+) {
+	err = common.WrapError(400, func() (err error) {
+		q := r.URL.Query()
+		if s := q.Get("sort"); s != "" {
+			err = sort.UnmarshalText([]byte(s))
+			if err != nil {
+				return
+			}
+		}
+		page, limit, err = parsePageParams(q)
+		return
+	})
+	return
+}
+
+// Parse optional "page" and "limit" pagination query parameters
+func parsePageParams(q url.Values) (page, limit uint, err error) {
+	page, err = parseUintParam(q, "page", 0)
+	if err != nil {
+		return
+	}
+	limit, err = parseUintParam(q, "limit", common.DefaultIndexPageSize)
+	if err != nil {
+		return
+	}
+	if limit == 0 || limit > common.MaxIndexPageSize {
+		err = fmt.Errorf("invalid page size: %d", limit)
+	}
+	return
+}
+
+// Parse optional unsigned integer query parameter or return def, if not set
+func parseUintParam(q url.Values, key string, def uint) (n uint, err error) {
+	s := q.Get(key)
+	if s == "" {
+		return def, nil
+	}
+	u, err := strconv.ParseUint(s, 10, 32)
+	n = uint(u)
+	return
+}
+
+// Parse optional "from" and "to" unix timestamp query parameters. Unset
+// parameters are returned as zero time.
+func parseTimeRange(q url.Values) (from, to time.Time, err error) {
+	parse := func(key string) (t time.Time, err error) {
+		s := q.Get(key)
+		if s == "" {
+			return
+		}
+		unix, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return
+		}
+		t = time.Unix(unix, 0)
+		return
+	}
+
+	from, err = parse("from")
+	if err != nil {
+		return
+	}
+	to, err = parse("to")
+	return
 }
 
 func serverJSONFromCache(
@@ -105,22 +304,218 @@ func serverUsedTags(w http.ResponseWriter, r *http.Request) {
 	serverJSONFromCache(w, r, cache.WriteUsedTags)
 }
 
-// func serveThreadUpdates(w http.ResponseWriter, r *http.Request) {
-// 	err := func() (err error) {
-// 		var data map[uint64]uint64
-// 		err = decodeJSON(r, &data)
-// 		if err != nil {
-// 			return
-// 		}
-
-// 		diff, err := db.DiffThreadPostCounts(data)
-// 		if err != nil {
-// 			return
-// 		}
-// 		serveJSON(w, r, "", diff)
-// 		return
-// 	}()
-// 	if err != nil {
-// 		httpError(w, r, err)
-// 	}
-// }
+// Serve changes in thread post counts and bump times compared to the
+// client-supplied post counts. The client sends a JSON object of post counts
+// mapped by thread ID.
+func serveThreadUpdates(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var known map[uint64]uint64
+		err = decodeJSON(r, &known)
+		if err != nil {
+			return
+		}
+
+		buf, err := db.DiffThreadPostCounts(r.Context(), known)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Serve statistics of tags active within the time window specified by the
+// "period" query parameter, sorted by the "sort" query parameter.
+// Defaults to trending tags of the last 24 hours.
+func serveTagStats(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var (
+			period = common.TagStatsDay
+			sort   common.TagSortMode
+		)
+		err = common.WrapError(400, func() (err error) {
+			q := r.URL.Query()
+			if s := q.Get("period"); s != "" {
+				err = period.UnmarshalText([]byte(s))
+				if err != nil {
+					return
+				}
+			}
+			if s := q.Get("sort"); s != "" {
+				err = sort.UnmarshalText([]byte(s))
+			}
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		buf, err := db.GetTagStats(r.Context(), period, sort)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Serve full-text post search results as JSON
+func serveSearch(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var p db.SearchParams
+		err = common.WrapError(400, func() (err error) {
+			q := r.URL.Query()
+
+			p.Query = strings.TrimSpace(q.Get("q"))
+			if p.Query == "" {
+				return errors.New("empty search query")
+			}
+			if p.Tag = q.Get("tag"); p.Tag != "" {
+				err = validateTag(p.Tag)
+				if err != nil {
+					return
+				}
+			}
+			if s := q.Get("thread"); s != "" {
+				p.Thread, err = strconv.ParseUint(s, 10, 64)
+				if err != nil {
+					return
+				}
+			}
+			p.From, p.To, err = parseTimeRange(q)
+			if err != nil {
+				return
+			}
+
+			if s := q.Get("image"); s != "" {
+				p.HasImage, err = strconv.ParseBool(s)
+				if err != nil {
+					return
+				}
+			}
+
+			p.Page, p.Limit, err = parsePageParams(q)
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		buf, err := db.SearchPosts(r.Context(), p)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Minimum global staff level required to see the data of moderation log
+// entries by type. The data of types not listed is only visible to staff.
+var modLogDataLevels = map[common.ModerationAction]common.ModerationLevel{
+	common.BanPost:         common.NotStaff,
+	common.UnbanPost:       common.NotStaff,
+	common.DeletePost:      common.NotStaff,
+	common.DeleteImage:     common.NotStaff,
+	common.SpoilerImage:    common.NotStaff,
+	common.PurgePost:       common.NotStaff,
+	common.LockThread:      common.NotStaff,
+	common.UnlockThread:    common.NotStaff,
+	common.StickyThread:    common.NotStaff,
+	common.UnstickyThread:  common.NotStaff,
+	common.AppointTagStaff: common.NotStaff,
+	common.RemoveTagStaff:  common.NotStaff,
+	common.AddFilter:       common.Admin,
+	common.RemoveFilter:    common.Admin,
+}
+
+// Moderation log entry types, whose target post and thread are only visible
+// to staff. Shadow binning must not be revealed to the author and meido vision
+// lookups must not be revealed to anyone.
+var staffOnlyModLogTargets = map[common.ModerationAction]bool{
+	common.ShadowBinPost: true,
+	common.MeidoVision:   true,
+}
+
+// Remove the fields of moderation log entries a viewer with the passed global
+// staff level may not see
+func redactModLog(entries []auth.ModLogEntry, viewer common.ModerationLevel) {
+	for i := range entries {
+		e := &entries[i]
+		min, ok := modLogDataLevels[e.Type]
+		if !ok {
+			min = common.Janitor
+		}
+		if viewer < min {
+			e.Data = ""
+		}
+		if viewer < common.Janitor && staffOnlyModLogTargets[e.Type] {
+			e.Post = 0
+			e.Thread = 0
+		}
+		if viewer < common.Moderator {
+			e.Ban = nil
+		}
+	}
+}
+
+// Serve a page of the moderation log filtered by the optional action, by,
+// thread, from and to query parameters.
+// Fields are served depending on the entry type and the viewer's staff level.
+func serveModLog(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var f db.ModLogFilter
+		err = common.WrapError(400, func() (err error) {
+			q := r.URL.Query()
+
+			if s := q.Get("action"); s != "" {
+				var a common.ModerationAction
+				err = a.UnmarshalText([]byte(s))
+				if err != nil {
+					return
+				}
+				f.Type = &a
+			}
+			f.By = q.Get("by")
+			if len(f.By) > common.MaxLenUserID {
+				return common.ErrTooLong("staff member ID")
+			}
+			if s := q.Get("thread"); s != "" {
+				f.Thread, err = strconv.ParseUint(s, 10, 64)
+				if err != nil {
+					return
+				}
+			}
+			f.From, f.To, err = parseTimeRange(q)
+			if err != nil {
+				return
+			}
+			f.Page, f.Limit, err = parsePageParams(q)
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		viewer := common.NotStaff
+		s, err := getStaffSession(r)
+		switch err {
+		case nil:
+			viewer = s.Level
+		case common.ErrNotLoggedIn:
+			err = nil
+		default:
+			return
+		}
+
+		entries, err := db.GetModLog(r.Context(), f)
+		if err != nil {
+			return
+		}
+		redactModLog(entries, viewer)
+		return serveJSON(w, r, entries)
+	})
+}
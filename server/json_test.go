@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/test"
+)
+
+func TestParseIndexParams(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, query string
+		sort        common.ThreadSortMode
+		page, limit uint
+		err         bool
+	}{
+		{
+			name:  "defaults",
+			limit: common.DefaultIndexPageSize,
+		},
+		{
+			name:  "all set",
+			query: "?sort=replies&page=2&limit=10",
+			sort:  common.SortByReplyCount,
+			page:  2,
+			limit: 10,
+		},
+		{
+			name:  "invalid sort mode",
+			query: "?sort=foo",
+			err:   true,
+		},
+		{
+			name:  "negative page",
+			query: "?page=-1",
+			err:   true,
+		},
+		{
+			name:  "limit too big",
+			query: "?limit=1000",
+			err:   true,
+		},
+		{
+			name:  "zero limit",
+			query: "?limit=0",
+			err:   true,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			sort, page, limit, err := parseIndexParams(
+				newRequest("/api/json/index" + c.query),
+			)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				test.AssertEquals(t, errStatusCode(err), 400)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, sort, c.sort)
+			test.AssertEquals(t, page, c.page)
+			test.AssertEquals(t, limit, c.limit)
+		})
+	}
+}
+
+func TestRedactModLog(t *testing.T) {
+	t.Parallel()
+
+	ban := &auth.Ban{
+		IP: auth.NewIPRange(net.IP{198, 51, 100, 1}),
+	}
+	entries := func() []auth.ModLogEntry {
+		return []auth.ModLogEntry{
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.BanPost,
+					Data: "spam",
+				},
+				Post: 1,
+				Ban:  ban,
+			},
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.AddFilter,
+					Data: "1: reject",
+				},
+			},
+			{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.ShadowBinPost,
+					Data: "spam",
+				},
+				Post:   2,
+				Thread: 1,
+			},
+		}
+	}
+
+	cases := [...]struct {
+		name   string
+		viewer common.ModerationLevel
+		std    []auth.ModLogEntry
+	}{
+		{
+			name:   "anonymous",
+			viewer: common.NotStaff,
+			std: []auth.ModLogEntry{
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.BanPost,
+						Data: "spam",
+					},
+					Post: 1,
+				},
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.AddFilter,
+					},
+				},
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.ShadowBinPost,
+					},
+				},
+			},
+		},
+		{
+			name:   "janitor",
+			viewer: common.Janitor,
+			std: []auth.ModLogEntry{
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.BanPost,
+						Data: "spam",
+					},
+					Post: 1,
+				},
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.AddFilter,
+					},
+				},
+				{
+					ModerationEntry: common.ModerationEntry{
+						Type: common.ShadowBinPost,
+						Data: "spam",
+					},
+					Post:   2,
+					Thread: 1,
+				},
+			},
+		},
+		{
+			name:   "admin",
+			viewer: common.Admin,
+			std:    entries(),
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := entries()
+			redactModLog(res, c.viewer)
+			test.AssertEquals(t, res, c.std)
+		})
+	}
+}
+
+func TestServeModLog(t *testing.T) {
+	t.Parallel()
+
+	const by = "modlog_serve_test"
+	ctx := context.Background()
+
+	_, err := db.Ban(ctx, auth.BanRecord{
+		Ban: auth.Ban{
+			IP: auth.NewIPRange(net.IP{198, 51, 100, 2}),
+		},
+		Reason:  "spam",
+		By:      by,
+		Expires: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.InsertFilter(
+		ctx,
+		common.Filter{
+			Pattern: "modlog_serve_test",
+			Action:  common.FilterReject,
+		},
+		by,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec, req := newPair("/api/json/mod-log?by=" + by)
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 200)
+
+	var entries []auth.ModLogEntry
+	err = json.Unmarshal(rec.Body.Bytes(), &entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, len(entries), 2)
+	for _, e := range entries {
+		if e.Ban != nil {
+			t.Errorf("ban target served: %v", e.Ban)
+		}
+		if e.Type == common.AddFilter && e.Data != "" {
+			t.Errorf("filter data served: %s", e.Data)
+		}
+	}
+}
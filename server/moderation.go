@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets"
+)
+
+// Apply a moderation action to the post specified in the URL
+func moderatePost(action common.ModerationAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleError(w, r, func() (err error) {
+			id, err := extractUint64Param(r, "post")
+			if err != nil {
+				return
+			}
+			err = db.ModeratePost(
+				r.Context(),
+				id,
+				staffSession(r).UserID,
+				action,
+			)
+			if err != nil {
+				return
+			}
+			return propagatePostModeration(id, action)
+		})
+	}
+}
+
+// Evict cached data of a moderated post and push the applied moderation action
+// to websocket clients
+func propagatePostModeration(
+	id uint64,
+	action common.ModerationAction,
+) (err error) {
+	thread, page, err := db.GetPostParenthood(id)
+	if err != nil {
+		return
+	}
+	cache.EvictPost(id)
+	cache.EvictThreadPage(thread, page)
+	return websockets.ModeratePost(thread, id, action)
+}
+
+// Set or unset a boolean moderation flag of the thread specified in the URL
+func setThreadFlag(
+	set func(ctx context.Context, id uint64, val bool, by string) error,
+	val bool,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleError(w, r, func() (err error) {
+			id, err := extractUint64Param(r, "thread")
+			if err != nil {
+				return
+			}
+			err = set(r.Context(), id, val, staffSession(r).UserID)
+			if err != nil {
+				return
+			}
+			cache.EvictThread(id)
+			cache.EvictThreadList()
+			return
+		})
+	}
+}
+
+// Serve all posts made by the author of the post specified in the URL.
+// Posts by public keys seen from the same IPs are included, if the "same_ip"
+// query parameter is set to "true".
+func serveMeidoVision(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		id, err := extractUint64Param(r, "post")
+		if err != nil {
+			return
+		}
+		buf, err := db.MeidoVision(
+			r.Context(),
+			id,
+			r.URL.Query().Get("same_ip") == "true",
+			staffSession(r).UserID,
+		)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Options of an image ban
+type imageBanOpts struct {
+	Reason string `json:"reason"`
+
+	// Also purge all posts currently using the banned images
+	Purge bool `json:"purge"`
+}
+
+// Normalize and validate image ban options
+func (o *imageBanOpts) validate() error {
+	o.Reason = strings.TrimSpace(o.Reason)
+	return validateReason(o.Reason)
+}
+
+// Ban the image of the post specified in the URL
+func banPostImage(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		id, err := extractUint64Param(r, "post")
+		if err != nil {
+			return
+		}
+		var req imageBanOpts
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		err = req.validate()
+		if err != nil {
+			return
+		}
+
+		purged, err := db.BanPostImage(
+			r.Context(),
+			id,
+			staffSession(r).UserID,
+			req.Reason,
+			req.Purge,
+		)
+		if err != nil {
+			return
+		}
+		return propagatePurges(purged)
+	})
+}
+
+// Ban images by lists of hex-encoded SHA1 and MD5 hashes
+func banImages(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var req struct {
+			SHA1 []common.SHA1Hash `json:"sha1"`
+			MD5  []common.MD5Hash  `json:"md5"`
+			imageBanOpts
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		err = req.validate()
+		if err != nil {
+			return
+		}
+		if len(req.SHA1)+len(req.MD5) == 0 {
+			return common.ErrInvalidInput("no hashes provided")
+		}
+
+		purged, err := db.BanImages(
+			r.Context(),
+			staffSession(r).UserID,
+			db.ImageBanRequest{
+				SHA1:   req.SHA1,
+				MD5:    req.MD5,
+				Reason: req.Reason,
+				Purge:  req.Purge,
+			},
+		)
+		if err != nil {
+			return
+		}
+		return propagatePurges(purged)
+	})
+}
+
+// Serve images perceptually similar to the image specified in the URL with an
+// optional maximum Hamming distance query parameter
+func serveSimilarImages(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var (
+			id   common.SHA1Hash
+			dist uint64 = 10
+		)
+		err = common.WrapError(400, func() (err error) {
+			err = id.UnmarshalText([]byte(extractParam(r, "sha1")))
+			if err != nil {
+				return
+			}
+			if s := r.URL.Query().Get("distance"); s != "" {
+				dist, err = strconv.ParseUint(s, 10, 8)
+				if err != nil {
+					return
+				}
+				if dist > 64 {
+					return common.ErrInvalidInput("distance too large")
+				}
+			}
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		buf, err := db.GetSimilarImages(r.Context(), id, uint8(dist), 100)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Propagate the purging of posts using a banned image
+func propagatePurges(posts []uint64) (err error) {
+	for _, id := range posts {
+		err = propagatePostModeration(id, common.PurgePost)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+)
+
+// oEmbed response of the "link" type
+type oEmbed struct {
+	Version         string `json:"version"`
+	Type            string `json:"type"`
+	Title           string `json:"title"`
+	AuthorName      string `json:"author_name"`
+	ProviderName    string `json:"provider_name"`
+	ProviderURL     string `json:"provider_url"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth  uint16 `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight uint16 `json:"thumbnail_height,omitempty"`
+}
+
+// Serve oEmbed JSON for thread and post URLs.
+// Post URLs are thread page URLs with a "#p<post_id>" fragment.
+func serveOEmbed(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		q := r.URL.Query()
+		if f := q.Get("format"); f != "" && f != "json" {
+			return common.StatusError{
+				Err:  errors.New("unsupported format: " + f),
+				Code: 501,
+			}
+		}
+
+		var thread, post uint64
+		err = common.WrapError(404, func() (err error) {
+			thread, post, err = parseOEmbedURL(
+				config.Get().RootURL,
+				q.Get("url"),
+			)
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		buf, err := db.GetThread(thread, -5)
+		if err != nil {
+			return
+		}
+		var t common.Thread
+		err = json.Unmarshal(buf, &t)
+		if err != nil {
+			return
+		}
+		if len(t.Posts) == 0 {
+			return common.StatusError{
+				Err:  errors.New("thread has no posts"),
+				Code: 404,
+			}
+		}
+
+		p := t.Posts[0]
+		title := t.Subject
+		if post != 0 && post != thread {
+			buf, err = db.GetPost(r.Context(), post)
+			if err != nil {
+				return
+			}
+			err = json.Unmarshal(buf, &p)
+			if err != nil {
+				return
+			}
+			if p.Thread != thread {
+				return common.StatusError{
+					Err:  errors.New("post not in thread"),
+					Code: 404,
+				}
+			}
+			title = "#" + strconv.FormatUint(p.ID, 10) + " - " + title
+		}
+
+		conf := config.Get()
+		res := oEmbed{
+			Version:      "1.0",
+			Type:         "link",
+			Title:        title,
+			AuthorName:   "Anonymous",
+			ProviderName: "meguca",
+			ProviderURL:  conf.RootURL,
+		}
+		if p.Name != nil && *p.Name != "" {
+			res.AuthorName = *p.Name
+		}
+		if img := p.Image; img != nil &&
+			img.ThumbType != common.NoFile &&
+			!img.Spoilered {
+			res.ThumbnailURL = templates.AbsoluteURL(
+				*conf,
+				templates.ImagePaths(*conf, img)[1],
+			)
+			res.ThumbnailWidth = img.ThumbWidth
+			res.ThumbnailHeight = img.ThumbHeight
+		}
+
+		buf, err = json.Marshal(res)
+		if err != nil {
+			return
+		}
+		setJSONHeaders(w)
+		writeData(w, r, buf)
+		return
+	})
+}
+
+// Extract thread and optional post ID from a thread page URL on the server
+// with the root URL root
+func parseOEmbedURL(root, s string) (thread, post uint64, err error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return
+	}
+	r, err := url.Parse(root)
+	if err != nil {
+		return
+	}
+	if !strings.EqualFold(u.Host, r.Host) {
+		err = errors.New("not a URL of this server: " + s)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "threads" {
+		err = errors.New("not a thread URL: " + s)
+		return
+	}
+	thread, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return
+	}
+	_, err = strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	if strings.HasPrefix(u.Fragment, "p") {
+		post, err = strconv.ParseUint(u.Fragment[1:], 10, 64)
+	}
+	return
+}
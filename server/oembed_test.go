@@ -0,0 +1,74 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestParseOEmbedURL(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, url    string
+		thread, post uint64
+		err          bool
+	}{
+		{
+			name:   "thread",
+			url:    "https://example.com/threads/12/0",
+			thread: 12,
+		},
+		{
+			name:   "post",
+			url:    "https://example.com/threads/12/3#p15",
+			thread: 12,
+			post:   15,
+		},
+		{
+			name:   "unrelated fragment",
+			url:    "https://example.com/threads/12/-1#bottom",
+			thread: 12,
+		},
+		{
+			name: "other host",
+			url:  "https://example.org/threads/12/0",
+			err:  true,
+		},
+		{
+			name: "relative",
+			url:  "/threads/12/0",
+			err:  true,
+		},
+		{
+			name: "not a thread",
+			url:  "https://example.com/catalog",
+			err:  true,
+		},
+		{
+			name: "invalid thread",
+			url:  "https://example.com/threads/foo/0",
+			err:  true,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			thread, post, err := parseOEmbedURL("https://example.com", c.url)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEquals(t, thread, c.thread)
+			test.AssertEquals(t, post, c.post)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
+)
+
+// Validate a report or moderation action reason
+func validateReason(reason string) error {
+	switch {
+	case reason == "":
+		return common.ErrInvalidInput("no reason provided")
+	case utf8.RuneCountInString(reason) > common.MaxLenReason:
+		return common.ErrTooLong("reason")
+	default:
+		return nil
+	}
+}
+
+// Report a post. The request must be signed by the client's public key.
+func serveReport(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		pubKey, err := imager.ValidateRequester(
+			r,
+			config.Get().SpamScores.Report,
+		)
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			Post   uint64 `json:"post"`
+			Reason string `json:"reason"`
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		req.Reason = strings.TrimSpace(req.Reason)
+		err = validateReason(req.Reason)
+		if err != nil {
+			return
+		}
+
+		id, err := db.InsertReport(r.Context(), pubKey, req.Post, req.Reason)
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, id)
+	})
+}
+
+// Serve open reports grouped by target post. Staff without a global
+// moderation level are only served reports from threads with tags they are
+// appointed to.
+func serveReportQueue(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var (
+			groups []auth.ReportGroup
+			s      = staffSession(r)
+		)
+		if s.Level >= common.Janitor {
+			groups, err = db.GetReportQueue(r.Context())
+		} else {
+			groups, err = db.GetTagReportQueue(r.Context(), s.UserID)
+		}
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, groups)
+	})
+}
+
+// Dismiss all open reports of a post
+func dismissReports(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		post, err := extractUint64Param(r, "post")
+		if err != nil {
+			return
+		}
+		return db.DismissReports(r.Context(), post, staffSession(r).UserID)
+	})
+}
+
+// Resolve all open reports of a post with a moderation action. Bans apply
+// site-wide and are thus only issued by global moderators.
+func resolveReports(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		post, err := extractUint64Param(r, "post")
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			Action common.ModerationAction `json:"action"`
+			Reason string                  `json:"reason"`
+
+			// Ban duration in seconds. 0 for permanent bans.
+			Duration uint64 `json:"duration"`
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		req.Reason = strings.TrimSpace(req.Reason)
+		err = validateReason(req.Reason)
+		if err != nil {
+			return
+		}
+		if req.Action == common.BanPost &&
+			staffSession(r).Level < common.Moderator {
+			return common.ErrNoPermissions
+		}
+
+		err = db.ResolveReports(
+			r.Context(),
+			post,
+			staffSession(r).UserID,
+			db.ReportResolution{
+				Type:     req.Action,
+				Reason:   req.Reason,
+				Duration: time.Duration(req.Duration) * time.Second,
+			},
+		)
+		if err != nil {
+			return
+		}
+
+		switch req.Action {
+		case common.DeletePost, common.PurgePost, common.ShadowBinPost,
+			common.SpoilerImage, common.DeleteImage:
+			err = propagatePostModeration(post, req.Action)
+		}
+		return
+	})
+}
@@ -8,9 +8,8 @@ import (
 	"runtime/debug"
 	"strings"
 
-	"github.com/bakape/meguca/templates"
-
 	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/imager"
@@ -73,14 +72,9 @@ func createRouter() http.Handler {
 	r := httptreemux.NewContextMux()
 	r.PanicHandler = handlePanic
 
-	serveApp := func(w http.ResponseWriter, r *http.Request) {
-		// TODO: Cache it
-		setHTMLHeaders(w)
-		templates.WriteMain(w, *config.Get())
-	}
-	r.GET("/", serveApp)
-	r.GET("/threads/:thread/:page", serveApp)
-	r.GET("/catalog", serveApp)
+	r.GET("/", serveIndexHTML)
+	r.GET("/threads/:thread/:page", serveThreadHTML)
+	r.GET("/catalog", serveCatalogHTML)
 
 	r.GET("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
 		var buf bytes.Buffer
@@ -92,6 +86,11 @@ func createRouter() http.Handler {
 		buf.WriteTo(w)
 	})
 
+	feeds := r.NewGroup("/feeds")
+	feeds.GET("/threads/:thread", serveThreadFeed)
+	feeds.GET("/tags/:tag", serveTagFeed)
+	feeds.GET("/all.atom", serveGlobalFeed)
+
 	api := r.NewGroup("/api")
 	api.GET("/health-check", func(w http.ResponseWriter, r *http.Request) {
 		w.Write(healthCheckMsg)
@@ -101,6 +100,127 @@ func createRouter() http.Handler {
 	api.POST("/upload", imager.NewImageUpload)
 	api.POST("/upload-hash", imager.UploadImageHash)
 
+	api.GET("/oembed", serveOEmbed)
+	api.GET("/threads/:thread/export", serveThreadExport)
+	api.POST("/reports", serveReport)
+	api.GET("/bans/status", serveBanStatus)
+	api.POST("/bans/:id/appeal", appealBan)
+
+	staff := api.NewGroup("/staff")
+	staff.POST("/login", login)
+	staff.POST("/logout", requireStaff(common.NotStaff, logout))
+	staff.POST("/logout-all", requireStaff(common.NotStaff, logoutAll))
+	staff.GET("/session", requireStaff(common.NotStaff, serveStaffSession))
+	staff.POST(
+		"/change-password",
+		requireStaff(common.NotStaff, changePassword),
+	)
+	staff.POST("/accounts", requireStaff(common.Admin, createAccount))
+	staff.POST(
+		"/accounts/:id/level",
+		requireStaff(common.Admin, setAccountLevel),
+	)
+	staff.GET("/filters", requireStaff(common.Admin, serveFilters))
+	staff.POST("/filters", requireStaff(common.Admin, createFilter))
+	staff.POST(
+		"/filters/:id/delete",
+		requireStaff(common.Admin, deleteFilter),
+	)
+	staff.GET(
+		"/ban-appeals",
+		requireStaff(common.Moderator, serveBanAppealQueue),
+	)
+	staff.POST(
+		"/ban-appeals/:id/accept",
+		requireStaff(common.Moderator, reviewBanAppeal(true)),
+	)
+	staff.POST(
+		"/ban-appeals/:id/reject",
+		requireStaff(common.Moderator, reviewBanAppeal(false)),
+	)
+	staff.GET("/tags/:tag/staff", requireStaff(common.NotStaff, serveTagStaff))
+	staff.POST(
+		"/tags/:tag/staff",
+		requireStaff(common.NotStaff, appointTagStaff),
+	)
+	staff.POST(
+		"/tags/:tag/staff/:account/remove",
+		requireStaff(common.NotStaff, removeTagStaff),
+	)
+	staff.GET("/reports", requireStaff(common.NotStaff, serveReportQueue))
+	staff.POST(
+		"/reports/:post/dismiss",
+		requireThreadStaff(common.Janitor, dismissReports),
+	)
+	staff.POST(
+		"/reports/:post/resolve",
+		requireThreadStaff(common.Moderator, resolveReports),
+	)
+	staff.POST(
+		"/posts/:post/delete",
+		requireThreadStaff(common.Janitor, moderatePost(common.DeletePost)),
+	)
+	staff.POST(
+		"/posts/:post/purge",
+		requireThreadStaff(common.Moderator, moderatePost(common.PurgePost)),
+	)
+	staff.POST(
+		"/posts/:post/shadow-bin",
+		requireThreadStaff(
+			common.Moderator,
+			moderatePost(common.ShadowBinPost),
+		),
+	)
+	staff.POST(
+		"/posts/:post/spoiler-image",
+		requireThreadStaff(common.Janitor, moderatePost(common.SpoilerImage)),
+	)
+	staff.POST(
+		"/posts/:post/delete-image",
+		requireThreadStaff(common.Moderator, moderatePost(common.DeleteImage)),
+	)
+	staff.POST(
+		"/posts/:post/ban-image",
+		requireStaff(common.Moderator, banPostImage),
+	)
+	staff.POST("/banned-images", requireStaff(common.Moderator, banImages))
+	staff.GET(
+		"/images/:sha1/similar",
+		requireStaff(common.Moderator, serveSimilarImages),
+	)
+	staff.GET(
+		"/posts/:post/meido-vision",
+		requireStaff(common.Moderator, serveMeidoVision),
+	)
+	staff.POST(
+		"/threads/:thread/lock",
+		requireThreadStaff(
+			common.Moderator,
+			setThreadFlag(db.SetThreadLocked, true),
+		),
+	)
+	staff.POST(
+		"/threads/:thread/unlock",
+		requireThreadStaff(
+			common.Moderator,
+			setThreadFlag(db.SetThreadLocked, false),
+		),
+	)
+	staff.POST(
+		"/threads/:thread/sticky",
+		requireThreadStaff(
+			common.Moderator,
+			setThreadFlag(db.SetThreadSticky, true),
+		),
+	)
+	staff.POST(
+		"/threads/:thread/unsticky",
+		requireThreadStaff(
+			common.Moderator,
+			setThreadFlag(db.SetThreadSticky, false),
+		),
+	)
+
 	assets := r.NewGroup("/assets")
 	assets.GET("/images/*path", serveImages)
 	assets.GET("/*path", serveAssets)
@@ -124,7 +244,15 @@ func createRouter() http.Handler {
 	json := api.NewGroup("/json")
 	json.GET("/threads/:thread/:page", serveThread)
 	json.GET("/index", serveIndex)
+	json.GET("/tags/:tag", serveTagIndex)
+	json.GET("/tags/:tag/catalog", serveTagCatalog)
 	json.GET("/used-tags", serverUsedTags)
+	json.GET("/tag-stats", serveTagStats)
+	json.GET("/search", serveSearch)
+	json.GET("/mod-log", serveModLog)
+	json.GET("/posts/:id", servePost)
+	json.POST("/posts", servePosts)
+	json.POST("/thread-updates", serveThreadUpdates)
 
 	return r
 }
@@ -0,0 +1,354 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/jackc/pgx/v4"
+)
+
+// Limit login attempts per IP and per account to slow down password guessing.
+// Successful logins clear the account's counter.
+var (
+	loginIPLimiter      = newRateLimiter(20, 15*time.Minute)
+	loginAccountLimiter = newRateLimiter(10, 15*time.Minute)
+)
+
+// Request context key of the authenticated staff session
+type sessionKey struct{}
+
+// Login credentials of a staff account
+type staffCreds struct {
+	ID       string `json:"id"`
+	Password string `json:"password"`
+}
+
+// Validate staff account ID and password lengths
+func (c staffCreds) validate() error {
+	switch {
+	case c.ID == "":
+		return common.ErrInvalidInput("no user ID provided")
+	case len(c.ID) > common.MaxLenUserID:
+		return common.ErrTooLong("user ID")
+	case c.Password == "":
+		return common.ErrInvalidInput("no password provided")
+	case len(c.Password) > common.MaxLenPassword:
+		return common.ErrTooLong("password")
+	default:
+		return nil
+	}
+}
+
+// Wrap a handler to only allow logged in staff members with at least the
+// passed moderation level
+func requireStaff(
+	level common.ModerationLevel,
+	h http.HandlerFunc,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleError(w, r, func() (err error) {
+			s, err := getStaffSession(r)
+			if err != nil {
+				return
+			}
+			if s.Level < level {
+				return common.ErrNoPermissions
+			}
+
+			h(w, r.WithContext(context.WithValue(r.Context(), sessionKey{}, s)))
+			return
+		})
+	}
+}
+
+// Like requireStaff, but also allows staff appointed to any tag of the thread
+// targeted by the request. The thread is read from the "thread" URL parameter
+// or resolved from the "post" URL parameter.
+//
+// The session passed to h retains the global level of the account, so h can
+// restrict actions with site-wide effects to global staff.
+func requireThreadStaff(
+	level common.ModerationLevel,
+	h http.HandlerFunc,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleError(w, r, func() (err error) {
+			s, err := getStaffSession(r)
+			if err != nil {
+				return
+			}
+			if s.Level < level {
+				var thread uint64
+				thread, err = targetThread(r)
+				if err != nil {
+					return
+				}
+				var threadLevel common.ModerationLevel
+				threadLevel, err = db.GetThreadStaffLevel(
+					r.Context(),
+					s.UserID,
+					thread,
+				)
+				if err != nil {
+					return
+				}
+				if threadLevel < level {
+					return common.ErrNoPermissions
+				}
+			}
+
+			h(w, r.WithContext(context.WithValue(r.Context(), sessionKey{}, s)))
+			return
+		})
+	}
+}
+
+// Read the ID of the thread targeted by a request from its URL parameters
+func targetThread(r *http.Request) (thread uint64, err error) {
+	if extractParam(r, "thread") != "" {
+		return extractUint64Param(r, "thread")
+	}
+	post, err := extractUint64Param(r, "post")
+	if err != nil {
+		return
+	}
+	thread, _, err = db.GetPostParenthood(post)
+	return
+}
+
+// Read the staff session of a request from its session cookie.
+// Returns common.ErrNotLoggedIn, if there is no valid session.
+func getStaffSession(r *http.Request) (s auth.Session, err error) {
+	token := auth.GetSessionToken(r)
+	if token == "" {
+		err = common.ErrNotLoggedIn
+		return
+	}
+	s, err = db.GetSession(r.Context(), token)
+	if err == pgx.ErrNoRows {
+		err = common.ErrNotLoggedIn
+	}
+	return
+}
+
+// Return the staff session of a request wrapped with requireStaff
+func staffSession(r *http.Request) auth.Session {
+	return r.Context().Value(sessionKey{}).(auth.Session)
+}
+
+// Log into a staff account and set the session cookie
+func login(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var req staffCreds
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		err = req.validate()
+		if err != nil {
+			return
+		}
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return
+		}
+		// Check both to also slow down guessing a single account's password
+		// from many IPs
+		if !loginIPLimiter.allow(ip.String()) ||
+			!loginAccountLimiter.allow(req.ID) {
+			return common.ErrRateLimited
+		}
+
+		hash, err := db.GetPassword(r.Context(), req.ID)
+		switch err {
+		case nil:
+		case pgx.ErrNoRows:
+			return auth.CompareDummyPassword(req.Password)
+		default:
+			return
+		}
+		err = auth.ComparePassword(hash, req.Password)
+		if err != nil {
+			return
+		}
+
+		token, err := auth.NewSessionToken()
+		if err != nil {
+			return
+		}
+		err = db.WriteSession(r.Context(), req.ID, token)
+		if err != nil {
+			return
+		}
+		auth.SetSessionCookie(w, r, token)
+		loginAccountLimiter.reset(req.ID)
+		return
+	})
+}
+
+// Log out of the current staff session
+func logout(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		err = db.LogOut(r.Context(), auth.GetSessionToken(r))
+		if err != nil {
+			return
+		}
+		auth.SetSessionCookie(w, r, "")
+		return
+	})
+}
+
+// Log out of all sessions of the current staff account
+func logoutAll(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		err = db.LogOutAll(r.Context(), staffSession(r).UserID)
+		if err != nil {
+			return
+		}
+		auth.SetSessionCookie(w, r, "")
+		return
+	})
+}
+
+// Serve the account ID and moderation level of the current staff session
+func serveStaffSession(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() error {
+		return serveJSON(w, r, staffSession(r))
+	})
+}
+
+// Change the password of the current staff account. Logs out all sessions.
+func changePassword(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var req struct {
+			Old string `json:"old"`
+			New string `json:"new"`
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		s := staffSession(r)
+		err = staffCreds{s.UserID, req.New}.validate()
+		if err != nil {
+			return
+		}
+
+		hash, err := db.GetPassword(r.Context(), s.UserID)
+		if err != nil {
+			return
+		}
+		err = auth.ComparePassword(hash, req.Old)
+		if err != nil {
+			return
+		}
+		hash, err = auth.HashPassword(req.New)
+		if err != nil {
+			return
+		}
+		err = db.ChangePassword(r.Context(), s.UserID, hash)
+		if err != nil {
+			return
+		}
+		auth.SetSessionCookie(w, r, "")
+		return
+	})
+}
+
+// Create a new staff account
+func createAccount(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var req struct {
+			staffCreds
+			Level common.ModerationLevel `json:"level"`
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		err = req.validate()
+		if err != nil {
+			return
+		}
+		if req.Level < common.NotStaff || req.Level > common.Admin {
+			return common.ErrInvalidInput("invalid moderation level")
+		}
+
+		hash, err := auth.HashPassword(req.Password)
+		if err != nil {
+			return
+		}
+		return db.RegisterAccount(r.Context(), req.ID, hash, req.Level)
+	})
+}
+
+// Set the moderation level of a staff account
+func setAccountLevel(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		var level common.ModerationLevel
+		err = decodeJSON(r, &level)
+		if err != nil {
+			return
+		}
+		if level < common.NotStaff || level > common.Admin {
+			return common.ErrInvalidInput("invalid moderation level")
+		}
+
+		id := extractParam(r, "id")
+		if id == staffSession(r).UserID {
+			return common.ErrInvalidInput("can not change own level")
+		}
+		return db.SetAccountLevel(r.Context(), id, level)
+	})
+}
+
+// CreateAdmin creates an admin staff account with the passed ID. The password
+// is read from the first line of stdin.
+func CreateAdmin(id string) (err error) {
+	fmt.Fprintf(os.Stderr, "password for %s: ", id)
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch err {
+	case nil:
+	case io.EOF:
+		// No trailing newline
+		err = nil
+	default:
+		return
+	}
+	creds := staffCreds{id, strings.TrimRight(password, "\r\n")}
+	err = creds.validate()
+	if err != nil {
+		return
+	}
+
+	err = config.Server.Load()
+	if err != nil {
+		return
+	}
+	err = db.LoadDB()
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	hash, err := auth.HashPassword(creds.Password)
+	if err != nil {
+		return
+	}
+	err = db.RegisterAccount(context.Background(), id, hash, common.Admin)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "created admin account %s\n", id)
+	return
+}
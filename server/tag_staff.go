@@ -0,0 +1,121 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+// Read and validate the tag URL parameter
+func extractTagParam(r *http.Request) (tag string, err error) {
+	tag = extractParam(r, "tag")
+	err = validateTag(tag)
+	if err != nil {
+		err = common.StatusError{
+			Err:  err,
+			Code: 400,
+		}
+	}
+	return
+}
+
+// Return the highest level of tag staff positions the requester can appoint
+// or remove in a tag. Admins manage all positions and tag owners manage
+// janitors.
+func maxManagedTagStaffLevel(r *http.Request, tag string) (
+	level common.ModerationLevel,
+	err error,
+) {
+	s := staffSession(r)
+	if s.Level >= common.Admin {
+		return common.TagOwner, nil
+	}
+	own, err := db.GetTagStaffLevel(r.Context(), s.UserID, tag)
+	if err != nil {
+		return
+	}
+	if own < common.TagOwner {
+		return common.NotStaff, common.ErrNoPermissions
+	}
+	return common.Janitor, nil
+}
+
+// Serve staff appointed to the tag specified in the URL
+func serveTagStaff(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractTagParam(r)
+		if err != nil {
+			return
+		}
+		staff, err := db.GetTagStaff(r.Context(), tag)
+		if err != nil {
+			return
+		}
+		return serveJSON(w, r, staff)
+	})
+}
+
+// Appoint an account to a staff position in the tag specified in the URL
+func appointTagStaff(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractTagParam(r)
+		if err != nil {
+			return
+		}
+		var req struct {
+			Account string                 `json:"account"`
+			Level   common.ModerationLevel `json:"level"`
+		}
+		err = decodeJSON(r, &req)
+		if err != nil {
+			return
+		}
+		switch {
+		case req.Account == "":
+			return common.ErrInvalidInput("no account provided")
+		case len(req.Account) > common.MaxLenUserID:
+			return common.ErrTooLong("account")
+		case req.Level < common.Janitor || req.Level > common.TagOwner:
+			return common.ErrInvalidInput("invalid moderation level")
+		}
+
+		max, err := maxManagedTagStaffLevel(r, tag)
+		if err != nil {
+			return
+		}
+		if req.Level > max {
+			return common.ErrNoPermissions
+		}
+		return db.AppointTagStaff(
+			r.Context(),
+			tag,
+			req.Account,
+			req.Level,
+			max,
+			staffSession(r).UserID,
+		)
+	})
+}
+
+// Remove the account specified in the URL from its staff position in the tag
+// specified in the URL
+func removeTagStaff(w http.ResponseWriter, r *http.Request) {
+	handleError(w, r, func() (err error) {
+		tag, err := extractTagParam(r)
+		if err != nil {
+			return
+		}
+		max, err := maxManagedTagStaffLevel(r, tag)
+		if err != nil {
+			return
+		}
+		return db.RemoveTagStaff(
+			r.Context(),
+			tag,
+			extractParam(r, "account"),
+			max,
+			staffSession(r).UserID,
+		)
+	})
+}
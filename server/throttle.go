@@ -0,0 +1,70 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// Limits the number of requests per key within a fixed time window
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	counters  map[string]rateCounter
+}
+
+// Number of requests made by a key within the current window
+type rateCounter struct {
+	n     int
+	reset time.Time
+}
+
+// Create a rateLimiter allowing up to limit requests per key within window
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    limit,
+		window:   window,
+		counters: make(map[string]rateCounter),
+	}
+}
+
+// Register a request by key and return, if it is within the limit
+func (l *rateLimiter) allow(key string) bool {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Periodically drop counters of expired windows to bound memory usage
+	if now.Sub(l.lastSweep) > l.window {
+		for k, c := range l.counters {
+			if !now.Before(c.reset) {
+				delete(l.counters, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c := l.counters[key]
+	if !now.Before(c.reset) {
+		c = rateCounter{
+			reset: now.Add(l.window),
+		}
+	}
+	if c.n >= l.limit {
+		return false
+	}
+	c.n++
+	l.counters[key] = c
+	return true
+}
+
+// Clear the request count of a key
+func (l *rateLimiter) reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.counters, key)
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(2, time.Hour)
+	for i, allow := range [...]bool{true, true, false} {
+		test.AssertEquals(t, l.allow("a"), allow)
+		if i == 0 {
+			test.AssertEquals(t, l.allow("b"), true)
+		}
+	}
+
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		l := newRateLimiter(1, time.Hour)
+		test.AssertEquals(t, l.allow("a"), true)
+		test.AssertEquals(t, l.allow("a"), false)
+		l.reset("a")
+		test.AssertEquals(t, l.allow("a"), true)
+	})
+
+	t.Run("window expiry", func(t *testing.T) {
+		t.Parallel()
+
+		l := newRateLimiter(1, time.Millisecond)
+		test.AssertEquals(t, l.allow("a"), true)
+		test.AssertEquals(t, l.allow("a"), false)
+		time.Sleep(time.Millisecond * 2)
+		test.AssertEquals(t, l.allow("a"), true)
+	})
+}
@@ -46,6 +46,19 @@ func writeData(w http.ResponseWriter, r *http.Request, data []byte) {
 	}
 }
 
+// Encode data as JSON and write it to the client
+func serveJSON(w http.ResponseWriter, r *http.Request, data interface{}) (
+	err error,
+) {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	setJSONHeaders(w)
+	writeData(w, r, buf)
+	return
+}
+
 func setHTMLHeaders(w http.ResponseWriter) {
 	head := w.Header()
 	for key, val := range vanillaHeaders {
@@ -99,6 +112,18 @@ func extractParam(r *http.Request, id string) string {
 	return httptreemux.ContextParams(r.Context())[id]
 }
 
+// Extract a uint64 URL parameter
+func extractUint64Param(r *http.Request, id string) (n uint64, err error) {
+	n, err = strconv.ParseUint(extractParam(r, id), 10, 64)
+	if err != nil {
+		err = common.StatusError{
+			Err:  err,
+			Code: 400,
+		}
+	}
+	return
+}
+
 // Decode JSON sent in a request with a read limit of 8 KB. Returns if the
 // decoding succeeded.
 func decodeJSON(r *http.Request, dest interface{}) (err error) {
@@ -0,0 +1,36 @@
+-- Per-tag activity statistics over several time windows. Refreshed
+-- periodically, so the tags of all threads need not be unnested on every
+-- request.
+create materialized view tag_stats as
+	select w.period,
+		tag,
+		count(distinct p.thread) thread_count,
+		count(*) post_count,
+		count(distinct p.public_key) unique_posters,
+		max(p.created_on) last_activity
+	from (
+		values
+			('1h', interval '1 hour'),
+			('24h', interval '24 hours'),
+			('7d', interval '7 days')
+	) as w(period, span)
+	join posts p on p.created_on > now() - w.span
+	join threads t on t.id = p.thread
+	cross join unnest(t.tags) tag
+	group by w.period, tag;
+
+-- Required for concurrent refreshes
+create unique index tag_stats_period_tag_idx on tag_stats (period, tag);
+
+create or replace function encode(s tag_stats)
+returns jsonb
+language sql stable parallel safe strict
+as $$
+	select jsonb_build_object(
+		'tag', s.tag,
+		'thread_count', s.thread_count,
+		'post_count', s.post_count,
+		'unique_posters', s.unique_posters,
+		'last_activity', to_unix(s.last_activity)
+	);
+$$;
@@ -0,0 +1,57 @@
+-- Extract all user-visible text from a post body tree
+create or replace function body_text(node jsonb)
+returns text
+language plpgsql immutable parallel safe strict
+as $$
+declare
+	key text;
+	val jsonb;
+	out text = '';
+begin
+	case jsonb_typeof(node)
+	when 'object' then
+		for key, val in select * from jsonb_each(node)
+		loop
+			case key
+			when 'Text', 'Code', 'URL' then
+				out = out || ' ' || (val #>> '{}');
+			when 'Reference' then
+				out = out || ' ' || coalesce(val->>'label', '');
+			when 'Siblings', 'Spoiler', 'Bold', 'Italic', 'Quoted' then
+				out = out || ' ' || coalesce(body_text(val), '');
+			else
+				null;
+			end case;
+		end loop;
+	when 'array' then
+		for val in select * from jsonb_array_elements(node)
+		loop
+			out = out || ' ' || coalesce(body_text(val), '');
+		end loop;
+	else
+		null;
+	end case;
+	return out;
+end;
+$$;
+
+alter table posts add column body_tsv tsvector;
+
+create or replace function before_posts_body_write()
+returns trigger
+language plpgsql
+as $$
+begin
+	new.body_tsv = to_tsvector('simple', body_text(new.body));
+	return new;
+end;
+$$;
+
+create trigger before_posts_body_write
+before insert or update of body on posts
+for each row execute procedure before_posts_body_write();
+
+update posts
+set body_tsv = to_tsvector('simple', body_text(body));
+
+create index posts_body_tsv_idx on posts using gin (body_tsv);
@@ -0,0 +1,16 @@
+-- Bans of IPs, public keys or both. Permanent bans expire at 'infinity'.
+create table bans (
+	id bigserial primary key,
+	ip inet,
+	public_key bigint references public_keys on delete cascade,
+	for_post bigint references posts on delete set null,
+	reason varchar(100) not null,
+	banned_by varchar(20) not null,
+	created_on timestamptz_auto_now,
+	check (ip is not null or public_key is not null)
+)
+inherits (expiries);
+
+create index bans_ip_idx on bans (ip);
+create index bans_public_key_idx on bans (public_key);
+create index bans_expires_idx on bans (expires);
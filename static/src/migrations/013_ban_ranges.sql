@@ -0,0 +1,52 @@
+-- Bans can target IP ranges. Containment checks need a GiST index.
+drop index bans_ip_idx;
+create index bans_ip_idx on bans using gist (ip inet_ops);
+
+-- Also ban all public keys ever seen from the banned IP range
+alter table bans add column all_keys bool not null default false;
+
+-- IPs public keys have been used from
+create table public_key_ips (
+	public_key bigint not null references public_keys on delete cascade,
+	ip inet not null,
+	last_seen timestamptz_auto_now,
+	primary key (public_key, ip)
+);
+
+create index public_key_ips_ip_idx on public_key_ips using gist (ip inet_ops);
+
+create or replace function notify_bans_updated()
+returns trigger
+language plpgsql
+as $$
+begin
+	perform pg_notify('bans.updated', '');
+	return null;
+end;
+$$;
+
+create trigger notify_bans_updated
+after insert or update or delete on bans
+for each statement execute procedure notify_bans_updated();
+
+-- Public keys seen from IP ranges with all public keys banned become banned
+-- too
+create or replace function after_public_key_ips_insert()
+returns trigger
+language plpgsql
+as $$
+begin
+	if exists (
+		select
+		from bans b
+		where b.all_keys and b.ip >>= new.ip and b.expires > now()
+	) then
+		perform pg_notify('bans.updated', '');
+	end if;
+	return null;
+end;
+$$;
+
+create trigger after_public_key_ips_insert
+after insert on public_key_ips
+for each row execute procedure after_public_key_ips_insert();
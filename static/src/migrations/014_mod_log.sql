@@ -0,0 +1,17 @@
+-- Log of all moderation actions. Posts and threads are not foreign keys, so
+-- entries outlive purged posts.
+create table mod_log (
+	id bigserial primary key,
+	type smallint not null,
+	staff varchar(20) not null,
+	post bigint,
+	thread bigint,
+	length bigint not null default 0,
+	data text not null default '',
+	created_on timestamptz_auto_now
+);
+
+create index mod_log_type_idx on mod_log (type);
+create index mod_log_staff_idx on mod_log (staff);
+create index mod_log_thread_idx on mod_log (thread);
+create index mod_log_created_on_idx on mod_log (created_on);
@@ -0,0 +1,22 @@
+create table reports (
+	id bigserial primary key,
+	target bigint not null references posts on delete cascade,
+	public_key bigint references public_keys on delete set null,
+	reason varchar(100) not null,
+	created_on timestamptz_auto_now,
+
+	-- Set, when the report is dismissed or resolved
+	closed_by varchar(20),
+	closed_on timestamptz,
+
+	-- Moderation action the report was resolved with. Null for open and
+	-- dismissed reports.
+	resolution bigint references mod_log on delete set null
+);
+
+create index reports_open_target_idx on reports (target)
+	where closed_on is null;
+
+-- Public keys can only report a post once
+create unique index reports_public_key_target_idx
+	on reports (public_key, target);
@@ -0,0 +1,17 @@
+-- Staff accounts
+create table accounts (
+	id varchar(20) primary key,
+	password bytea not null,
+	level smallint not null default 0,
+	created_on timestamptz_auto_now
+);
+
+-- Staff login sessions
+create table sessions (
+	token char(171) primary key,
+	account varchar(20) not null references accounts on delete cascade
+)
+inherits (expiries);
+
+create index sessions_account_idx on sessions (account);
+create index sessions_expires_idx on sessions (expires);
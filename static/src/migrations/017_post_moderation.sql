@@ -0,0 +1,129 @@
+alter table posts
+	add column deleted bool not null default false,
+	add column shadow_binned bool not null default false;
+
+create index posts_hidden_idx on posts (thread)
+	where deleted or shadow_binned;
+
+-- Return, if a post is visible to a viewer.
+-- Thread OPs are always visible to keep the thread readable.
+--
+-- viewer: public key ID of the viewer or 0, if unknown
+-- is_staff: viewer is a logged in staff member
+create or replace function is_visible(p posts, viewer bigint, is_staff bool)
+returns bool
+language sql immutable parallel safe strict
+as $$
+	select p.id = p.thread
+		or is_staff
+		or not (p.deleted or p.shadow_binned)
+		or (not p.deleted and coalesce(p.public_key = viewer, false));
+$$;
+
+-- Encode post row to json with moderation state included for staff
+create or replace function encode(p posts, is_staff bool)
+returns jsonb
+language sql stable parallel safe strict
+as $$
+	select case
+		when is_staff then encode(p) || jsonb_build_object(
+			'deleted', p.deleted,
+			'shadow_binned', p.shadow_binned
+		)
+		else encode(p)
+	end;
+$$;
+
+-- Get thread JSON as seen by a specific viewer
+-- page: thread page to fetch.
+-- 	If -1, fetches last page.
+-- 	If -5, fetches last 5 posts.
+-- viewer: public key ID of the viewer or 0, if unknown
+-- is_staff: viewer is a logged in staff member
+create or replace function get_thread(
+	id bigint,
+	page bigint,
+	viewer bigint,
+	is_staff bool
+)
+returns jsonb
+language plpgsql stable parallel safe strict
+as $$
+declare
+	max_page bigint;
+	thread threads%rowtype;
+
+	data jsonb;
+	posts jsonb;
+begin
+	select max(p.page) into max_page
+		from posts p
+		where p.thread = get_thread.id;
+	if max_page is null or page > max_page then
+		return null;
+	end if;
+	if page = -1 then
+		page = max_page;
+	end if;
+
+	select encode(t, page, max_page) into data
+		from threads t
+		where t.id = get_thread.id;
+	if data is null then
+		return null;
+	end if;
+
+	case page
+	when -5 then
+		data = data || '{"page":0}';
+		select into posts
+			jsonb_agg(encode(pp, is_staff) order by pp.id)
+			from (
+				select *
+				from posts p
+				where p.id = get_thread.id
+
+				union all
+
+				select *
+				from (
+					select *
+					from posts p
+					where p.thread = get_thread.id
+						and p.id != get_thread.id
+						and is_visible(p, viewer, is_staff)
+					order by p.id desc
+					limit 5
+				) _
+			) pp;
+	else
+		if page < 0 then
+			raise exception 'invalid page number %', page;
+		end if;
+
+		select into posts
+			jsonb_agg(encode(p, is_staff) order by p.id)
+			from posts p
+			where (
+					p.thread = get_thread.id
+					and p.page = get_thread.page
+					and is_visible(p, viewer, is_staff)
+				)
+				or p.id = get_thread.id;
+	end case;
+	data = jsonb_set(data, '{posts}', posts);
+
+	return data;
+end;
+$$;
+
+-- Get public thread JSON
+-- page: thread page to fetch.
+-- 	If -1, fetches last page.
+-- 	If -5, fetches last 5 posts.
+create or replace function get_thread(id bigint, page bigint)
+returns jsonb
+language sql stable parallel safe strict
+as $$
+	select get_thread(id, page, 0, false);
+$$;
@@ -0,0 +1,32 @@
+alter table threads
+	add column locked bool not null default false,
+	add column sticky bool not null default false;
+
+create index threads_sticky_idx on threads (sticky)
+	where sticky;
+
+-- Encode thread column into struct
+create or replace function encode(t threads, page bigint, last_page bigint)
+returns jsonb
+language plpgsql stable parallel safe strict
+as $$
+begin
+	return jsonb_build_object(
+		'id', t.id,
+		'post_count', post_count(t.id),
+		'image_count', (
+			select count(*)
+			from posts p
+			where p.thread = t.id and p.image is not null
+		),
+		'page', page,
+		'last_page', last_page,
+		'created_on', to_unix(t.created_on),
+		'bumped_on', to_unix(t.bumped_on),
+		'subject', t.subject,
+		'tags', t.tags,
+		'locked', t.locked,
+		'sticky', t.sticky
+	);
+end;
+$$;
@@ -0,0 +1,9 @@
+-- Images rejected at upload time. Each row bans either an SHA1 or an MD5 hash.
+create table banned_images (
+	sha1 bytea unique check (octet_length(sha1) = 20),
+	md5 bytea unique check (octet_length(md5) = 16),
+	reason varchar(100) not null,
+	staff varchar(20) not null,
+	created_on timestamptz_auto_now,
+	check ((sha1 is null) != (md5 is null))
+);
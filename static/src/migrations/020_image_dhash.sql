@@ -0,0 +1,18 @@
+-- Perceptual difference hashes of image thumbnails. Null, if the image has no
+-- thumbnail.
+alter table images add column dhash bigint;
+
+-- Perceptual hash of a banned image, if the image was stored at ban time
+alter table banned_images add column dhash bigint;
+
+-- Number of differing bits between two 64 bit hashes
+create function hamming_distance(a bigint, b bigint)
+returns int
+language sql immutable strict parallel safe
+as $$
+	select length(replace((a # b)::bit(64)::text, '0', ''))
+$$;
+
+update main
+set val = val || '{"banned_image_distance":6}'::jsonb
+where key = 'config';
@@ -0,0 +1,34 @@
+-- Admin-managed rules applied to post bodies on each modification
+create table filters (
+	id bigserial primary key,
+	pattern varchar(200) not null check (pattern != ''),
+	is_regex bool not null default false,
+
+	-- Restricts the filter to threads with this tag. Null for global filters.
+	tag varchar(20),
+
+	action smallint not null,
+
+	-- Matched text is replaced with this by replacement filters
+	replacement varchar(200) not null default '',
+
+	-- Added to the author's spam score by spam score filters
+	spam_score bigint not null default 0 check (spam_score >= 0),
+
+	created_by varchar(20) not null,
+	created_on timestamptz_auto_now
+);
+
+create or replace function notify_filters_updated()
+returns trigger
+language plpgsql
+as $$
+begin
+	perform pg_notify('filters.updated', '');
+	return null;
+end;
+$$;
+
+create trigger notify_filters_updated
+after insert or update or delete on filters
+for each statement execute procedure notify_filters_updated();
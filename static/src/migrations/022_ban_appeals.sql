@@ -0,0 +1,17 @@
+-- Appeals of bans by the banned users. Only one appeal per ban is allowed.
+create table ban_appeals (
+	id bigserial primary key,
+
+	-- Set to null, when the ban is lifted or expires
+	ban bigint unique references bans on delete set null,
+
+	body varchar(1000) not null,
+	created_on timestamptz_auto_now,
+
+	-- Set, when the appeal is accepted or rejected by staff
+	reviewed_by varchar(20),
+	reviewed_on timestamptz,
+	accepted bool
+);
+
+create index ban_appeals_reviewed_on_idx on ban_appeals (reviewed_on);
@@ -0,0 +1,14 @@
+-- Staff positions scoped to threads with a specific tag
+create table tag_staff (
+	account varchar(20) not null references accounts on delete cascade,
+	tag varchar(20) not null,
+
+	-- Janitor, moderator or tag owner
+	level smallint not null check (level between 0 and 2),
+
+	appointed_by varchar(20) not null,
+	created_on timestamptz_auto_now,
+	primary key (account, tag)
+);
+
+create index tag_staff_tag_idx on tag_staff (tag);
@@ -0,0 +1,15 @@
+create index threads_bumped_on_idx on threads (bumped_on);
+
+create or replace function notify_thread_deleted()
+returns trigger
+language plpgsql
+as $$
+begin
+	perform pg_notify('thread.deleted', old.id::text);
+	return null;
+end;
+$$;
+
+create trigger notify_thread_deleted
+after delete on threads
+for each row execute procedure notify_thread_deleted();
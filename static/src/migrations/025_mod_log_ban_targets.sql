@@ -0,0 +1,6 @@
+-- Targets of logged bans and lifted bans. Not foreign keys, so entries outlive
+-- the public keys.
+alter table mod_log
+	add column ban_ip inet,
+	add column ban_public_key bigint,
+	add column ban_all_keys bool not null default false;
@@ -0,0 +1,69 @@
+-- Extract the text of a code node from its highlighted HTML
+create or replace function code_text(html text)
+returns text
+language sql immutable parallel safe strict
+as $$
+	select replace(
+		replace(
+			replace(
+				replace(
+					replace(
+						replace(
+							regexp_replace(html, '<[^>]*>', '', 'g'),
+							'&lt;', '<'
+						),
+						'&gt;', '>'
+					),
+					'&quot;', '"'
+				),
+				'&#39;', ''''
+			),
+			'&#x27;', ''''
+		),
+		'&amp;', '&'
+	);
+$$;
+
+-- Extract all user-visible text from a post body tree
+create or replace function body_text(node jsonb)
+returns text
+language plpgsql immutable parallel safe strict
+as $$
+declare
+	key text;
+	val jsonb;
+	out text = '';
+begin
+	case jsonb_typeof(node)
+	when 'object' then
+		for key, val in select * from jsonb_each(node)
+		loop
+			case key
+			when 'Text', 'URL' then
+				out = out || ' ' || (val #>> '{}');
+			when 'Code' then
+				out = out || ' ' || code_text(val #>> '{}');
+			when 'Reference' then
+				out = out || ' ' || coalesce(val->>'label', '');
+			when 'Siblings', 'Spoiler', 'Bold', 'Italic', 'Quoted' then
+				out = out || ' ' || coalesce(body_text(val), '');
+			else
+				null;
+			end case;
+		end loop;
+	when 'array' then
+		for val in select * from jsonb_array_elements(node)
+		loop
+			out = out || ' ' || coalesce(body_text(val), '');
+		end loop;
+	else
+		null;
+	end case;
+	return out;
+end;
+$$;
+
+-- Reindex posts with code, that had the highlighting markup indexed
+update posts
+set body_tsv = to_tsvector('simple', body_text(body))
+where body::text like '%"Code"%';
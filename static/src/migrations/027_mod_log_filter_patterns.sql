@@ -0,0 +1,4 @@
+-- Filter patterns must not be public. Remove them from existing log entries.
+update mod_log
+set data = ''
+where type in (14, 15);
@@ -0,0 +1,17 @@
+-- Notify caches of modified posts. Open post bodies are written by the
+-- websocket server directly, so this is the only reliable eviction point.
+create or replace function notify_post_updated()
+returns trigger
+language plpgsql
+as $$
+begin
+	perform pg_notify('post.updated', new.id::text);
+	return null;
+end;
+$$;
+
+create trigger notify_post_updated
+after update on posts
+for each row
+when (old is distinct from new)
+execute procedure notify_post_updated();
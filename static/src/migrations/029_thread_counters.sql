@@ -0,0 +1,53 @@
+-- Keep post and image counts on threads, so the index can be sorted by them
+-- without counting the posts of every thread
+alter table threads
+	add column post_count bigint not null default 0,
+	add column image_count bigint not null default 0;
+
+update threads t
+set post_count = (
+		select count(*)
+		from posts p
+		where p.thread = t.id
+	),
+	image_count = (
+		select count(*)
+		from posts p
+		where p.thread = t.id and p.image is not null
+	);
+
+create index threads_post_count_idx
+	on threads (sticky desc, post_count desc, id desc);
+create index threads_image_count_idx
+	on threads (sticky desc, image_count desc, id desc);
+
+create or replace function update_thread_counters()
+returns trigger
+language plpgsql
+as $$
+begin
+	case tg_op
+	when 'INSERT' then
+		update threads
+		set post_count = post_count + 1,
+			image_count = image_count + (new.image is not null)::int
+		where id = new.thread;
+	when 'DELETE' then
+		update threads
+		set post_count = post_count - 1,
+			image_count = image_count - (old.image is not null)::int
+		where id = old.thread;
+	else
+		update threads
+		set image_count = image_count
+			+ (new.image is not null)::int
+			- (old.image is not null)::int
+		where id = new.thread;
+	end case;
+	return null;
+end;
+$$;
+
+create trigger update_thread_counters
+after insert or delete or update of image on posts
+for each row execute procedure update_thread_counters();
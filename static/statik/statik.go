@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00T\xba\x95O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01Q\xa8\xfe]\xbc\xbauP\\_\xd75x\xbb\xa1\x1bM\x82k\x08\x1d\xdc5h\x80\xe0\x10,\x90\xe0\x16\xdc\xdd\x9d\xc6\xdd\xdd\x1d\x9a\xe0n\x01\x1a	\xee\x04h\xdc=\x04'\x10c\xea\xf7\xce\xf3|\xdfWoM\xbd\xf3\xc7\xd4<\xe7\xaf{\xf7]k\xaf\xb3\xcf9\xb5k\xd5\xad#\xf7VVP\xc8x\x0b\x80\x03\x0b\xac\x00\x17\x00\xbb\xbf\xbf\x87\x17\"\xb6T\xbd\x10\x88\x19#\x988B\xd0(\x12\xc6u\xb1\x852\xc2cB\xb5\xab\x1a9\xc1\xf1\xf0\xb8`L\x82\xe2\x00\x93\xb6\xb6\x95\xbc\xaa\x11\xaa0\xd3\xc8\n\x01c\x92\xc7\x93\xd7NtbB\x18Y\x01\x00\x80\xaa\x1fAxe\x92Y\xc1\xb7\x8e\x8e\xeeG.\xb6\xbc\x10\xf7\x88\xfa{#\x04J\xd0	\x81\xb8\xaf\x97w\x1a\xd1VE\x90	r\xc9;11\xa9^\xd4\xabj\x0b\x92\x89\xab\xd6\xc3\xb8\xe0\xc0\xff\xb7\xf1\xf2\x11GEF\xfd\x83\x94\x84\xaa\x0c\x0f\x07\x17\x1a\xe8\xff\x0ei+\xab\xc2\xa4\x8d\xdd\x8c\xb5\x95UE\xdex9\x19\x9b\xda\x9a\xbb\xc1L\xcc-\xad\x1dDi\xce\xbbzi`\xd6f\xa24Z|\xca\\\xcaNR\xe6V\xd6\xf2>.\xe6\x1f|T\xd4M}lM\x85\xcch\xde\x88\xc1D\xbc\x84\xbd\xec\x9d\xec\xcd\xdd\x8ca^\xf6v\x0e\xae\xc2^\xa24\xc6f\x8e&\xe6\xc2\x0e\xae\xc2\xff\x849i`\xff\x05q\xb3\x15\xa5\x91\xf8\xe7\x03\xec\x1fM)G\x17s\x18\x1f\x07\x17\xbb)\x17?\x17\x8c\x9f\x9b\x83\x9b\xf7\x95\x80\x80\x00\x1b\x8c\x87\x8b\x9b\x8b\x93\x8b\x87\x93\x9b\x87\x9d[@\x98\x97G\x98\x8b\x0b\xf6\xafA#\x06\x13q1\xb3\x10~/-\xfb/-\x173\x0bQ\x1a+77'aNNOOO\x0eO^\x0eG\x17KNn!!\xa1\x7fr\xf0\xf0\xb0\xbb\x98Y\xb0\xbbz;\xb8\x19{\xb1;\xb8\xd2\xfe;\x83\xb4\xb9\xab\xa9\x8b\xb5\x93\x9b\xb5\xa3\x03\xec\x9fwc\x13Gw7Q\x1a\x9a\x7f\x97`\xef\xa4\xac\xfc\xbf\x12;\xb8r\xfcWA\x1c\xa6\x8e\xf6\x9c^\xc6N\x9c\xdc\x1c\\\x9c\xf6\xf6\x9c\xffF\xbb\xba\xbd7\xb7\xf8\x9f\xd1\xae\xea\xdeN\xe6\x9c\xef\xcd]\x1d\xdd]L\xcd\xdf\x9b[\xd0\xfe\x1fR\xff3\xf5\x1f\xa0\x93\xb2\xb2\xb0\xb4\xa3\xa9\xbb\xbd\xb9\x83\xdb[iQ\x1a/{'\x0e3k3a)I^\x01!.I\x1e\x19A~\x1enn\x99WB\xfcB\xfcB\xbc\xb2|\xdc<\x02\x02\x02\x122R\xff\xe6\xbeupu3v05\xff7\xd7\xfa\x7fs\xb9\xffG\xae\xb0\x94\x8b\xb9\xb1\x9b\xa3\x8b\xba\xa3\xa3\xdd\xbfwO\xd5\xca\xd1\xcd\xd1\xd5\xca\xd1	&\xf5\x81\x0f\xa6e\xed`\xe6\xe8\xe9\xfa\xcf\xc2\xfek\x9e\xe6.\xd6\x1e\xe6f\xb2.\x8e\xf6\xb0\xffZ\x19a\xeb\xff\xa6\xfe\xcf\xcc\x05\x04\x05\xb8\xa5\xf8$\xa5\xf9yd\x04e^qsKJH\xcaJqq\xf1\x08H\xc9pI\x08HH\xd1\xfc\x8bk\xf6\xffP\xf5\xff+\x97S\x0c&\xc2\xf9\xdf\xb6\xf9\xdf\xa1\xf7\xd2\xb2\xff<\xfe\xafC+\x06\xfb\xdf\xc7\xde\xdc\xc1L\x94\xc6\x85\xe6\x8d\x18\xe8\xf1\xef\x9f\xdf\xbf~>\xdc\xff\xb8\xbb\xbd\xb9\xbe\xba\xbc8\xff~\xf6\xed\xf4\xe4\xf8\xe8\xf0`\x7fowg{ksc}mue\x19\xb5\xb4\xb8\xf0u~nvfzjrb|ltdx\xe8\xcb\xe0@\x7f\x1f\xb2\xb7\xa7\xfbsWgG{[kKsScC}]mMu\xd5\xa7JDEyYiIqQaA~^nNvVfFzZjJrRbB|\\lLtTdDxXhHp\x10<0\xc0\xdf\xcf\xd7\xc7\xdb\xcb\xd3\xc3\xdd\xcd\xd5\xc5\xd9\xc9\xd1\xc1\xde\xce\xd6\xc6\xda\xca\xd2\xc2\xdc\xcc\xd4\xc4\xd8\xe8\xa3\xa1\x81\xbe\x9e\xae\x8e\xb6\x96\xa6\x86\xfa\x87\xf7j\xaa\xefT\x94\x95\x14\x15\xde\xca\xcb\xc9\xcaHKIJ\x88\xbf\x11\x13\x15y-,$(\xc0\xcf\xf7\x8a\x97\x87\x9b\x8b\x93\x83\x9d\x8d\x95\x85\x99\x89\x91\x81\x9e\x8e\x96\xe6%\x8c\xfa\x05\xd5sJ\nr2R\x12b\"B\x02|\xbcgO\x9f\xe0\xe2`cab@!\xe8h`\x10\x00\xbc|@\xc7\xc2\x02X\x01\xb6\x7f:\xc7?\x0d\x10\x80>\"\xd3\x9c\xe4\xd9(\x12FJ]F\xed\x84\x0bf\x10n\n5\xbau\xa8j\x8f\xf13\x9f\xde\xadz/%\x8e\x9c\xe9\xa3f\x9fI\x87\xae\xcd\x8bv?\x95\xba\xd5\xf3\xfb\xcf\x01\xd3\xe7\xbf\x1e\xe1A\xc1!\xa1a\xe1\x11\x91Q\xd11\xb1q\xf1	\x89I\xc9)\xa9i\xe9\x19\x99Y\xd9\x16@^~N\xa1Q>\xa4\xb4\xac\xa0\x08\xa1\x99\x07\x81\xa2\xd5\xd6B\xcb y\x95-\xef\xaaj\xea:\xeb\xcb\x81\xd6^y\xa0\xbak\xb0\xae\xa1\xb4\x079\xc6\nt|\x99\x1en\x1a\xef\x9d\x9c^\xfc\xdc\x94?:\x9f\xbd\xb0T?\xdb\x08\xe0\x12\xee\xe36\xafe\x02\x9bh\xdb\x8dM{\xfbW\x87+\xc7\xc9\x1b\x8b\xe7\xe7\xbbW\x7fp\x1f\x1f[\x97]\x9b\xbfg\xf7\x18\xee\xfd\x9d\x9c\x1f\xc2[GJ\xf7\xe3\xa6\xf3\x88\xcf\x8aWx\x01h\xd8)\x1aI&\xfcA\xcf0\xb6i\xf9\x1bvd\\\xc9_\x0b\x0d\x1cL\x94\x0c$\x06a\x93\xd0.\xcc\xd2\x0c\xc5\x1ej\x12\x94 \xc6\xe9\xe5\x1f3}iHb4x\x17\x05s\x0fQ}'X\xa7\x8c\xaa\"\xb4\xee\x0d\x9f\xac\xb1+\x94\xca\xba\\t\xfc\xc6\x0em\xa6+\x89\xbfP\x9d\xed\x8d\xa1i\xda\xeau\x1c\\\xda\xc4~b\xd6d\x19\xcf\xefN'\xd1\xeeny2z\xe7f\xabP\x11\xd1\xdb5\xf14\x13\xa1N\xc1\x07\xc1fQ\x17\xa4\x1f&\x0c\x85$\xd2r\xc2\xb7\xb0\xf4\xb5\x9d!\xe0\xc7u\xb66\x83\x03SaOa\x08\x04p\x86\x0cF\x917\x1d\xdfh\xc3O\x97\xea!Jw_\x9d\x0b>\x1a\xe4@\x9e\x0d\xdcM\xd6\xb3\xbf\xab \xcc\xd1\x9c\xc4B\x06\xa3F\x92\xa6\x1d\xca\x1dK\xb7\x90\x9f\x0f\xf8\xf3\xa0\xcfo/2Lb\x197\xf3\xfd\xfa>\xb5\x89\xd4\x1d?\x9d\xac\xe5\xb8U\xe9S\xe3\x1c\xda\x80\x00?~\xac\x0bL\xfd\x8c\xe5\xbb\x06\x90\xaf\xdf\x9a\xdf\xe6\xd5v\xde\xde\x96T\xd2 \xa1\x94H\xcb-[Jo\xa9\x8d\x80:\xa7V\x02\xb5\x1fY2j\xf0\xf1\xfb\x8a\x17\xce\x1a\xedr[A\xcf\xb1a\x8f\xb8b8=\x8d\xabZ\xf8\xad\xb4D\x82D.\xa6\xdb\xee\xcc\x1c\xc6k\xe1T.dZz\x0f\xa1\x1e;F>-\xbcE\xbaR\xd5\x99\x14\xbe\xcaV\xdaF\xb1<\xe1\xd0m-a\x9d\xaf\x04\xf6\xe9-\xf7\x89	\xc0F#\x10$E`]\xe89\xd3\xce[\xcc\xcaK\xd1\x12\x1d\xff\xfa\xca\x0b=\x95\x8c\xe2\xa5Y\x9a&\x0b\x06\x08\xbdV\x86\xef\xab\xba\xe8\x17\xbe\"H3\xbey~\xa6E\xb1\xe5zB\x89\xb5\x16,\xd6\x9aa\xb8\x1cHM\xc38d\x05f\xac\x93!n\x9fJ\xe9\x02\xbd\xa0%\x87\x81\x83\x1d\x18\x94\x85\xd6\xba'\x14f\x8d\x1d\x1d\x1f\xbd\x18\x0c\x9d\xfaB\xf1\xa5g\x98\\'\x05\xc9+\xf1\xcc\xe6\xc0\xc8\xc2\xe1\xeep\xeb\xd8<\xe4\xd0\x81\xdd\xcbl\xcc\x136\xb7h\xddO\x95\x9b5\xc5'\x9fDU|\x8a\x9csi\x04N=BZ+\xd7\xcb\x93\xb0\xd0l4'/\xcc\xf0K\xf2\xa4\x9d$*K\xee\xc1t\x0d\x12\x14\xbfl\xcc\xd6\xd4\x1et\x9br\xf8O\x1f\x0dj\x0e%[\xd5\xe4\xbej\x9a\xd1\xb4\xf6:\xb0\xb2Um\x19\x82\x9e76\xbc\x10\xd4\xb1\xab\xa3AY\xf5&x\x1f\xe2k\xf3\xbd\xedB V\xff\xfa\x8c\xd5?tj;\xf5B[\xa1\xbbM\x1b\xa0U\xbb\xbe\x05\x12\xf3\xe4\x12Y\x1d\xe4E+\xa64\xe0<\xa8rAi\xda\x84\xee\xc3\xf8\xc9\x93\xc4\xc9\xf6\xd8\xec\xbb\x8f\xdd\xfbQl\xb4\xc23\x08\x88+\x16\x003\x96\xb05tQ\xfd\xb5em\xec\x19c\xa3\x9c<]\xb1\xc3\x07\xa3\x92}@ \xd1\x98\x9f\xae3\x92\x8cA\xd5\xe59=K\x14vu\xb5\x95\xd7\x10\xc1\xeb_d\xe6\x13(\\\xe0\xab[\x7f\x19;\x08r\xf1\xbcoa\xeb \xcc=\xc1\xc46\xfb\x9b\xc7\xab\x81\x03*s\xe1i{\xce\xde!\xfb\x12'9[^\x9b+\xb62(\x170\xfd\x06\xad\xdb\x86\x91@\x95\n\xb9\x8f.?\x83G\xb42\xa8\xc0\xe1i\xfb\x1e\xf7~\xc45'\x96\xcd\xdc\x8a4\xa6~Ju\\\xa4m\xd7L.\xe0\x87c\xfaG\x87\xc7_g\x99\xb6\x01\xa4\x84+\xfeSvGF\xe7\"<_\xf3\xd8\xb4*\xfdb\x1f'\xce\xd60qI:\xf6L\xc0\xf0_7_\x0f{\xba\xb2\x08\xcf\xe3\x9eo\xad$(\xb7\xac\x897\x1dD	\xe16P\xd8\xed\xad\xfdU\x1a\xbbnZ\xa2\xf1\xbf\xbd\xd8\xcd\x17\n\xffmCMi\xcep~F\x05\x16\xf9\xf1\x1c\xb9P7\x07}\xe0\x82\x07\xe1\x9a!\x1dl}\xf3\xc1\xe6\x02\x1d\xf7B3\x1e\xe8\x99\xd4\xc2C\xfbT\xbf\xba-\xf8\xc5W)\xcd\xef\xb5\xf8\x96\xbd%=@}\xc83\xaeDq@\x1e\x06\xc2\x92\xdf\x02/\x0bt\xac\x88r\x05\xe0\xa7\x1a\xa1\xa2\xe5\x1ap\xa2\xd9C\xfe\x98\xac\xff0\xc1x5\xe8\x15\xacw\x88Wt&\x7f\xcf+\x8c\x0f\x03e\x9b\xe6_\x91\x95}\xbe\xfbs\x106\x1fcDD\x8bx\xfd\xee\xe64:\xa5\x8f\xba\x91?\x0d9q\xc9\xe5wl-\x0f\xc5\x94G\xa2S\xe0G1+\xa5V\x12-!:%\x92|\xb8\xdd\x8c\x9f\xd3\xa2^\xc7\x9a?&\xf1\xb8),B\xf1\xf1\x00,\xbfY\xb7!\x93YP\xa0\xf9 !\x01R/\xa7$\x98\x90\x12\x04\x90\xf6\xe0\x07\xbb\xc6\xee-\xfa`d\xf1\xed\xb3\xebT\xef\x9ab)\x14\x8a?4\xb3\xa8Y*!_\xae\x12\xc2\x1d\xedw\xfc\x13rM\xe8\x8e\x8f\xf2\xa4\x14\x86\x92\xd3\xd7v\xfe\xd2X\xf2\xdd\xbd\xe4\x12e\x93\xdcf\xea\x80\x8f\x19tr\xa6]\xe8\xd1S\xe9\x90\x93kG\xd7d\xb2\xe8\xbf\x9cQ\x1d\x99\xb0\x08h\x14}\xe6T\xc4\xcc\x19\xa4`\xa4\xf9.3\x82-\x93;\xe1\x98\x83\x9e\x85\x10\xc0\xc0\xac\x10\xcc\x89\xea\xb74\x01\x01\xcf\xe4\x0f\xd1\x1c\x0e\x15\xe7\x04\xe7\xb2?\xe5\xee.\xb6zw\x9a\xf1\\z3\x10V\x88=\x13\x9fr\x81&\xbf#\x7f_!\\\xd61\"\xf9\x8a \xffY*\x9dy#\xd6x\x0dsR\xe2\x11\xda\xa1\xd8\xc0\xf2\xb4E427\x97\x10!\xbe\xac\xa3*\xa9NX\xd4\x17k\xcaxD\xa6\xb8\x0b\xc4\xbb\xf7\xb2h\x940(\xccF3\x88\xa3?R\x1d!e\xb2.\xa7\x99\xcap\x81'3V\xc2X>c\x9e\xc1\xaf\xf2#b\x8d\x81\xa6\xe5\x11)\x0b\xc5\xb2<#kE\xb5\x91wD\xfd7\xc5y\x99\x95\x0f\x00\\\x11\xde9B(\xb4\xc0,\xd7\x85?\\\x10\xee\x96&\xc3\xc7B5P\xf9\xa4\x8c_\xa3`\"\xd4\x9aQ\x1c\xa9y\xe7\xc7\x93\xf4jAV\x132\xf6\xc1\x1ds\xbe\xeaj\xc0Bu\xd8f\xb1\xa7\xbc\x05\x0d\x00\xfa0\xa0\xbf@x\xe8V2\xd1o\xed \xaa\xf0\xe0\x08op\x98\xab\xc6\xa1fe\xe5R\x97\x0fq\x0e\xc1\x19\xabb\x1e\xcb<2\x18\x8e\xee\x94F\xa4Q\xb3\x0bX\x08#A\xb1\xfel\xba\x84\x85\xf3\x9d\x9a\x92>i\xb7\xc7\xe6\xf4\xcbA\xe8V\x1e\x05XG\x00\x86 \xa0M\x00'I5uNfT\xdd\x05\xfc\xf3=\x1a\x80zv\x07\xda\x9c\xa9\xd1\xf7;\x85U\x83Uh\x87\x88\x8e\xf5E\xcd\x0e\x8bIvGO\x1c\xf8\xe6\xd0\x84\xd9\xdfL\x06|\x85\x90\xcc\xb9\xf77\xed1\xcb\x8fv\xe0\xcc\xd3\x15_m\x95\xe89e\x84<\xa8pu\xfdj\xb1\x8c\xb3\xa6\xceA\xf4\xd2\xc0\xe86\xc4\x8f\xf9\xc3\xb45\xa1\x93.\x06\x13CS\xbby\x8dl\xde\xe9\xc6\xbddRgd\xa8\xb5\x92)K0\xf6\xc0\xd7\xce;\x88]`\x10_\xcf\xe3S\xdb\xe6r\xe6\x0b\xeb\x0f)\x06\x07;*\x9f\xf1\x84\x10!u\xcc\xa3\x97sK=dS\xf5\xeeg\x16H\xb4\xa1\x12jj\xe8e~x\xca\xfa\xed\\Z_\xd2\xd1`\xf42\xb3R\x85\x9bd/Wy\xbd\x0d\xc0%\xcb\xf3\xe2\xcb\x80\x9a\xf838\x99\x89aY\xb1S_\xdb\xa9\x95\x96\xa6\xbe\x0e\xb6\xb7d\xbf\x02\xb4\xf6\x81`f\x8f\x87\xc5\xec\xe5\x87\x8b\xd0\xc8%\x11#V\xb9\xd6n\x89![\xbb\xd5\xca\xd0p\xaeh\x95W\xf6\xe5xh\xe2\x92U\x88\xe0Ix\xed\x95\x0d-\x8e\x99\xbc\x1fQfC\x0d\xcd90\x17\xf5Z :\x17:\xe1\xa2P\xac/\x06\xa0\x0b\xcf\xa0\xec\x8f\x13\xec#v\xa4\x10\xa8\xec\xb4\x85<\xacG\xd9\xbc\xe2\x12.\xdd\x96\x1d:X\x96\xa3'\xd2\x19\xfdI\xc5\x93Hw4a\xe7c]\x9c\x95\xe1\xe4\xb0\xc2\xf4\"\x9a\xd5c\x93\x0f_K\x9e\xb6f|\xe8\x07,\xa2\xaf]\x94\x95\x91\x98\x96\x18\xb6:\xaf_/\xb6\x0e\xb9}8\xc4\xb6\x94%r\xef\xf46\xa0\xaf\xdf\xb7\n\x16\xd3)C\x8d\xc9\xdc\xbd\xc7B\xd9h\x88\xfcZ;\n\xf7hV\xac\xda:8\x11\x1e\xb9\xc83i}\x89\x0bW\xeb\xd5\xa2t\xfb\x9e\xa4\xc1\x00\x97t\xfe\xd5\xe4\xf7S\xa1\x0d\x89\xb1\x01W\xfc\xf0z\xbc+\x7f\xa0\xa5I\xf4\x9c\xec\xa4\xd6\xa5S\x85\x08\x19cd#\xfb\x0e\x0e\xdfa\x9b\x17\xa2\xe2\x00\x05\xfb\x8a\xacC\xa6l\xd0\xb0\xd9#?\x0f\xe2\xb9\x1a\xd0\xf3\xf8\xc5\xe9\n\xb1\\e\xa8d\xcfS\xbc\x07\x90\x8e\xfe\x0c\xb9;\xae\x07\xf7\xc3o\x0c\xed\x11{\xf4\xe8\xe2\xcf|0\xfb\x85\xe5\xc21\xe0\xfb\x04\xf2?\xc7)\xd3n\x08\xc8\xc8\x99\x0f\x11B:\xc3\xe27\xb9\x11\x9d\xbb\xaf\x19\x1f(\x038\x1e\xdd\x8a\xde\xd5\x9d\\\xca\n\x1d|#\xd1\x1a\x93qJ\x81u`~\xb5\xcew\xe6\x81\xaf\x9f8\xd5\xb1\xf5\xb7\xd5\xc6\xd5\xfav\xfbH\xfd\x90\xa6\xdb\x17\xb8\xf3a\x14=\xd8\xb5\x17\x02\xf1\xeb\x80\x85\xef\x10p\xe2\xa5\x11t&\x9fk\x91\x973\x84o\x13\xba\xf6\xce\xbbo\xf1D\xb8\xce\x9dj\x84\x9f33\x06\x19y\xae*\x85r\x8aT\xe8\xc8\xc8R\xf2f\x93o9|\xa6\xa1\x9e\xf6\xe9\x92y\xd1\xf4\xa9\xb7rf\xd4\xf00U*\xf9:P\xcb\x87k\xe3\xb8\x86\x876\xff\xc5\x97\x93f9=\xce\xdf\\\xfa\xe7$\x8c\x87L\xdf]\x9fy\x91&\xa8\x07\xe4]\x88\xee\x98\x16\xf0\xed\xbd\x9eC0\x98\xfd\xdd\xc4\xb7\xee1\xcc \x08S\xae\xbd\x04U\xf5\xba\x0bI\x1a\xfeZ\xb5\x1c\xc2\xbc\xf9\xfe\xe7<\xa4\x17\x0e-\x15{\xf0\xae\xf8\xfd\xed\x83\xc0\x1f\xa0\x10\xf9s\x00{`/9k\xe2R \xd0~F\xb1\x96\xc6+	\xdd\x0b(\x04\xd1:\x81\x8d0\xfc\xfff\x80&^\x05\x80\xdb\xa1\x8f\xe7!\xfe\x16\x15\xf6\xb5\xc9p\nq\x10.\xa6\xef[w\x00\xef\xe8\x1d\xa1|\x10nC`\x9d\xc0\xc7\x15q\x10E}06*\x88i\x84e;\x144A\x17H\x9b\xc9C[\x1f\xc0\xc8$AT\x18\xcc:\x12\"z\x14\xacH\x12\xa2,\x13\xc2GF\xf73\"d\xf5\x90\xfd\xf5H\xb0X{\x90\xc0\x91\xa6\xe4\xc8;i\xed\xd0\xcfM!\xefF\xc8\x1e\x86C\x14GB\x7f\xfe \x1c%\x0e\xfb \x1f\xe6\xde\x1a\xa2\xe9\x15r\xbaD+&\x1fnf\x15nH\x16\xc6L\x17\xaeW\x11n\x9b\x89\xfb0\x1cL\x19)NT\xa1O\xc6\xe5\xeet\x04\xd5A\x84[\x8e\xb0(1\xf9\xed\x02\xc1\xf6K\x11U/#\x88.\x08(\xb4\xfd\x10\xc3\x00\xb9Sd$\x02\x1a\xd8\x1ebx\x14\xf9\xe1\x0b\xb0K\x14\x9e2\xc2\x12\xed\xe5\x1a\xc6\x14\x96\x17	M\x93\x0f/\xf4\xf2)\x19\xc1\x8a\xb6\x8a2t\xf2\xab\xb4\xf2\xcb\xbb\x88\xaaC`6XE7\xb5c\x12z\xc6t\xb6\xc7|F\xc5\xe0\xf7\xdc\xc7 \xc9b\xfb\x05c\x07\xb5c\x87\x8e0$\xfec.9'7/\xbf\xc0\x00(..,{W\x82(\x95\x04\xa0P\xc8\xa7\xf2\x06\xe9\x12HMkM]iu;\xd0\xd8X\xdc\x82\xd6?0\x88V[\xd2Z\xd7S\x88\x84~\x99\x1e\x1cn\xf9<\x9e\x07\xf4\xcdL\xb7\xd5B\xe6\xc7\x16\xb2\xab\x97\xbf\xac\xad\xaf\xb7\x1f\xacwoe\xec\xec\x0e\x1d_\x1ev\x94|K\xff\xbe\xbc\x7fu\\\x8cK\xf8\x88\xfb~\xa1\xa9\xedh\xa5\xcb\xff\xea\xe7\x1f=\xcc\xc4 \xa9\x88SL\x86\xfe\x1fl\x1b\xad\x9a\xd8\xe4<~\xf7\\\xc5\xd2R\xd0\xa6\xbc\xaeH|xo8##:Q\xe4\x89\xe9\xcb\xe8\xc1\xf2\xa4WF\x04\xf4\xc3i$\x8e\xb3\xae\xe4\x98\xf0\x91\xc7,\xb2?\xeb\xa1Lc:\xcd\xae\x03^\xde{Y\x8ctBzCn=\x14\x9dsG\xed\x9a\xb3\xf1\xec\xe6,\x11eL\xc6\xaa%\xc5\xbc\x96\x8d\xb4\x13\x9e\x9aqA\xebci\x93L\x988;c\x06\x80\xb1\xbaf\xa8\xf9tO\x88E\x9a\xfd*o\xf1\x166\x99R{=\xd09\x82\xf7\xe9\x97\xc2\xc4\xc0\xc60\xda\xef\xbf\xc4\xb46A\xdb\xe3\x19\xf8\x1fW=\xd9\xcf\x80\xe1Dj\xc5\xa9\xaf.*\xe6\x13\x08nN\x80\xe4Yy\xc4\xd2\x97\xe7\x19\xd7\"\x0e7\xa2\x90vV\\74\xa4\xcb\xc9f\x96@\x15+\xde\xaf\x16g\xe3\xc2\x10\xe7\x92\x9aI~z\xa0\x9dX}\xa4\xfb\xf5\x13x\x1f%?\xb0\xa9\xa2\xdd~j<d\xdc%\xac\xe0Ww}X\\~\x98n\x987q\xfbm\xa5c|S	\xdf\xcd\xce\x18%\xf9\xec\xd7\x10[\xb7\xcfI}\xe8\xa5\xa1\xc7\x151\x938\xef\x04\x1f4!`/7b\x94\xe9\x11D\xb6E\xd2\xddGZ\x10\xb4F\x9ej\xc4\xfd\xb0?\xff\x18\xc7\xff\xfbO\xb2\xa1\xdb\xec1\xff6\xf19\x968,\xfc:\xe8V\xdb\xbb`\xf9\x87\x92\xfc\xce\xac\xd9\xb3P:\x85H\x990\x94\xc1\x08\x8dSU\x87\x17q,\xf7\x18I	W,\xbdy\x89\xb4\xe0\x81Uq\x88\x9c\xbe\x16*\xfa\x8d\x05\x14\x84\x1e\xf2\x80\xae\x19L\xf5}	&\xd6*n\x1d'\x8e\x7f\x82	\x01\xb4)d\xf7\x13\xde\x13\xa4[\x18\xc8\x95\xedc\xdbKSH\xc5$+\x06\xd3F\xa3\xa3\xe3\xc1@YB\x8d\x9e4\x04\xddO\x0e(\x8dm)\x98\x14\x8c{ \x0f\xa92\xc4\xe6ac\xe9d\x0dhp\xf4L\x05<d9\xe8>K!+8>\xbc\xdc\xdc\xda0\xfd\xc88#\xadS\xb7(T'\x1c%a`BX\x0e\xf1\xb0\xa7\xb5\x10\xcc\"@2W\xe4\x17\x83OR\xd4\xa6n)\xfd\xc2\xd3u\xed<K\x88\xc2\xcd\xf5u\xff\xd2U(\x0dk\x8b\xdb\xaa\xb5?\x97\x9a)\xcb\xfc\xfe\xc9\xbe\"\xf0\xe7\xc9\x9eC\xc9\x9cy\xa1\x11+\xb6\x07\x190\xffB\xf8Q\xab	f\xc27\xc1G\xaa\x9f\xa8\x88\x8e\xaf\xd4\x85\xa3\xec\xbd\x11YY\xbbj\xb3j\x9bB_LS\xed\xb7s\xc7\x047\xaa\xd2Q\xe5\xcc\xae\xe9\xdfg\xfa\xd8E\x17\xa6\xf0\xf9\x86C\xe9\x8c\xf5\xe5I\x95u\xef\x9f\xbf\xafi\xa2:G\xf9\xbe\x17}\x19\xd2\xd3\xfaRAu\xe048\x19v\xab\xf0\xe5\xc6P\x9c\x93Ew\xfd\xe7h\xd9\xf3\xc3\xb5J\x0e\xea\x88U\x0b\xfd^b}\x14\x02O5V\x1c=\x88x\x9c\xady\xd6\xed\x00W\x99K\x8b\x8d\x97e\x0c5\x11;%LX\x88\xcd\xe2D\xcc\xe223d\xe1M\x0fpS\xe7\xd9\xe9\xb3rz\xce\xb2\xb6%RI|\xe6)\xf9\xdc\xcf\xd7\xad=\x89\xdb\xab\xedZ\xed.m\xa5y\xe7n\xe3\x1e\xd6\xbd2\x8a\x0f\x83#\xbf\x06\xcc\x87\x91\xa9\xbeZ\xffu\xb5\xeaD\x1a\x7f\xb3\xe6\x91\x03\x16/\xf0\xb6\xec\x9e\xd2\x0b,	'\x1b\xc5\x88\x01\xb7MX0n\xacv\xd3%\x9d5\xaf^B\x81\xb6\xed\xb4\xc79\xc6\x8d,\xcf\xe8\xc7\xb6E\xd7\xe9u!\x1b\xd3\x10\x95\xbb\xa3K\x1a#\xce\x15\xff\x12\x87\xaa\x8b\xae\xfa\xda\xd3S\xcc\xc1\x17\xe3\xfbMKS\xa7\xdf\xfc]\x9e\xde8\x9a6\xd90\x01\x11\x9c\x81n\xd7%V\xad\x0fJk\xf3\xba8\xcb\xeb\xa4N\x12@\x98\xc0\x1b\x1d\xfc\x199\xce\xee\xb3\xaf\xb6\xdd]i\x9fRb\xff\xfe\xac\xa5|\x1a`\xe4\x9e\x03i\xe6\xa2\x11\xb9.\xe2\x04\x83\xc8\x90?\x7f\x1f\x8a\x8c\xaf\xc7{dU\x15\xfc\xa9\xb5D\xa8/\x98\xab\xd7\xecll\xeb{\x82\xa0S5\x1c\x1d!\x05\xf3\\\xef\x05q\xe0u\xfeX\xdf\n16\x05\xd0\x01;\x9c`\xef\xaa\x8f\x8d\xba\x10\xe1N\xfd\xc4`w\xee\xb5+\xf5\xa3\x9e\x9a\x96\x08\xbe\x13+\xeao\xbf\x05\xe0\xe0\xfbi\x7f\xb6\\J\xdc\xd7|o~N\xaa(T\xd1d=q\xf4b\xbay\x89|'\"\xbd\xc3B\xd2\x98\x1c4\xb3C,'b\"\x10\x8fBV\xf1q[\xf2\xe8\xa7\xfd\xb1\xf2\xa2gq\x98\x0e\x04%\x8a\x93$i\n\xa8\x01\x8f\xe8\x00\x19R\x1c\xca\xb3E\xfc$\xfc2\xc1\xfc\xf32y\xd3\xdd\xe0}\x03\xb6\xbd}\xf5\xf8mu$\x1cH-D7F\x87;\x90a\xe2\xe3~\xc3F\xa3\xf8\xac\x0e&\xd8\x1a~S{\xe1\x9fAI\xf7\xb2E\xb6[J\xa5\xc1\xb3\xf6do\xbb\x11%\x02\x895\xb1\x005\xd0UpY\x0b\xea\xe2\xc3@\x81\x04;\xb3\xa5\xe6\xed\xa3\x0d3a\xc5\xcf\xcd\x993\xdc\x15\xd4ke3\xf2\xed\xcc\xdd\xd0\x93\x83D\x0e\xd4BZ\x07\xe9\x19g58\xadu\xd8\xa2\\q\x18\x16\xe9\x04\xfbLX\x92uFn\xe8\x90`\x06l1\x16;c\x7f\x05\xe0\xbbv0\xcd\xb8\xb5k\x0eO\xa8\xe2\x16\xc12\xe5\xe0Qr\x86\\iI[\x0f=\xb6\xb5\x81CJ\xfbu0\x0e\x86L6}\x8f\xab\xcf\xc0\xe7\xe7\xd9+\xda\xef\xd40\x1bL\x18\xbe\xd1\x03\xfcog\xad\x15xT\xdf\x81\xad`\xdb\xc3Uq={\x85\xfdkz\xb7d+9\x9d\xdf\x94]\xb8r\x16A\x9acs\xd1\xdfSr\x1f_\xd8o\xa3Y\xc9\xcd\xf8\xc2\x89\xf2\x17|\x8b\xdf\xfe\xd2\x94o\xd3\x89-\x13\xb7\xb7\x96a\xd7e0\xb2\xfe\xc7\xaa\x9f\xa0y\x10\xcf(\x87^\x95\xbf\x9c\xb4\xbe\xc5\xedx\xaf\x8e\xce\x87\x90\x9a<\x11\x94\"~]\xca\xc2W\xf9*\xee\x98\x1a\x8e\xa5q\x9c\xd6\x98\xaa\xa5|\nb\xc1V\x8f\x8e.D\xa3\xc2\xebS4\x16\x86\xd4\x05\xf0U*\x11\xdbR\xe7\xebj6)\xe0\x016O\xaaU7)\x96\xd4F\xdc\x89\xa9\xd1t|\xd3a\xd1\xd05\xe2\xea\xaeO\xd6t\xf7+K\x98c\xaa\xf5A\xcap\xcc\x0d\x16-\x00\xbe\xd5D\xb8b\x83\xc8\x86c\x0f\x8b\xf1\xd7A\xf8\xac\xcc?2\x0d<\xa5\x03\x9a\xec\xc3l\x8dE\x7f\x05=\x83\x17\xb6\x86\x10\x9c\xa5\xa0\xa2Psb\xfc\xd5\xcf\xfa\xd7l\x9cW*\xde8\xc3\xd1j\xfd\xed-^;\xad=\x8d\x0cFo\xab\xb1w\xb3g%_\xd7\xdc\xea\\\x92\x01a\x7f\x16\x8cV\xce\xbe\xabBtG\xfc\x0e\xafj{\xcf\xf3\xa3}D\xf9\xd1A\x13\xcbW\x1f\x10\xff\xd1\xd4\xf2\x07\xc0\xda\xc1\xd1\x1db\xe0\x17~Z\x88@{^\xb8\xfe\xbd\x95\x0f\xd4\x1bWmx\x86\xf7f\xa1V3<<\x8c\xd5g\xb7sy2?\xa3#\xbf\xfb\xccm?\x07\x9b \xd72\xf7)p\x12\xc0Zo\xb0\xdfz\xdb\xa5\xf0\xa4\x8e\x0e\x9bM\xff\xca\x85\x10H`\xebc\x9a\xca1\xbc\xa1i\xeb\xc0O\xafu\xfb\xc5\x9c\xc8k@$\xf0``\x0d\xe6\xaf\xcei\xf6\xf9Q\x8b\xf4\x7fK-\xd37I\xa0\xd7:\"\x9f\xbf^\xf0\"J@\xfd\xf3\xc2\x17\xa736\xbf2[c\x1b\x95q\xecb\xa6\xd9\x00\xcf	S\xe4O2\xa4'\xf1%\x0c\xc0\x0b\xe6\xc9\xe9\x98\xa0;\xb0\xc0M\x998px[\x88\x8e\xb7\x96\xcbd\xb6\xba\xfcc\xae\xad\x80)\x043\xfa\xef\x87\xa0??\xb3D\xb3 \x97\xef!\xbf\x93\xf1\xacN+\xed\xf8\xad\x8aS\xd6j\xc4D]\xf4\x14\xc9\xaf\x98\xa6\x84x\xc3\x05\xcf{6\xc7\xec\xc0\xe2J]\xe5\xd8\x92D\xe1\x07DI\x8f\x02iV\xe5\x1c\xd99\xeb\x14a\x05[\xb8\xc7t\x85\x8a\xba\xf5\xe5\xc4e\xa0\xc2$\xacW3o\x0cG\x1bt\x05\xc8\x0fF\x82\x07Y\x7f\xb34V\xbeS\xeeV\x9f6!\xfa\xf3\xc9\xa1\xdaX\xd4ZwI\x18\xa6C\x16VP!j\xff\xcc4m>\xd4b\x101+oG\xcf\xe9A\xacx\xd3\x8c\xf9k\xf4\x9bF\xa94-%\x04hY:\xb3\xb4=\xf5\x9a\xdf:C\xd0\xb6\xc6\xa9\x9aT\xe8\xaao\x93\xa4\xe9 \xf7\xed\xe2\xc4\xbbj+3)\xbb0jm\x8b\x02\xf8FV\xca\xdb\x08c\xfc\x1c\xe7\x1c\xbe\xcd\xb42\x8f+\xd3\x18\xd8\xea}\x00\x01OF\xbc\x86d$.\xe2I\x9fo\xbcL\xffA\xab\n\x1a\xd8YDa\xa5\xae\x93[W6\xa6\x05p\x0e:\x90-\xb5O\xf0}\xbf\x0c\xea\xbd\x1bN$\xbe\x85w\xcd\x0d+\x19x\x11\xf9a\xb7\x9a\xe919\xc7\xd7\x971x~\xbe\x9f\xc8\x98\xba\x89]\xf2\x1b7\x1a\xaa\x1a\x91\x8b\xafQ\xd2\xf9\xf3\x8e\x89\xba/_\x81\xfc\x91>]u\x7fS-\xdb\xcb G\xe5\xaeQ\x00\xbe\xb5)\xe3%\x11lhWP`\x82\xce\xd8\xfe\x95\x10k_I,\xc0`:\x94\xa0\x8bh'\x82\xb9\xe9W\xf7q\x00\xbf=\xda`,fG\x89\xcfQS!\x1c\xaa\x82\xe7\xa9$\xe3C49Q\xb2~nh\xd0-\xf7r26\x1d\xb9\xf9X{\x97Q\xdf\x8d\x8a\x8c(\xd8!\xa5\xf6\xd4\x8e,.N\nd\xd8'\xef:\xef\xf6\xf4\x9d\xf5\xdf\x14\xd9\x1e\xc6v\xc3\xf28\xbd\x02\n\x91Go\xeb\xdc\xc7\xcc\xf9n}\xd8\x84\xd5\xbe\xe1x\x8bu\xfe\xbd\x1d\xe1l\x99zS\xe3>\x90\xb3f\x01\xcc\xc0\xa1n\x9c\xfe\xc7\x02\x81\xc5\xec\xeb\xbb/\xe8{\x87\x8b#^\x00\xab\x05\xfb5tOn\xa6\x19\x03gK7\x84\xc8\x00.p\xcd\xc7u\x9b\xd9:\xb1[\xbe\x99\x00\xce:\xf5+\xc4\x92\x18\xb5\xa2\xd4\x8a\x88\xe1\xfb\xba=Rxe=\xa7<\x89\x8f\x81\x04\xe8\xf6\xd0\xc3]\xc3\x7f\xae\xc2\xb7\x04\xf9\xde\xbbYS\xaa,\xf0q\xa8\xcd\xf3/c\x80/=\xdc\xb7\xcc\x17\xf3( \x8d0\x10\xd7\x8a \xc7\x0d\xc0g\n\"\x81\x04\xac[\xc2/\\\x83@x~(7\xef\xe7\xa8 \x162z\\\x04\xe8\x8fj0\x81\x95\xcf\xa6\x076Q\xfb\xdb\x936\xdc\x0e\xd2\x10\x9eH\x9a\x97L\xc1\xec|!\x96\x8do\xc9\xc5\xd5\xde\xdc\xe3H\"|\xbb\xdbh\xaf,C5d\xc8\x15FB9\xe5u\xdfE\xc2\x83R}\x17\x96\x9eiX\x85\xe5\x84\x82l\x17B\xd3\xafC\xe5G\xb4?Z\x11\x18[\x85\xd1!L-Fp%1\xe1\x08$ v\xf4bL&\x1c\x03/\xc2e$H\xb9=\x04|\xab\xeb\xd3\x1e\xa13\x82.!\x0f\xf2\xc3$c%\x06\xd8\xd2\xc2\xed\x9d##Q\x98\xa8\xe1H9\xcc\xc8\xb7\xe8QQVP\xb8L\x94vZ\xd4\xb3\x9d\x88k\xfep-\x88G\x98\x97]\xfc\x08\x06!_$\xc6\xc5\xb3|\xedh'.5\xac\xfa\xd0jTt\xed=\xb4>\x13`U\x8b\xc9M$\xed<\xc2zQ\x81\xfa?\\\xb2W\xecHf\xecX{\xec\x04*v\xea>V\x1a\x0f\xfd?b\x99\xe3\x81\xec\x1c 3\xdf\"\x1b\x02-)\x81\xe4\x14 \x0c\x81b\xb4\x9a\xda\x1ahy^e\xb3&\x00\xad\xeb\xa8m\xc8\x96njA\xbe\x05:\x07\xbb\xca%\xdbz\xfb\xc6Y\xb3\xbfL\xa3}n\xfb<\xd1\x97\xdd\xdeQ\nY]E\x0d\xadC\xc7\x16\xf2\x97:K\x0f\xd7\xd66\x1a\xd6\x87w\xf76\xba\x0e\xaf\xbf\x1d\xa3\xbe\x95\xec\x9c\xa7\x0c,\xdf<\xde~M#\xd8w\xa0~\xe3Z\x82\xf7Wq)\xbeP,$\xeegw\xb4\x1c.L*x\xf5\x85\xa4:\x1d\xbenC\x18)\xaf\x83\x9f\xdb\xf8\xae\x1c\xfcV\xf0\xd6f\xc8z\xaf(\x9e\xc1\xb8h\xa2\"\xaa/4\x07\x97\x9f\x1b\x955o^\xf4\x98\xcc\xbd\x1f\x96\nA\x1eL\x96\x08z_}=r}\xa6\x94\x00\"_\x94V\xe0\xab\x9c\xcbW\x06\xd1\x7f\xe8`^U\xd5\x91e\xd4\x17\xcdN\xb1\x1d\xafV\x11\x90\xd0F\xb6\x9e\x811\x0d \xb2\xec,w's\xaaw3y\x92\x8arg`\x10\x1c\x0d\x07\xfa\xdbc^\xbc\xd2\xe3\xbc1\x1b\xa5|\xa4\xd0\xe3\xf0\xaa\x86J\xa9\xc3e[\xe3e\x8c!;\x06{\x91iy\xe2\x17\xab\x9fz\x1dn\xc7\x10\xafy\xeb\x8dQ!\x8b\xcf\xbe\x85jy\xfeh\xf0\x9b\xf0'\xe5\xae\xcfd\xe3\xb2\xf4f\x17%&;|pq\xe1\xc1\xbb\xc4\x91U\x95\xec[\xfc\x0e\xeb3h%\x9aO\xbe\xa6ElM\xf7\xb3\xf3\xc6\xebK\\\xa1\x8c2_'\xa8\x88q\x1dh\x10\xd5\x99F@{\x92\xcb\xb1\x89\xe3}7_K\x9b\x93^\xb6\x8d\x9f\x92\xd6\x7f\xf2a}\x06\x1c\xb8\x18\x88\x125\x0cO\xf8\xaeO\x0ec\x81@\xaf\xe4\x01\xffVq\x00\xe5\xf5+\xad-\x1a\x0eX\xdc\x7fd\x85 \xe7\x1e3\xdf\xaePE\x16\xfaa\x03\xb0\xbe(\x82W\x88\x9d\x901\x99\x97\x86\xae\x0f\x1a\xf2/\x94N\x9e$\x1a\xc3\x1b\xb8\x16\x8b\xde\x9e%\x85l10\x95#v\xa2\xf1\x84A\xf0\xd0\xe0/L\xb4n\x08\x1d\xb9X\x9f\x98\x1a\x01q\x89s;\x82\x02\x06F\x1e\xc1\xbdD6\xc9\xbe5#\x05\x0ef\x94^\xca\xe5\xe1,\xfc\xb5\x02\xb9rB\xf3o\xaf\x9dt'\x8f\xd1\xc1\xa4\xd2\xa0\x87\xb5d\xe0[8\x9d>\x03;I\xa1\xd0\xaa\xba4\xbd4\x8b5\x16c\x06;\x89\x9a^^j\xd8h\xa9\xb776\xbfNJ^\x99\xf5n\xc1\xa5\xfe\xfd&\xfc%\x7fKTQ\x04\xc1\xe8\xd7\xe29\x8d\x03\x04 \xaf\xc0\xb2ne\xaa\xa0M\xf6J\xed\xa0\xd1\x14\x8e\x94\x99\xb7\xd6\x04\xf0\x00\x1b\xc4\xd3V\xf9\xc3\x8f\x8e\xfb\xb2\xdbf\xdcP\x1c2\xc6\xd8\xce\xe8l\xcb\xce\xc1\x93\xe82[\x1b\x16B>\xbf\xfd\xb1\xd3\xa2g,'\x9a\x9f\x94\xca\xb373\x102\xf5\xa3\x99\xf6\xb7\x84\xe3\xb63.\xf7\x8f\x14\x8c\xa7\x89\xfd\x07A\x9d\x86\xbeG\xa61\xc5\xe5\x1f\xb3\xaa\xc08\xe2\xe8\xf8\xdaH\x1b#\xdbn\x96\x14\xd1\xc9))u\xa6\x17\x9d8:\xb5\x97c]\xe6\xad\x93\xf5\xc4\xcc\xdeC\xd8\x1e{\x10\xd2o\x94 \xf0\xce\x0bf\xeb\x8e\xe0\xf7n\x0e\xcc\xd9\xda[\x8eZ\xb6,\xfa5\xb5]\xc2\xcf\xc5[[\xbc\x95\xc8\xf4:\xb6\x15QIN\xc6\xb1\xc0pN\xe2\x1d\xc9\x08\xbcV\x91\x07 \xb9\xf1m\xe4}\x10l\xbe\x18\x7f\xd2\xc5vb\xe5 a\xc9\x1b\xe8d\x1d\x8b\x84\x88(z\xf0\xce\x18\xb6ta\x0f\xc9\\\xafrv\xcd\xadEW\x85\xb0wM\x14\xeb\xc5\xe6\x0f\xd5\x00\x9f\xc3\xba\xaf\x17\xa8l\xc1\x026\xb6\x97\xd6OU\xa5\x08\xe3\x1aPr\xef\xe6\xdf\x06\x16\x81\xeaMVP4\x14|n\x1f%\xc78\x87\x02-\x9f\xb8/\x8ck\xbe\x8a\xab\x91\x8e\xf9#\xd8\xdb\xad\xef\xaaT,F\xdeS<\xafT\xa5}\x1e\x1b\x1b\xee\xcb\xb7'\x8c\xc1\xd1\xc0\xec\x94\xc7;\xfb\x8a\xce\x96\xe3\xf1\x0eBE{\xfd\xd0\xb5\xa0\xfdZ\xf8\x98\x0dC\x9c\xd1\xdb\x89\x1e\x079E\xca\x15y;\x94\xb7\xa4\xd9rXL\x16k(f,U\xfb\xc0YXB/|\xfc\xac\xe9w\x9d\x9b}\x89\xf0go\xca\x8f\xeb\xb0\xc5\xd7un{[\xcd\x9cn\xcabmz\xd3\xbc<E\xe4\xdb\xaf\x7f\xac\x9f\n_\xaeoK\xa9\xfa\xe5!\xb2V!\xe0\xc2\xa6\x9b\x05\x0c_\xdeeR\x1f\xa5\xcbv\x90\xe4\x14\xfc\xf6u\xff\xddEF\xaf\xfc\xa5E \xfcr\xf3q\x82W\x00\x9d\xe9\x99?ff\x98\xd8\x8a@\xc2\xee\x03\xebs\x99\xae\x15\x8c\xd9\xe1\xae$\xa5\xc4\xe0\x08_<\xc7\x9b\n\xc6\xc7$l\x87\xe1\x1cJ\x10\x1d\xd0\x8f\xc7\x12\x14\xfa]\x12\xdeK\x16)\x02&2\x82\xef\xdbn\xcb\x1abJ5[\xf7\xfbV\xceoA\xbf,\xf5\x01\x17\xf5!;?\x8do\xa6\x8c\xfbS\xd2\xfe\xcb\xab\x03\x87\xe5\xd2\x92|\xdc*l!\xc5n\xa5\xed}\x80f\x03:\xfdQ\xe1\xb3@A\xd1{\xacG\xee\xd0})q2_m\xd9\x90\xb5\xf3X+E\xda\xf6\x91\xd2>\x9f>\x99\xf8\xaa\x14S\xdf\xf7R\x837\xeeN\nT\xdfJ\xa8\xf1#z_\x9d<\xd3\x0ee3\xeb\xc0\x1b\x1d\x15zQ/\x9f3\"\xcf\xd8\x86?\x8aI\x13d\x9c\x8a\x9e\x0fC\x1b\x92\xa5thL\x89\xbe\x81\xe8L\xf4\x13k\xa7\xcf\xa5\x12(\xd1\xc2\xb2\xa02\xed\xcd.j\x11\xa9%\xa3{\xda\x02\xe9\x00\xc0\x08 \xe1<\xbc\xf5u7oa \xac\x91\"vz\xa1\xb7\x8a`\x97\xb4\x8e\xfe\xac'\xc7\xcdXlW\xe3`WBR\x97\xd2\xca,\xb3O\x98hB7\xa4 \x02X\x90E\xb8k\xe6\xf5@\xd9\x1f\xb6\xe5Wd\xd8s\xbe\x1a\xf3\xd4\xfa7\x19R\xabylb\xc3B\x1b\xa8\x80\x90 \xd7r^\xado\xb1\x16\x07J\x9a\xcd)\xa3\x1f\x82\xf5\xe7\xf6\xc9\x8f\x1f`\x12\xc5\xc92\x14\x94\x08\x85\xda\xc14\\\xaf\xd4,\xe3\x0f\x87\xc2\xc6Ci\x11\x07\x04z}9L2\xdd\x8c\xf2fv\xe0\xecG\xae\xb0\x83\xc8\xbd\xeb\xbcdn\xa2\",x\xe6\xdb\x1c\xe4\xe0\xef3\x9eb\"\x7fk\xf0S\xf1\xaf\xc5\x04\xc4\xe5\x9a4I\xc1pt\xe5\xbc\xefn**U\x87\x1c!\xc2\xea%\xba-\x08ne\x96(\x9b1\x15\x1b\x12\x8e\xb47\xdd$\xa2 \xa7\x85\xc6\xa1WU\xbcT\x96w\xcd\xf5\x8bJ\x99_H\x8d\xedw\xb4\xc7\x15\x96J\xf25\x8a\x00\x80IyU\x172{\xe9\x8e\x0f`\x06\x19at\x15\xb6\xaf\xcc'\x9b\xd5T\xa9\x99\xb2\x80\xf1\xdd\xb6\x1a\xe2\xcf\xe0\x98s\x9d\xea\x1cJ3\x95\xbf1\x83\xa2\xa1\x94\xc1\xf9.\xd3\xd9\x05-f\xaf\x8b\xdeje\xf2\xdf5$\xe6U+PT-t\xffu\xc7\x02\xbc\x80\xdc\xcf?1\x94\xad\x16\xdd\x8c\xf6\x17\x14>\x9cp\xcd\x82a-qO\x1c\xcc\x84\xc4\xd7\x9e\x12y\x12\xd2\x01A.o\xc5\x83\xbb\x9b5k\xccc\xcf\xd0^\xd4W-1\x04\xa7\x07\x12\x9d:h}\xd2Ko!\x04\x06\x9fpb\xc4R\x1a^\xc5\xb7\xa1\x8d@\x1e\xfb0\xe4/\x82\xee\x1aI\xd1'5\x86\\\x89\xf7\x8a\x8fW\xb4Z#\x15 Zs\xad\xeb\x7f\xef~t\x01LA\x0c\x13\x8aF#.4\x1d'\xee\x8e\xbeK\xab\x1b\xfb\xf1\\u\n5\x95I\xaf\x9c?\x9e8\x8cv&\xdd\x13Q\xfc9\xdd$d\x8f\x04\xe8\xb7\x18=@p\xa3}\x86\xf6>\x1c\x15.\x17j\x9e\x8fX\xf3I\xfd\xc4\x01d\xf6uU\xdbd.\x82q/6\xd0\x0f\xc9\xd0Mh\x96\x13\x06\x194\xbf\x07\xbfq2\xa1\x19\xf6\xe4'(\xb5SV\xb4\x99PVo\xef\xe4=\x90\x8f\xb3W\x9a}nK\x93 b\x8b\xf7\xfc\xfa\x97)\xfa\xb3\xac\x11f\x0e\x9du\x92,\xcd\xfb\xcc\x0e0\x93x\xd0\xcfW\xa0y2\xd7\x0e\x850\xd1\xdc\xb4\xe3\x12S\xe7K\xc1AR\x12hb\xc8\xb7\xad	\xe7\xda\xb0\x1aw@\x89j\x0b\xfe\xaai\xf9\x13\x96\x97K\xbe\x81\xbcb\xf8O\xd6Q\xb6\xd5\xab\xe2[\xbb\xf4z\xe7\xef\x0c\xb3\xfb\xf1\xe0\xe7F\xea\x92%B\x0d1\xca7\xfe\x8f\x9c\xcf\xf7q\xdb\xe6g\x19\xd0&\x19.D\x8e\xa9\x85W\xa63\xc4\x14\xa8\xf9\xd8\x8fJ|\x84\x85,4\x9a\x13\x0c\x98\x820\x8a\xb2'\x9a\xf6\x8f\xeb\x06<\x94>M\xe8\x8a\x85\x863\x14QqQ7E\xaf\xf4\x94\x1a\x16c\xddg\x9d\xbc\xe0\x9e]\x19\xe0\xf0\xd0\xb0x2\x83\xcd\xe6\xbb<\xe9q\xe7/\x11\xb6\xbc\x91e\xb4\xf2Z\xba\x91\xab\xb0\xdaA\xa6\xe8\xa7\x99F_3;\xac\xfak\xf7\xe2\xed\xf2|\xdc\x9d\xa0\x12]\x90\xeb\x0f\x9f\x88\xb3\xdc\x94\xc4\xc8P\x8d\xcf\xf1^\x84\x0b\xf1\x02\x9a?}.>E\xaf\xf6\xecq\xdc*\xcey9\x10zeV,|\x817\x15U*\xe1r\xb9\xf2\x99\x93\xeb\x7f.\xc1\x85;\xe6\xacmk}\x11\x08P\xd6\xcae\x8c\xbe[[\x8c\xbf\x07	\xc7\x07u1\xc0\x0f&\x9ac0\xdb\xba\xc3\x92s~\x1f2q\xde\xb7G\x84\x1a3\xe7X\xb5\x81\x0e\xad\xeb\xb0zP	\xc2T\x870\xcb'<\xfd4\xe9\xef\x7f\xfd>\x14\x7f'\xd4\xb3\x89\xfb\x18\xf5\xc1h\xb4\xd7\xae\xf3(\x04\xdb\xe1\x1a\xdd\x0c~\xa0\x96\xe1\xc3\xeb\x16\x00\xa95\xfas6*{3\xa3\xccx)\x04\xc0\xc1\xb4\xf7\xc2\xa8\xeeP\xd3\xc69\x9e\xf2\x17\x06\x16+\xbe\xc9\x1eu\xe0BP\xe4;\x91\x9d\xedx\xf7T)\xd7\xaf]\x16\x83\xeb\x97S\xe70\xf3\xca\xf3w@\xf2\x8fbo\x05 s\xfd8\xc4\x92zu\xb5\xf6~.'\xff\xd2\xf9\xcd\xc9\x1a\xa5\xfa\x0f~\xab`p\xfdt\xd7\xbe\xe5G:T<\xec\xfc\xb7\x03+\x90\x9b\xef\xdazn\xc8h\xde\xd7\xbf\xad\xfc\xee\xf7N\xaf\xffL\xa2\xa5\xff\xe9x!\x01\xea\xf5\xc7\xbb\xf4\xb6\x04\xa2\xefb\xc1\x84\xb9\x85\xeb\xd1s\x83r\xb6\xc5\xa9}y\xeb\xbb\xd4\x9c\x9d\xe81\xef\xfe^q\x06,\x8f\x85{\xed\xb5\xec\xfc\xbe\x1b\xee\xb9j)\x10\x83\xbc*\x0c:)\x80\x9d\x13\x96\xd3\xf3\xa4v\xd0\xbba\x7fl&0\n\x00{\xf9<\x01\xec\xddH1S\x0e\x03\x1a\"|\xe9\x04\x02o*@\xa9\xc3\x9a\xbf\x05\xe8\x1e'n\x02\x05\xc2MA\x82\xf0rK\xe3\xee\xfe\x00\xec{\xbf{K\x9dg\x98A/\x99\xfc,\x97l\xfc\xdd\x83X\x10\xac\x8c\x92\x01\xd2m~\x1f\x13\xe1S\x8c\xfe\xe9\xfb\xf0[9\x97\x02-\xd5\xea\x0c\x08\xad\x877+\"\xf8\xb4\x02\x83\x87)\x80c.@\x13C\x99\xfc\xc2\x9b\x98\x89\x02c\x98X\x0c3\xf4\xf5\x08\x06\x16f\xc8\xed6\xbc\xde3\xd4\"B\xddc&T\xe5\xdeQW2\xd4\x8a\xcb[]\x9b\x9a\x163\xc8\xc8\xea\x8d<\xd3\x87W\xe5\x81\xdex\x81\xdc\xda\xe17r Kmj\x81\x8a\xb0w\xf4\xa1\xfc\xda\xe1\xe1\x9e\xae\x18HoO\xa6\xd0\xdc\xc4\x08_2\xc0_0<\xd0J\x07%\xe0\x8f%\x1e\x19\xe0\x15\xb9\x07(\xc7ZE\xc6\xb7\x87%\xca\xab'\x0bF\xf9\xabE\xa5GFely\xfbi\xabg\x8cD5\xf79k\xf2E\x143\x85)l;\xc5yE;\xb4`\xc978U3a)\x91\xc44k\xc7\xb4z\xc5\xb4g\xfe\xeb\xc72\xb4\xe7>&\xe2?b\x8d5\x81\xcc\xac,\xe9\x9c\xf4|\xa3L\x08\x14\xad\xb4\x14\n\xc9\x03\x10@A\x8d.PR\xd6XV\x91\xc9\xfa	Q\xdb\xa9\x9a]\xdf\xd4\xdb\\U\x0f\xad\xee\x1a\x92\xcd.\x86\x8e\x8f#\xa7Z&\xbe\x0cwe\x15O5O@VV\x1a\x9a\x07\x06\xe7k\x8b\xd6\x97\x1a'\x96W\xd7+\xc6;\xb6\xd32\xf7\xf6\x91\x87\xdf\x8e\xcb\xc7\xe6\xbe'\xf5\\\xfdA\xbb\xa9\xf8Vc[q\x8e\xff&\xf0\x0f\x00\xdd\x1bb\xff\xd8'\x83\x81\xbf\xa8X\xf1t\x8b_m\xf5\xb9\x98\x88[\xfdru\xd8\xd8\x91\x01n\xa5S)\x7f\x1c%\xd6\xe55Yl\xb1\xa4\x1b\xe9\xc7l\xe9,\x9e\xc4Ojl\x8f_\xfct\xe6J\xbf<&\xbd\xb2\xfd\xf9P\xeeP!\x9f\xb2\xc6\x10/\x92\xad\x86\x18K\xfc\xa1\x1f,\xad\xd0b\xc3\xa9,\xe5#\xb4p\xad\\1\xfb\xc9\xa8\xd2\xc2\x84\xab\xa8z\x19\x0bw\x88G\x9b\xd2\xed4'\x87\x8b<<\xcf\x17\x9fp\xb9\xc9k\xbcv=g\xf1\x8c\xaa\xd8\x0d\x8d\x16\xe2\x94\xe1h\xd8\xed\xf7\xebg\x19\x9a\xe7'\xa3\xbeP\xc2s\xbe\xf1;\x96\xc5/N=\xa2\x1b\xfaZW\x1bf\xb2\xcb\xee\xfd\xd5P\x0e\x83\xf1\"\x98b-\xc9\xb4\xed\x17\x8d\xd2\x86f\x19\x06\xec%%e<\x92\xa3\x8dn@\xb2e\xbe\xc0\x91\x81\x8e\xd1\xc7\xe3\x1b}k6B!\x0f\xff\x83\x03h\xa6D1a\xe3W\xdb\x82R\xcd>\xbe\x80\xf9oA\x02\x80\xa0S\xef\xe3\xad_\xe7\xc3\xf5\x82\xfd\xb3\xa9\xf8\x19\xfd\xca\xbd\x0do|\xae)l\xd2r\xc8\xe1\xf5)i=\xdc{}\xf1E\xb3\xe7\x13\xc1KQ\xd0\x86\xdfXQ0\xb5\x1b\xa5]\xfdf\xc8\xd7\x07R|f\x10\xb6\xb6\x8a'\x04\x1b$\x0e\xec\x80\xf0\x1e\x0dB\x9eJ2\xd1\x04\xb6\x82.b0\x98\xa6\xde\x17\x89b\x1ako\x85c\xd2\x0c\xc3\"i\x17\x99\x90.\x10D\xe4N<Qrr\x89\xed_\xbc\xcc\"\x02J\x17\xa6\x12\x00\x16n\xb5\x1by\x81`\x1a\x8f\x07\xfdE%\xbfA\x7f\x12\x85\x0b\x02\xa1\xe3\xed\xe2\x03[\xa4&!\x8a+dja*\xba\xa6E\x02_c\x87d\x92t\xe6\xd0\x01\xf4\xf9 r\x8b8\xc2|/\xec0\xb3V\xad\x18A\xec\x1b\x9d\xa0\xecF\xf3\xe5\x03O\x1c\x05\xc6\xc3\\\xbc\xbb\xa5\x84,\xb4\x8b\xfb$\xfek\xccc,\xc0\x9fE5\xa8 C\x03\x04\xa4\x07;d\x11\xe7\x1eRE\x1f\xb9u\x07\x9d\xe8	y>e\x8aa)+m\x8f\x8d\x895z\xdfW\x16`\x82\xfd\x91\xc8\x0e\xfb\x93Z\xc6\xcf(\x8a\xf2\x88\xf9\xa0\x15j_4.\x9b\xa7\xa5qPT\xa9\x90\xefI\xf1hu\xda\n\xb6mm\x9e\xf0 \xab[\x18\xc5\xa7\xd2\xb8? E\xaa\x80\x85}\xb7iN\xd0\x17)\xdc\xaa	\x85\xecG\x06\xa0Y\xf6\x17c\xa1\x8c\x11\xcd\xd1\xe7\x90\x80N\xa3\xd6\x83\x87\xb1\x02\xef\xc2\x8d#\xfd\xfa\xdf\xcc\x9f>\x08\x0c\xfdd\xfa\xde\x91YZ\xf5\x9d;\xab\xf9\xb46;\xcbI\xc1\xb1E\xe7l\xf1<\xe0\x156\xf0)w\xff\x96\xb4\xafwv\xbf<'\new\x1d\xc3\xd3\xa7\xaf1[\xc5,JY\xd3\x9c\xa3\xc2\xd6\x94Dq\xe2q\x19I*\x80\xa2l\x07\xa4/.\xfd7W\x94\xae\x1a\x0e\x80\xf4(\x88\xbc\x96\xe9\x98P}4\xed\xe98\xf7\xb4\x88+\xb7Az\x84}\xb1\xc0&\x93\xcb4>\xcc\x0842\x13\xc5V\xcff!;\x8f3'&w\xc1\xe7\x81\xd6\xf4Mk\x00[\x9a\xfd\xf6k\xdb\xfd]M\xa4\xa6\xe9\xaf\x03\x08\xe9},\x00~\xe3[u\xe9 \xb5\x19\xf2\x81\x1f\x80M\xb6A\xac\xab\xec\xc7\x05\x044\x1eZ?\xbbN\xe7a\xb5wF\xa1r\xbc\x1dM\x08@\x9b\x81\x18\x86\x08TX\xdb\xa4\xdbn\xc1\x8f\xb3\xeb\x95\xb6\xad\xa6\\O\x17\xad\x0d\xeb\x16\x83\xef\xe1\x8f\xbc=\xeb<\x1b|`R ?\xaa\xbe\xf4\x8d\xc2\xb3%D\xe0wW\x16\xdf\x8dh\x9do\x8d\x96\xa3\xcc\xa0\xebe\x15\x9b\x00\x1d\xdf\x9e_\x9b\x9f1\xa6N\xa7hy\x07\xd6\x8f\xbfT\xac\x0b\xf8\xd5m\x12P_O\xdaV\x9a\xdf\xdc?\x8d\xdf\x98|\x816%\xb0i\xf0\xe8\x87\xb3\xdd\xf1 K\xc0m\xf1\x8b\xfd\xcd\x1f\xeb\xae\xb4\xab\xf8\x90\x9e\x03\x89g>\xe2)S\x07S\xae5G7\xdf\x1c\xafs\n\x96^\xb4\xb5L1\x9b\x91e!7\x1e\x8f\x0e\xbb\xbct\x8eI\x08\x14\x0b\xae\x9f2\xb5;\xb4$H/\x9f<\xbb\xe3\x80\x97m\xb8\xb3\x13\x1f\x07a\x88\x1b\x05E'X':\x82\x12a\xe0\xec\xd6\xcfx\xd1\x89\xa1O\xd7R\xb7Jws\xecD\xf1\x82uN\xd67G\x0e)\x9ft\xa3\x93D]S\xa3\xebavR\x05\\\xbc\x16{\x05\x03\xcbA\xa5\x03\xba+UY\xf4\x8d\x9f\xa6\xb5\x0f\xf8%\x9e\x07#\xc4\xb3\xa1\xderp\xb0>\x0c\xe8\xda}i\x8d\xad-\x84n\xf2\x18\x1cz\x16\"\x8e\x8f\xe3>\xd8\xab\x86\xef\xbb\x18\xbd\xf4\xd7\x06!\xfbD\x16\x06\xa0y\xd1\xc2\xea2\xd1\x85\x15\xce\xe0\x89\xb9\xcf*o\xda\xe5\xbak\x92\x00\x02X	q\xd2|&\x85\x8f}\x1c\xa6x)\xc3\xbe\xa0\xdcQ\x94Sh\xd2M\xfa$\x93\xce\xeb\xa3\x9bKG\xd0H\xe9\xcb\xd7\xea\x19\xa75\x0d\xda\x14t\xe6\xcf\x94\x1f\x06\xd4\xfbGMR\xab\xcd\xc8\xf8\xc8\xe0\x04\xa9Fq\x1c?\x9f\x00\xe2\x0f\x02\xf6{\xc9I\xda\"[\xcc\x1fFs\x8cN\x1a<.\xd1\xcc\x89I\x97\xe5\xbf\x9a%f\x8d\x84\x1e\xa4\xd1\x08)\x8b\xd4\xcee\xa3\xfc\xe8\x053\xee\xa9\x003\x00\x8dl\x04\x89\xdf\x97\x02Ggs\xcd\xfbF\xc4\xc8H3:~\x93t\x91=\x85eq\xe6g\xaa\xe8\xf7\x9d\x85\x90\x0c\x8f\x08\xfa\xfd\x81\x0fL\x07\x13iU\xcf\x81HV(\xb6\x11E\x15\xe8\xe4\xe6P	\xf0Q\xbb\x1d\xbc\xcb3\xcc9b\x9b\xf4\xca\xc0\x02`E \xed\xa3\xcd\x1e\x12x\xc4\no\xf4\xa3\xbc\xe9\xf3\xd3\x9b\xca\x97>\x97\xd7\xa9\xcf\x83\xf7r\xfcJ\xa2\xfeNT$X\x13V\x9e\xb3\x99\x93w\xb7\xf3\xa2]\xe9S\xc5\x12\xc0a\xe33\xafXyi\x19U\xf2H\x1b\x02]n*nm\xd7V\x0ce\xbf\xeej@	\xb9\xd7UEE\xd3^z\xbd\x0c\xd1\xe5\x0f\xdbS?\xfc2]\xe5\xf0\n\xa1e\xbd$\xea\x1f\x81\x85\x15\xcaH\xc8nn#\x93f\x1a\xf5$\x0cP\xcd\x1e9\x1a\xfc\xda\xa9\xd9jrS\xc1\xf9E\x1a,=\x134\x14]h\xec\xbc\x10j@\xf5s\xa6\x9b\xc5\x12\x0e\xc5\x12\xc7\xe4\xf7%\xee\xb3i.\x08\x93)Sy&\xbe\x82\xee\x06GC\xd8\xb3\xd9|\xc0\x11\xa8\xed\xc1\x01n\x9b\xd9\x089X\xf4\xc4\x88\xac\x9b\xda\xffTY\x9eb\xae\xf4\xb7\xde6W\x9e\xc1\xcd\xe1\xe3\xa0\xed\xd6;\xe3\xcc3\xc9\xdadJV\x1bg\xfe\xe6\xd3\xd5\x9ao\x01\xabA\xc3,\xad\xf9(e6SJ\xbd\xf5]\xcd\x93\x96\x96\xb8%4IyMy3\x1a\xf4k\xc9GV\x95\xb2\x8eE\xf9\x0dbB\x9aHG\xbf\x86\x18,\x02\x94\x16\xe1\xea\xd9\xf7y}[Me\xd1\xad\xde\x1b\x13\x07Z\x9fK\xad#\n\xb2\xe4\xf3\xb4\xba\xe0\x1a\xdbM\xe8\xebv\xd2+\xf7\xe5qC\xd6\x9cG\xdd\xcc\xcf`\xfe\x11\xaa'\xcf\xd77d\x89}<'\xb1\x01\x12\\\x80\x00\xbe5\xc8\xc4\xef\x1c-\xe1\xb4\xf9\x93\x1f\xd5\xc5U\x02\x06\xd8\x94Lq_\x8f'}\x16e\xf3\x95\xa141K$\xfb\xc2\xc6\xd0\x87\x11.b\xf6\x924k\x84\xf9{\xd8@\xcf\xea\xa6\xc7T\xe6\x10\xc9S'/P\x83q\xe0\xc9\xfd\x18\x8c\x84\xcb\x8f\xf6\xb9\x19\x1e\x9e\xf7\x88!Cc\xd2\x1b\xa4Id_&\x82|1\x91\xa1\xf0\xf7G\xcaMm\x93\xc2/\x8d\xb9\xdcY\x16|\xae\x0f\xe3\xf2\xb2.\x99\x12\x89\xdb\xbc!\x19\xf2\xd9\xf2@\x0e5l\xffU\x9f\xf7\x94KO\x15\x96R\xe2\x84a(\xf9\xc4G1n,\xe4\xcf\x96\xf1\xc6\xf1\x96\xad\x851v.5]	\xa9\xe5I\x81t\xca\xe2\xc7\x87\x07CB\xb2\xf9\xcc\xd2\x89\xd0\xd2\x14\xa5\xe3\x16>\x9f\xde\xcd\xbc\xb4j\xcb\xb4(\xf4\xc4\xbb\xc5\xfc\xbfn>\x15l\x91\x91\xe4$\xe3\xe7\xfb\xf4 \x15\x83\x8d&\xec\x99\x85\xec\xc8\xe3\x15\x14O\xd8\xae0r\xb1\xcc\x1d\xa8\x019\xd9\xea\x1ff\x0f\xd9\xd54\xd1\x81s\x16\xd1z\x96x\xa6\x15y\x8dMr\xbe\xed\xf8\xb3[G\xcd\xee\x07\x14\xc4\xd9b\xc8SB\xbe\xd6zT,\xe3\x9eX{][\xbd\x9dgi\xcc\xe3\xfa\xfem\x88\xc4}$\x1e\xf7#Kt\x94X\xc7\xeb\xae\xef\xe5-\x9fk&\x0f$\x97\xe8\x9c\xc7\xfe\xeaJ\x9c\x0d;Ba\xc4\x957\x0b\xeb\x8d\xf3\xc1\xeaA\xbc\xbf\xb03j\xe1]r\xdf\x1d\x8a9r\xb7\xf9\xa6\xee\x06h\xf3\x1d\x87\xd7\xf1\xa5\xfe\x0c}\xff\xb2/D\xd2\xf4|a\x97\xba\x17\xbd\xac}l/\xa5\xe4w\x7f\x97\x83\xce\xa0\xefK\xeeL^\xe4\xe62\xbd\x92\xa1CO\xb6\xd6\x1ae\xab\xd8\xb0\xfb\x00\xf3]bUhE\xc1\x00\x07\xc8?>\xfe\xf1\xa6\xf4\x90\x9e]p\xfa\x81\x04\x10\xbfj\x94Z=\xbc\xfd\xdaR\xeb\xd5\x1d\xa1\xe4\xdc_\xeb\xd4v\xaa\x11\xd5}[\x8a\xb1\x92O\xdf\xe2\x8d\"n*o\xfc\xf5g\xcb\xaa\x97\xbf\xc8Z`k\xdc(\x18\x1cI\x0dR@\xa2\xfd\x901\x0dF\xdd\xd2\x10,\xa5UD\x95\xad\x9ao\x15\xdew\x1dL\x1b\x9e5\xa3\xe5\x9dD\x03\xc0\x0e~\xe0\xfd,\xeb_\x16\n\xdd\xe6\x13\xf2\xb8\xc5\xa7-\x10@Q\xcb5\xc5\xbc\xe4a\xa5Io#\xdf\xd1u\x86\xf0\xe5\xcf\xd9_b\x97\x04N\x8e\x0c;\x1c?\x16{i\xce\xfd0\xbeh\xd8M\xfbM\xe1\x18\xeeG\x1e\xfd9W\xa6\xf6\x8b%\xe8\xbd'L\xa9A\xda\xab@\x81W[\xbf\xb6\x9e\xf5\xde\x0fl\xfe\xb6\xaa\xf0;\x0bO\xfc{\x13\xf4\xd1\xff\xfd@\xf7\x18h\xf1ac9>\xf6r\xf5\xf1\xef=\xa3\xb7(\x15\xdc~\xe6\x87\xcf\x9f\x1e\x01\xb0\x93\xa5\xbf\xcb\xa1\xce	\xc3\xbb|\x89\xc0\xdb\x88@\\\x14\xfc\xfe\xc7\xc7'\xf5\xbe\xfc\xdb\xbe\x8fYx\xf0\xe4}\xc3mc\xa1l\xf0;\xccHo\xf3-\xcd\x92\xf0\xb7\x04[p\xdcL{l2h\xb7\x9cXv\xabu\xac\x16\\\xad\xcc\xe1\x02\xfd\xdd\x0b\xcf\xe0]\xa2`~&\xcb\xbf\x96z+\x0c&\x9e\xa92o\xca\x98\xcfC\xdfc\xb6\x87\xe8\x00\xc1\x02^\xa1Z\xb2\xa1\xdd\x1e>\xce\xe5\xa1#\x17*\xd74\xef\xaeB\xa1\xef\xb4\xc24\xbd\xc2\x10\x96\x86\xb4N`\xd8Q\xe8\x88\xea;rqN\xaa\x1f\xea.\x00@\xe1\x04\xd6\xbf\x0f\xa8\x03\xc2\x8d\x11\xaa\xaf\xe4\xc3\xa1\x92 \n\xa7p}A_(8\xdc(\x93\x1e\x07\x12\xe1l\x14\xee*\x1fQ\xa1\x15\x8a\x01\x07y\xfc\x08\xf7\x1bq\x0cD\x80\xa2*\xfcK\xc3@\x18xz\x11\xda\xe1.\xf7\x11Dx\x910K[\x90\xaan8Y\xd4o~\x1fW\xed\xa8X/ \xcb\xea].S\x14\xd1Q\xd8epTiKT\x99\xa0^\x8a\xbc\x8fiE4\x062\xbaF\x9e.\n\x11\x89\x18\xc1\xba\xdd\x8bl\x93\x8d\x91i\x19\xa1o\xbb\x8f\xea\xf0\xc2\xe9br\xec\xb9\x8f\xc9\xe5\x8a\xea\x93w\xf2s\xf6(\x8d\x8c\xb5\x9e\xc1\x19C\x80\xd0\xb5\xbcS\x9dcg\xc88\x1d\x18)Y3\xe36\xee\xe3\xb6\xc8\xe2w\x04\xe3\xf7\xb4\xe3\x0f\xbc\xe2\x8f2\xe3\x15\xff\xffw\xd6\xf6@J\xba\x17\x90\x96\x91c\x90\x95_\x90\xfd\x16\x80d\xe5\x96k\xe4C\xa0h\xd55h\xd0|\xf9\x12hiQcCEn\xd6'hwOUms\xbb4\xd0\xd3\xd2*\xd9\xd5\xdd\xd2\x912\xd6;\xd3S[7,\xd5\xd4;\x9c\x8f\xac\xe9\x1f\x99\x8c^\x9a\x9d\xaf\xeb\xde]O\xdb\x9e]=(\xdd\x8c]\x82\\^\xed\x1f\x9c\xf6-\x7f\x9e\xd9\xdd=\xfb\x1e\xd9t\x05\xc6nns\xa4\xa8\x81\x9f<\x1d\xd2c\x9c\xd8,2\xa9DWP\xd7\xa8\xa7\xa9\nE\x91\xb8\x9c\x1e&\xaa\"\x0c\x081tq\xc3\xd5\xd6E4/?\xbb\xdf\x08:\x93\xf7'\x91*v\xa6\"T\xf7\x15$Jt)\xe6\x05],F\x1e\x0b\x98\xa3\xd2:\xb1\x98,Gb!F\x86\xe8,x\xea\x95J\xb8d(:\x9f\xe1\xa3wV\x97\xa9\x1b)\xf7Q}\xa1u\x1f\xcbd\xe3\x84\xd3X\xa8\xaa\xc4K\xe8\x17N\xb0S\xb5\xea\x9d\xfa\xe2\xe9m5\x9f\xe9\x96?\xa4\xe3?\xc7\xda\xb2_\xeb5>L\xaf9=\xe0\xd8\x08\xfb\x89\xba\xcb\x1b'\xabU\xaf(\xc0\x9c\xe6\xf6#k0\xac\xa9'\xe3\xfd\xfb\no\x95k\xd4\x96I+\xff\xd0\xe5\xbc\xfbKvZC	Y\n\xb9\x15_\xb1\\\x8a\xa6\xbc\xea\xcb\xca\xc9/Fs\xb6\xa27-Yj\x85f8\x87\xc2\xfc)\xc7\xc1\xb8\x9cO\xef&!:\x83\xdb\xfd-\x0d5\xed5\x02\xae\xa8\xe2\x97!\xcf\xf3|\xb3#\x8eG2>>k\xfaC\x18\n9\x9a\xe7\x84_<\xb7\xf2\xd4\xda\xec\x8b\xa0~\xc7<\xef\x0d\xba\xbf>\x1d\xee\xed\x11]r~\x81~D\xa5 r\xcc\xf0\xc2\x7f\x12\xe4\xb4\x19\x80\xcb\x83*0.\xf1\"\xdd\n\xad\x1e\x94\xc3\xe1\xab\x8e\x1b\xc4\x0f61k|\x14\x0f\x81\x85\xb7#=\x98\x97	?\x86\x11=M\xdc\x96\x80\xba\x08B\x01j\xec\xfa>\xe8\xcb\xc5\xf6\xcdH!r\x8cM1\x92Z\xfa\xcdp\x0e\xfe\x88\xcd\xd8W+Vf\x01\x82\x86\xbc\xef\xfd\xc8\x831\x14\x13\x0d\x01\x18m\x92\x9cf\xd6&G\x1d\x93\xfb6\x00\xae]T\xc8x\"Q\x19\x96*3e\xb5\x9f\xb5N\xb5\xa4\x10\xce\xacB\xf8\xf2\xed\xbb\xe8\xa8\xb0\xd4\x9fY\xde\xe5i\x80S\x89\xb1\x84\xb7\xcbC^\xfc\xda\x8d\x0b\xccjQB\xe20\xc0c\xc3z/2\x88\xe6\xd0T\x0fj\xdcq\xc0\x13\xb2\x08\x11\x07\xd2t]M\x03dXl\xe8|\x7f\xa0\xe6\xe9X\xfd]\xaeG\xf9\x1f\xcc\xb2\xe5\xcd\x10\xe8pk\xa2\x174\xe4\xb4\x15\xf9\xc1\xed/\xd0\xc1\\6\x89\xf6	\xc9\x9d\x93BC\xa0\x0f\xb8e\xe5@!\xb2\x81\xb8\xfe\xe7`Y-\x81S\xa1\xffI\xcd\x92Q\xb1\xe7\x8bl\xeaZ0\xfc\xe4\xa8\xa4\xef=6\x1cBR\xfa^\xa5\xed\x13\x85	[\xe30K\xe2\xe37\xcb\xc6\xf6\x93\xefM\x87\x95\x11\xec\x91__D\xed\x96w\xa4\xdbL\xf9\xd6\xc1S\xa3 \xf8\xd2x\xb5\xfc\xc1\xb5\x80jG\x98\xd7{\x89N\x1c\xda*6n\xf4\xf6\xd3\x93\xc2\x99x/\xba\xb4\xfbI\xdf\xb3\xb4;\xfb\x95\x8f^N`\xa7>\x08\x80\xc1\xc6e	\xa1\xc4\xd5\xe7\xc5\x16\xc8\xad\x1d\x18\xe0bmh\n\xb7\xd8\xa9l\xf6P\xc8qD\xa7\xfa\x98\x93\xdfS\x9bH\xc0\xc4\xc6\x90`w\x85\xdcYy\x7f\xc4\\\xd0\xfe\xbb\xb13_\x9a\xedr\xb0~\xb1\xb30\x0b{\xe7\x07\xfbt\xc3\xd5\x8fkC\xfc\xbf`\xf8\xa8\x0c\x06nt\x9f8\xc1\xc2\xcd\xfc\xf0\x98\xc8m\x952\xca\xafiH7P\xd0\x04\x89f\xfb\x9c\xd3w\x8e	c\x12e\x96Q\xf3m.\x9de\xad\xf5\xab\xe3\x87I\xf4X\xcd\xe9\xb1\x82\x11R\xbb8`)\xd6\xf2\xb7\xaba\xfd\x1e\xc4\x0bI\xfbQ\x9c_X\x81\xf6\xfe\xed\xce\x07B\x15%\x9f\x9fB/h\xd6\x97\xfb1\x83fS|\x83\x0d\xd7\x8f.\xed\x1f'\xea\xfba\x85\xbf\xf6\xf2|_t\xf5\xb90\x84\x08\x1eO\x8a\xf9\xf94\xdf5\xd6\xc2|s\x87\x0d\x1b\x80\x17\xc5\x11\xbe\x07\x87\xdf\xe2\x91# \xb7\xda\xd7\xfd\xe9\x04\x05\xedMC\x94\xd5\x86ii\xb4\x95\xb0\xadN\xdf\xbf\xa3\xd3\xd0\x02K\xdfx=9\xa3?\x10|\xdb\x04\xe0\x87E\xc7\xd9\x9f\x89D\x9d\x12\x9f8\x92\xd5\x04F\x1f\xc67c\xcc\xf2>\xa3\x82\xd4lb/\xb9p\xb4\xcf}\xd0\x99\x90\x100\xac\xf7\x17d\x9a\xe2\x17\xed\x0b\x10]!\xd7\xf2\xf2'\x89o\x1d\xb3(\x1f\x01\x1et\xd8\x13]j\xacEn\x8a{$&\xba\x11@\xb8F\xf5\x17Q\xdc\x8b;\x15\x0bP\xde\xc00)_\xd1,\x80\xe2\x03\x96s\xd6\xfe\xd0\xb8\x8a\x15\xed\x85\x10]\xb9n\xa1}\xf4\x02\x9dN\xaa\xda\xf3\xe7.?q\x1e\xe9\x83d=\x93~\xa9\xb4\x8e\xaa=\xfc\xc4\xe0\xc0i\xb1\x01\x9b%\xa1F\xbc&\x16\xba\x0c\x1e\x8d\xdcy\xb2\x1e6\xc0\xafY\xfdF;u5\x8dE\xb0\x8f\xc0\xe1\xf1\x9d\xf9`\xbbL\xfc\x08\xa2\xcb+\x17?\x0e 3$ZEU\xf3\xef\xe0\x11i\x9b%\x0c\xc96w\xfd\xf4\x9e\x0d\x1e\x9f}\n\xdc\xcb\x0f1J\xaa\xc5\xd9\xbd*eX\xcf\x94\xbf\x9b8q\x88KE\xd2\xb2\xb7\x0b\xe2\x1a\x19\xc5\x00\x89\xe2`\x02\xae\xb7\ncxq\xfd\x03f\x0c\x02\xa3o1$\xb6\xd2\xf3\xf7\x9e\xf9V\x93\x02j\xba	\x96\n#\xe8P}w\x05B(\x0f\xe8yu\xe1w\xed\xfb\xd7\xda\x14J\x89!\xa5eM\xeaZ\xaf\xf5_pi\x15\xae\x19\x00\n\xaa\xc1\x18*\xe6n &\x8bv0\\I\xd3\xe1\x89>@\x99\xc9[\x03R\xa6\x9c#\x90\x9d\xfd\xb4~%\x92\x95o 6\x13_'\x02\x06\xf6\xc0\x00\xae\x99\x91\xdd.\x9d8\xd8j\xb1\xd7]R)\xbd\xc9\xcd\xf4\x0b\x8aw\x1aml>m\xe3+\xad\xce_\xacZ\xc5\x9ad\x0b\xa8\xc1\xf3\xa7\xf8\xd9\xaaa+\x18\x197\xdf\x12x^\n\x0ff\x8e^p\xe7\xc3\x0e\xc5\xd9\xc9K\xc5[?\xc4=\x9e\xe7V\xd0\xec\x0b\xc9\x9e\x06U\x85\x1d\x06g32\x08pa\xdd\x13ET\xed\x19\xc9f\xc8\xc8\xc7\xab\xcc\x96PO\x0e\xbe\xb4\xf5i^\xbf\x9c\xb0\xc6\xfd5\xc8R!\xac\x91>4\xe9\xb6\xe9t,\x1b\xe7\xa3^\xea\xc0C\x08\xa1[EN`\x01\x08\xd9\x05B\x0d\x99\x812\xa4'a\x0dIZ\x15U\xda\xc1{\x01\x01\xcdO\xc9-\xd5v!\xe4\x9a%\xa1\x82\xad?\x07j\xb2COW\xf7|F\x9e:m\x11R\xc9\xdb\x99hy\xc3\x87j]\xec\xbd\x0d\x96\x0c\x9b\x008\x06\xeet]\x87\xd3\xf1W0+j\xb2'\x05\xadP\xa3\xdaX\x82r\xe5)]aC\xbc\x7f\xf56\x0de\x8f\x90\xe8VK\xfa~\x81\xc6\x9fB\x9d9\xf2\xc6&\x83<V3iX\xf0`\xf8]3\xa2\x85\xd5l\x99A']\x93\xa4\xb5\xae\xfb\x9b\xd9\xe6\xe5\xfbi~\xd2\xa2\x8f\x93\xe8\x8e\x15\xe3z\xefb\xef\xda\xd4\xf7\xedMd\xb6\x88\x9aN\xd2|\xb3\xc49\\\xd2l9\xde\xe6\xb3'f\xfa=\xb2j\xf5f\x8b\x9f\xb8d\xa0\xd3%\xd6\x9a\xbc\xadB\x9d\x80a=\xc42\xcc\x86:]\xfa\xb4~\x92\x9f\xeb\xd9\xd7\xed\xe6\x91\xd5xd\xf7H\xcc\xa7A\xef\x9dq\x0c\xa4\x841\x80\x05HP\xe8\xf6i\xd4\xc6\xe2\x12!\xd1\x14\xa7\x03\xcf\xf6\xfa\x8e\xd8i/\xa0\xf9\x1d>\x0d\xc0\xf1\x129\xfa\xf8\xcc.\xfe>\xf7\xc3\xc1\n\x81\xa3s\x94s1m\xbd\xdev\x0cS\xe4\xfdB\xfe}^D_5\x0dd\xf4	\x88g\xa7\xfce\x1b\xa1D\xe6\x15\xc8\xacy\xf9\x03\xa1\x9d\xae\xd4\xae\xddO\x1dy\x15j\x8d\x0bf^\xf52\x12L\x8fV\x15|\xbe\x8c\xed\xf50\xfe1\x00\xdb\xe9\x03\xa5\xd9\xd5\xd3\xfe\xcf\x85x\xdf\x18\xecWe\x9fFc\x00\x1a\xf1\x8d\xa9\xbbl\xe6B\xc3K\x84\xe4\xf7\x8c\xf1\xf6\xc5\xbb\xa6B\xf0OO\x9d\xe0\x19\xbc\xdc\x07[\x0c\x85\x13\x04\xb4WYK\x8a(\xe5\xceeU\xe7\xf0\xc6\xdc7\xe2`\xa1\x10qB`\xc45_M*\xe3\x8b\xb3\x90A\xe0S\x87\xc2GV8\x18(\xbc\xfb\x8a\xa1m\x9eh\x8e\x13EgY?\x1b\xee\xd7L\x94+r\xcc4\x80\x1a[\x1cib\xbe];67\x07\x7f5UA\"R\xc2N>T\xe9~\xa6\xf1@\xf71\xc7\xb2eh\x1a\xc7\xda\xbc\x92.\xdd\xf6=\xd4?\xf5Y\xf2\x079\x0e\x80\x9fXI\x10v\xa1\xc2\x9fr?\xa6\xae\x1b\xf5wv\x98\xedGob-\xa7\x1d\xe3f^\xad?~\x06g\xb0\xe2\x1d\xf9`P\xd8l\xf1\xdf\x15%\xe5\x1d\xe1\x93\x1et\x84\xf0	h'\x94[\xd9\x0e>sdyp\x06\xaf\xfdq\x08\xd4\xbdZ\xf2v\xd7.\xea\xf3\xffv\xf9ty\xd3\xce\xc9\x1dYvl\xd1`!\x89\x08\xa7\x19_\xfe\xfdz\xc16\xc7\xa5\xd5\n*\xde\xcd\x18\x7f\x12=\xd0ZG\x16\xa1\xfc\xca\x9a\xd9\x962p-.\xfcgL\xfa\xdb\x9e\x0b\x0d\x1b\xe1\x03\x96\xb6\xb6\x99\xb3I\x17\x9e\x98\xb6\xcdG'FK\xeeSS\xac\xe6e\xf4\xb4\x15K}\x0f\x15\xdc\x02\x1c\xd2#\xa9\xe9\xce\x19\x9d\xef\x8f./1\xe0\xc7\x82:\x97s\x19\xe9c\x159\xc0\x89P\x82\"Y\x8d\xcaB\xa6\x9e\xd4\xa9\x13cg\xcf\x83\x81H[ (W\xb1\xe7l)/Y\xfc]\xee\x9fs\xb12\xd4\xc7\xdc\x01\x8f\x85d\x0c\"\xe7w\xbf\xc7\xe2\xfe^\xbd<\x07\x11\x89\xddZo\xb4uz\x02\xa9\x00@T\x88\xc4\x14\xc1\x7f@6	8\x04`\xc5\xccaO\x8f\x91\xf2m\xdd \xdeu\x9fh\xd5y\xc1\xf4\xfcL<\xda$Y^?\xcekU|\xd5\xc9Z\xfa\xfd\xcd\xfa\xf1\x03	'\x88\xa5\xa8\x00>G\x11\x13\xd4\x9a\x02\x02\xaa\xde\xf5\\e\xf4\xfa\xa1\xf8\xb7v4\xb6\xbb\xd1?c\x88\xb5\x19\x8b\xfd\xc8\xc9\x088w\xe6\xc0V\xf9\x15v\xf2F\x0c\x80\xbe\xf7_AQ\xc6>\x1c\xbd\x0b\xbc\xa6\x04\\\xaf\x07\xa2\xeffrOWk\x13\xfe\xac+n\xfe\xf8\xb9\xe7nS\xdd\x07G\x8f\xa5n\xf9\x1e\xe1w\"\x00G\x8f\xf4\xe1\xd22\xeb\xcex\xeb5\xfc\xa6C+pE\xcew\xd3\xcd\xc5W\x16\xf7\x117\x114D\x02\x9fL\x0f\xa2\x90W<\xdf	\xe8Y|3\xce`\xf4$\xd2?'\xc3\xa5\x18p\xdc8|\xfe\xc7\xd3\xe6\x05\xa66\xe5}\xf0\xa4\xa6\xa7\x7f\xa2\xc9\x8d\x1c(?\xd1\x9b\xb6\xde[=\xd1\xfaI\x04E\xe8<i\xdfB09\xca\x9bY+T\xf6\xdek^K\xb8c\x89\x92V\xc0\x16WNi\x99\x01\x1b\x17\x80\xdb\x98\x87\xd6\x94\xe1\x14\xc0L\xb2\x04\x8c\xb0\x05\x94\xbe\xbb(l6\x07cl\x05q\x80\x01\xfa#7\xb6;;\xd7\x1d\xd7\x0f\xae\n}_\x03\xcb\x04\xd4S\xf9\xc0\x8c\xaa\x1ek\x18P\xec\x0c\x10\xcea\x98\xd3\xbc?\x841\xa2\xaf\x14\xc4\xa8\x1a\x91t\xe8\xb1]\x11\xf1\xc1\xdd\x05\x8e\xd0$*\x04\x05kG\xd8\x082T\xf0\x05h\xdcF\xc409\xc6\x1fE\x86d\x86\x88\xcb\xf9\xed\xc9\x85gd\xe2f\x1dEvF8\x97{\x00\xa0-\xedB\xb2h(o4E\xa1\xaf\x17\xa6~\xa5`x\xb5\xbcQ\x87\\t\x12\x93c,_\x8c\xb3v\x8cI\xefe\xb4\"\xeae\x17J\xbbj$\x1aH\x8c\xd2c\x8a\xfd+\x17\xd6#oRk\x15RNF\xfc\x98a\xf3\xc5+j\xf8(\xba\xce**\x9f\xc1\xddz!\xd6\x1c\xee\x8d:\x8a\x14\x1f\x8e\xeb>\x8a\x8b\xf0\x0c)\xb1\xf2Dj\xc5\xb5\xa0B9\x8b\xe3\x0e\xc8\x12\xae\x04\x13n\xb4\x13\xee\xbc\x12\xee3\x13~\xb6'\xfc&\x83\xfe\x87\xafG\xab\x03\xc5%\xa5\x85\xe5j\xa5\x10hUu\x15\xa4\xa4\xa2A\xae\xe4\x13Zk[;Zu]qcCqKGG\xcd\xe0\xe7\x9e|$\xb4\xbf\x1d\n\x99\x9a\x9e\xae\xa9\x1b\xce\x01\xfa\xc6'gVfJ\x01\xe9\xfa\xaf\x89\xc0\xd8x\xe7\xea\xe1g\\\xc2S\xdcR\xdc\xb3\xed\xf8\xe2\xfd\xce\xe5\xb9\xd5\xe2\x93\xd3_\x84\xe7\x7f/.	\xa2h\x86\x95\xd2\xaaLV\xae.\xf5\xee\xcf\xf3\xda\xe0\x11C\xf8\xae\xe6N\xda\xad\x96\xd8\xa4\xdfm\x97j(\xa5\x85\x9e\x15\xc9\x7f>\x8a5\x92\x88\x05n\xf8\xcc0pu\xb9\x9d\xa8\x97o$\xcb&\xf7F\x93\x99\x7f\xf1\xb9	:h\x8f>\xea\xd5Z\x0c)\xf1r\xec\xa5\x98\xdd\xc8\xd7*t\xa4\x94(\xc7LdcQ\xe9\xca\x16\xcf2\x88\xa6\xc86\xd1\xbb[j\xfa\x88g9w+\xf0\xc5\xb6\x94\x99Y\x82\x00\xdfY3\xad\xc0k\xe4\x18\xbd`\xcb\xb7\x8c&\x89Sl\xf2\xdbT\xdb*s\xb2\xbc\x9d+X[}P\xb3G\x8b6\xaf|C	\xdf\xb0\xf3\xa1\xf0nN\x8c\x93\xf1@$\xe1G\x9d\x89\xfbc&L\x1c\xc9\x18C\xc0\x98H;\xf6\x9d(\xa2V\xc4\xe8t\xdf:\xbb\xcb\xa9\xb6\xcd\x06y\x08\x01\xbb\x9cn\xb0\xc3\x9b\x8f\xf5\xc9p0?(\xaf\xf1l[\x1c\xd4\xd2O>\x7fM\xd4\xdc\x00\x88_\xe7\x8b\xad\xff\xd0\xd5[{\xff\x8ca#\xdb\xa8\xb5\xbf>\xc7r[\x84\x1cp89\xaa\xe4\xb4\xd2\xd9(7_\xac\xe1\xc4y\xcd\x16\x80cy}|]\xf2\xb1\x97\xccq{\xb7\x7f\x14\xe3\xb9\xeb-\x15_\x96\x03\xa5]\xe1fpg\xcd\x8fh'\x7fP\xf9\x84\xcd\xb5\x90+N\xd8\x0dqd\x18S\x07=\xe2\x11\x16\x02\xc3\xe6\xc2\xd3\xaa3\x90\x7f\xc9\xbb8!W\x18\xae\x18;g\x1c\xfcr\xe8^#T\x1c\"8\x01TQy\x18H\xb06F\xa2G\x80\xbd\xee\x8dcqkI\x89E\xc7\x8cI\x17\"\xc5\xd9\xc3p\x95\xa4\xdd\xefi\x92^\xa6\x1f\xcc\x7f\xe0_D\xd1q:\x08\x8db\x01\xd8\xa1:\x12\xcc\x16\x00\x17U\xac:I\x87\xa9\xfa*&!.k\xf3W\xb2\xaf\x19\xc6,\xaa\x12y\xa6\x92\xc7aY_\x8b\xb2\xca}\xf0\xc4a\xf04;\x1dm<n@\x1b\xfe\xf5\xbdk\x00\x968\xc43:\x8a1\xeb\xee\xd2c#50Z0\xafP\x96\xc4f^\xfe\xbdN\xe1\x91\xb8D%A~	P\x08X\xe7U\x1fTf\x10\xdd\xef\xcb\xea\x16H\x11`\x99\x85\xb4\xe3\xeb\xaa\xf02\xe8\xb8\xf6AJ\xb4\xf1\xfa\x10\xb4\xde\xf3\x9b\xcc\xa5\xd2c'\xa5>\xc2\xc6\x1bV~\xc5\x87\x15\x0e\xc1W\x9a\xf6\x8e\xc5\xfa9\x94\x15\xb6\x9f\x8e\xa8\x98{\xb1_\xb3\xb1\xbf?sgi,a\xeaz\xac\xceKm\x15\\\xf1\xc1\xf5\x91?s\x9bM.fok1NY0\xde\x91\xdd:\x87\xfc\xf5H\xd96\x14\xf5H\xfe\xd69\xd1\xf3\xf3	sF\xb8 k\xf6\xc5A:\"\xa6\xfd\xe7\x1d)G\x1d\x13mZ\xef\xf3GJ	\x81C\x12\xdc\xb1>\xcc\xa6U;\xfc\xd9!&\x83DlYFV`wh\x85\xd2\xea\xa9\x07\x94\xd14\xea\xef/\xdb\xe1\xa1;}#\x81\x93\xd6\x95\xef\x86\x816\xac\x97u\xa7Q\xacce\xa5aP\x96\xe2.d\xac8z\xefw\x91[)\xed_\xf2\xf5L\x12\x8cv\xdf\n\x9a\xbe\xb7\xbe\xb0z\x8d\xf1\xad\xb1\xfaU\x06?~\x89\xa2\x0c\x1c\x00\xf7BUoWi\x1es\x1dFM\xf9\xd9XCx\x14\x9f\x1bX\xab\x7f\xa4\xa2rP\x89(\xbf\x93&W\xbc\xe5_C\x1f\xf9\xc3\xba\xaeK\xed\xee\x997\xcd_\xfd#\xc0?\x86\x8cj\xc2\x01M$\xda\xaa,\xc8C\xb0\xb8\x1c\\/\xb8\x0b\xd1\xc7\x83\xafb~\xa5zXL`Q\xed\xb0\x82\xa5[<V2i\xa0\xb1y\xed\x0fA\xa3\xb5X\xff\xe4\x89\xb9\x03h\x88\x9e\xf6\xd3:\xce\xdf\xdeV-\xab=\x94\x13\x1doT\xaa\x8f\xb43\xd2'&\xcbq\x12\x80K\xd2\xdcx\xbf+Y\x11\xbd<\x9f\xe5+\xa8\xff\xeez\xd7\xfa\xa4\x0f\x0ea,\xc1\xe9J\xdb\x81\xbf?\xb6^\x92\xb7\xe3Q\xdf\xda\x0f\x1f'\x0c\xd8\x8f\xcb\xbb\xceh5\xabK\xec\xb3\xeeI\xf2k\xdb\xa7\xc0\xef\x1f\xddt\xb95\x12\xac]\xeb\xc3\x13KB\xc3T\x8e\x90nU2\xb3FK$\xba\x0d\x14\x8835BS\xe3\x17\x1cHK\x10^T$\x7f\xc4\x83\xf7|\x97x\x1a\xe2\x8bE\x0dp\xe1\x01\xb8JJ`,y\x00\xe35J\x18\xf2\xf3<\x02]P'F\xf6eP<:\xbeh\x8foa\xa4\xb6\x1c\x80\xd5~\x11\xf6\xf49\xe4\x89\xfd=7\x98\xae0\xf2\"'\x1b\xb1q\xd7\x80\x82_D\x18\xe1\x8a\xe3\x85EJ\xbc\x97\x17\x07\xa3\xcb\x97P,x\xbd\x99\xa3K\xb2~\x8e\x0b'|\xf7\x83{\x10x\x84\xd3\xe3\x94\xee\x80\xeb\x10\x03\xb9Q\n\xda%W\xc6d\x18YCO\xf2\x9d\xe3\"\xa5\xcd\xc6|v\x87\x04zp\x03@L\x12X\xa1?\x10\x1e\x1dh\x00\xf9\x08\xfc\xef`&\x1c\x96>K\xbb\xa3\xb3\x05\x93{;\xc6\xc5\xf0>I\x89W\xbf\xf0\xf91\xe5\xb6\xe8\x94\xaf$-\x04\x90)B\x00{3)\xa3\x84\xe6\xcc\x9e\x1d\xe3\xd2SO\xdd\xaan\xa8&\x17S\xbe<\x0d\xc626\x9d3\x11\xa8F\x8d\xe2\x87\xf7g\xc1g\xe89+t\xc6$\xd3g|\xfan\xa8\x8e<F\xa4\x92\xd5\xa6\xb8\x05?\xd3\xbc\xaa\x00\xfa\x7f\xb2Kf\x7fU\xaax\xf4\x13\x8a\x86Y4\xbdb`; 9\xc6\x84?\xbfK\xb2\xf5\xa2\xd4\xd5\x80[\xa9H\xfb\xa9\xe5\x19)\xed2\xbd\xf3\x1e\xeb\xa5O\xf2)\x86S\x0f\xb3\xd5\xb7'Pm\x15\xe1M\x16	d\xec\x7fr\x16\x1eO%\x99D\x13\x06)\xcc\xc4\xb3$\x13}g{BY\xb6\xd8\x90)qS\xfa\xac\xe5P\xd44B\xc9\x7f\xe5\x10\xf4\xf8\x0cs\xfb\xd9/\x9d\xc8@\xf0\xab\xc2\x17\x93\xc7\x14\x02?\xd4\xa6\xb2\x88\xca\xf05*U\x19)\x14\xb3\x8b\xc1\xe5\\i\x95dq,\x91q\x1bD\x15\xc2\xecL\x98\xc4\xcc\xb3j\x00\x1f\xe2N\xa4R\xa1\x81\xbf\xc3]\xc3MJ\xfeI\xd5\xfba\x14f\x8e\xd8\xff\xc5\xbb}xS\x1d8\xfe\x1f\x7f\xdf\xcbu]\xd9\xf3\x1aE\xa8.\x92\xbd\xa3K\x19\x99\x11\xd7\xde\x9b\x84\xec}\x87q	![\xd9{\x8f\xb2B\xd7\x9e\x15\xe1\xba\x11\xd9.2\x12%\x8a\xdf\xf9\x9e\xef9\x9fs>\x7f\xc0\xef\xfb_<\xcf\xe3\xbc^'\xe5\x86\x8bBP\xe2<\x86\xc7\xe6\xa4NH\x0fM\xad8	b\xa2n\xaca\x0d\x10B\x01\x8c\xc4F\x877\xb5\xda\xca5vs\x0f\x1f\x01\x8d\x01u<n\"V\x86\xc2s\x9d\xc9}\xf5\xf7\xfa<\x0d\xd5\x89I=7e\x12uOp\xe6P%\xd2\x03\xf6\xd4z\xbc\xfa\x13k\x96C\"O \xba\x0e\x000\x1e\xee\xda\xc6\x0dt\xa9\xac\xb0\xc6'\xba\x14\xb9\xe6*\x00\x90\xf4\xea\x83\x97\x01\xaf\xd2\xdct\xb3	\xa5\xda\xc9\x9e\xbbR\xca<\x03\xfc7\x05_a\xadS\xf7&\x1c\x9b\xfa\x0bPH\xdf3\x93\xe2\x82\x1aR\xca\xb6\xf1\x9882\xa9\x13\xac\xf7\x93\xfb\xda\xe2\xd8\xea\xdc\xf6\xaa\xe7\xd7\xb8\xe4:\x1a-.\xeb_\xf3\x0d.\xd1\x14\x02\x90\xe2\xe0z\x94\xa3\xa7\xf9\x84\x11\x07\xfd\xe3\xc1\xfd\xc7Ag\xa3\xfb\xa1\xa6\xac\xddtu>\xea \xf0:;\xbc\xc3\xe9\xab\x88\x8a\xf9\x06\x00\x1d\x91\x03\xd1\xa3\xc5\x80z\xae\xc5\x1e\xfd\xdf=O\xb3w\x02N,?H\xb3\xfb\xda/B\x00\x98\xd6\xa1u\xab\"j`\xd3Z4\xf6\xdd\xfc2T\x0e\xdeSV\xd6`\xe6\xfe\xdc>\xa76\xb5\xbfk\xbd\x81\xb6[\x07<\x9b\xd6\xce\x0d\xb9\xd9\xeb\xfb2\x0f\xa1\xbe\x18S\xc6\xe6\xd6\xa4\xcc}\xf7f;*x\xe8\xef\xf1-.\xef\xc45L\xa8\x19\x0eh\xac\xc1\x9b\x8c;_/\x98k\x7f#\x88J\x82\xb1W	\xca\x9dN\xd0\xbb5\xbftN4>\xdf\xb7\xc7\xf1\xe99e\x14q=`	\x97o.!KPw\x85\xdb0?\x17\xe6\xa5g\xea\xc7Z\n\xdb\xd2\xb4\x84\xb4\xfe\xe5i\xd1\x92\x85c5\x94\xce\xa6\x92zDU\xb7\xba\x1e\xb3d\x17\xa6h#\x9a\x11\xc7\xdf\xd7\xc4\xa56x\x13\xae\x81_]\xaa\xfbn\xaaI\xfd\x9a\x9c\x8e\xe8\x07_\xff\xb0c\xf44\xb3\x91[\xc3\xb3L\xec\xc3\xd0H\x82\xce\x04\xa7\xb1\xf1c\xb5\xab*\xd2\xe7\xf3s\xe5\xc951\xbdf\x0b\xcaUR\x9f\xf7^I1\xb2\xb9\xbb\xdb~\xc4\n(p\xcf\xc9\xe52\x93%\xff\x9d\xcd\xbb\xc9\x9e\xbc\xb4\xd7^\x93M\xec\x14(\xceD\xbaN+j())\x85sOmUp\xf7y\xa6\xdb\x9f/\x12\xd8e\x8d\x1e_\xd5\xebM\xe3\xceJC\xad\xdf\x96\x063\xa6\x1b\xbe[\\D\xb7\x11Z\xe8\xec\x11\xcf\x0f\x19\x1a\xf2ov\x1c$\xf6bc\x0dx\xff\x92\xdb\xdewKR\x01\xd2\xcb\xab\xf0O\xedCR*\x87R\x02oA\x927j\xbc\x06_[\xbff\x97\x10\xe4Rqb\x19}\xa6\x99$\xee\xba8+;>\xe4\xaa\xff3\xd7\x9b\xa7\x9c\x83!\xa4iny\x86~\xebx\xfd\xdb\xe1!g\\\xf2\x91!\xf2\xeeb\xce\xd2\xdbB\xaf\x8f\xc7\xe5s\xafZ\xbd\x93e\xaeG\xd9p<dxM.\x98)\xbdg\xf5\xf1\x922['\xdc@\xc6\xf4O\xf7\x81\xf2t\xe0\xc2\x8bu\x85}\xf2\xe8\xf4\xb3\x85\x9a\x02_\xd6\x9c\x99\xb3{\xfc=\xfc\x0d?-\xfa\x1d?\x85i\xceu\xfc\xac^n\xcd2\xb6\xf1\xf87\xbf\x1fh\xe8\x04P\xe0\xb3\xce\xbf\x9e\x1b\x8ag\x7f\xb3>\x08\x89:o\xfb@_#uf\xb5\xa5&\xed\xb8B\xf7\"x Ly\xd3:\x8e\x16~\x18\xa9=j\x109\x13\xedv>\x03\x19\x0b\xda]}\xc6.\xc08\xb8\xe9\x17|\xca+\x9c\x7f+m\xdciK\xf9\xde\xd9(\xf7\x13r\xf7\x93\xad\x0c)e,\xc9V\xb9\xe7ME\xd1\x9ejHz\xfb\xc1R\xec\xb2'\xd3!L\xe8\xaf\xc8\xfbW`VD\xe4\xc2\xae\xfb\xa7v\x1c\x13E.m\xcc\xcf \x83\x10[\xc6k{\"\x1c\x10\x8d\xdd\xe4\x7f\xdf\x87Uz|\x0f\"W\xcf\xefx,\xdeR\xf9;u95;]\xf0\xedA\\\xcf?\x92ix\x93\xab\x8eZ\x06\x90+\xad\x9f\xcd\x1a\xc5\x9c\x11\xd5\xd2\x185\xbb\x15e\x0dE\x03\xa7\x91\x97\x93\xce\x06\xa1\x10\x11Nd\x18\xfb\x08\x1a\xe1\x17\x0e\xa4D\xdcB\x07\xe9\x9e`\x98\xe1\xe8&S\x0c\x0c\x05\xf9\x9a\x85\xb92\x82\x19\x13\xa0a-\x04q\xf9\x80\xaf\x9f\x06\xf2\x1bbY\xedL\"\x04\xdd\xb8H\xd8;p\x15	\x04N:\x1b\x8bx\x8ce\xa12Lo\xc3\x96\x9aD\x0e#\xb1\x92\x08\x1c\x98\xac(H\x8a\xd4\x0c\x066*\xa2'\x07\xa3\xc9\xfc8\xd5\x91\x98\x03\xa8\x08\x10\x1cmaf\xc0y\xfa\xc8B^\xd2\x1a\x1fa#\xaf\xf68\x1b\xe7:\x12\x01GG\xbb\xba\x89\x1bT\x82\x98\xc9\xba^\xf2q\xf0t\xc0\x17n\xc8Qy\x1dx\x14\x17\xa8\x15\xcd\x84\x8f\x08\xa5\x8ec-\x8f\xcbb\xc4\x1b\x04\xdbF\x8d\xe0\"\xf0F\xb6\x1c\n\xbc$|b#\xf6\xd6[\xfa\x04<,\x11\x81\xcbk\xbf\xa5\\\x19_\xd0\x8e\xb7\x96O\xb0\"D\xc0\xd0a\xf7\xf0\xaa\x99\xc1\xfa>M	\xc5\xbf\xf0z\xeaQ\x8d\xff\xbd\xf5\xa0\xfd\xcf\x89\xd0\xe4\xff\xbfK\xffW \xa3\x80\xe2\x92\x92\xc2r\xe3\xe2\xff	d*HYE\x93vU\xdd\xdb\xda\x06\xa0\xb9\xf3\xfe\x1b\x8a\x9e\xf7=\xf5\xd5m\x1d\xef\x9a\x80j\xc2\xd8\xfb\xbe\xfe\x86\xa1r\x80j|\xa6\x172W\xfdi\xf250;\xf6\x81\xd4\xde\xb8\x90\xb3\xb8D\xb1\xbc\xd2@\xcb\xb2G[\xba\xb6\xfebsv{\x07\xf8\xbewF\xfb\xf7\xdf\xe0QR\xa7^Iy=\x9fJ\x04\xf5\xb9\xd0_\xa8x*\xc1\x03r\xd0\xb4\xd6\xc6\x90?\x18\x8b\xefdC\xc3\x99\x16Tc\x0eRbjn\xd0\xaf\xf1\xb9s\xc3l\xde\xb1\xed\xaf\xf5a&P\x92~7\xc6\xd6y\xd3\xc51uN\xdb\xbe\xab:\x97\xde5\xe8\xba>\xe7\x9f\xc9\x83\x86\xe3i\xe6\x9e\xdb\xd3\xb6\x1fC\xd5p\x12\xb7w\\X\xa3!f-w\xd0h\x7f\xe4X\x99\xb2\xeaQI\xd0S\xce\x89*\xe3i|\xa7pa\xddB\x1b\xb85P\x9d\x83\x89w\xe4\xe9\xb5\xb1\x1d\xf5+\xc2\xd7x\x0c\xaf,\xd3\xb0\xdf\xe6(\xeb\x0d\xb8\xa0Z\x9f}g\xca\x0e@R</\xe8\xee\xb6K\xae\x18\xffF}\x19\xdc\xb5\xff\xd1\xd0E\xca'\xae\x8doK\xac\xd3\xb0\xfd-\x06\xd9u\x9c,C\xf8\x1b\xae;\xde\xd0\xf6\xddZ\xe8a\xf7f\xbd\xa9(\xb4\xadW\xfc\xe8>*\xc5\xe2\x88\x93\xc8-\xdbn\xb3/\xdb\xfa\xc69\xdf+\xa8\x9c\xfb\xe7\xae\x9bu\xafA\x01\xea\x1f\xa4\xe3\xd9\xe6\xb2\xba\n\x94\xfd_\x8bXYb\x94\xbb\xdf\xab\x08y\xdf}\x8b.\\\x81\x18\x1a\xe5\xed\xdc9\x1du\xbe\xd8\xf6\xefp\xf5\xaf\xde\xcab\xd0\xb1D\x1c\xad\xe2\x99\x97\xf4k\xf3\xb9\xf5\xd6l\x10Hc\xed[\xe0P\xccg\xd43Jv2\xd7\x93^\xf1\xcd\xcb\x04\x1c\x83\xe4\xfdL\x19\xa6|Im\xc8~\xb7\xa9=k-\xac\xd0\xea\x19\x17v\xd8$\xce\xb9\xa8\"\x1f\xc7\xa8Rfn\xc8bd\xc6\xcf$\xda\x03qLlP\x05T\xad\x8e)7\x92\x02\x88\xd4\x01\xcb\xba\x8c\xe5\x01\x0e	\xc2\x99p'\xab\xa7t\x0f\xd73\xc0<_n\x98\xd7\x1f\x0f\x95\x9a\x8aO9]\xf5r\xff\xf1\xd91\xe3!e|\x86\x9d\x05;b\xcc2\xdd\xf74IY7\x12N\x1b\xfdv\xaf\xc31\x17Y\x0f\xb3c\x02\xdc66\xec|-\x02\xdd\"\x00\x9e\xb3\xf2\xd7[\xc8\x11'\xc97\xe6|c\xd2\xf6\x170D\x116\xd3\xc8\x0d\xf5\x1c\x15[dYD\xdcb\x7f\xe2\x97\xc65\x97\x98\xaaRuM\x82\xddL8\xc9\xe5\xfe\xbf\x11\xc7\x8a\xc2gB%\xcf)\xa9F\xd9\xec\xaa\x1dd\x8a\xcd\xd7 ~\xbd\x90v3FL\xed[\x8f\xce\xed\xdb\x172\x1e\x82\x8d\x90\xa7\x01E\xcf\x1ae8<KJ]d\xbeG*<\xa4 4\x8d\xdd	\x13*\xa8&\x9d]~O/A\x14\x8a\xf8=\x99\n\xb9\x9d\xc6\xf4\x80\xe0ei\n\xab\x11\x94QH\xa0Fvp\xcc(\xd1\xe8\x89\xf0\x95\xec\xb7\xa8\xe9n?M,\xe3\x12\x02:\xc0\xe7;{\xe2\xe7\xd5r\xd7\n6\x88\xdc\x87\x84\xc1Z\xbc8k\xa9\x9b\x9b\xa8U\xd2\x18[cwL\xfd\x8e\xa8w\xeba\xbcP4;OX3\x01\xd2\x10t8\x1c\xad(\xecX\xd9\xa8W\xf3}\xa8\xa0/\x96\xcdTtZ\xd6\xb2Nb\x9c\x07\x04\xfe\xc5\x1e.\xf5Q\xab4\xf1F\x97\xd1K\xc5\xfa\xcf\xba\x147\xb3z\xd4\xef\xbby\x8b\\^\xe3FOP\xf3MJ\xcc\x98\x97\xfe\xdd|6mD|5\xe14.\xfcdt\xab\x95\x16\xfde\xe0\x81\x0f\x08\xe4\xc8k\xf9k\xda\x8d\xd7\xf0h\xde\xdfF\xc3\xcb\xb2qOYv\xda\x85b\xc8\xa4\xf7\xc5'\x8f\xf1\xc54\x8a\xc6\xe3%\x88\x15#\xe1\xb3\x0b4?\x10\x15M\xe7\xf2g\xe5d\xfb*a5\x8a\x82\xf8\xe3\x9e&.IN\xcfY\x93\x91\x00\xe4f-^vl\x19\x11^6\xd8\xfd\xe8\x194Y{\xda\x1f\x8b\xde\x8c\xbc\xa4S\xd8\xc5\x18}\x97\xd1\x0eZP9\x87\xb4~KVzS\xaa\x85$\x9c\x94\xb4/\x12\xbb\xfd/l\x9ag\xa9\x0f\xad\xc3\xb7\xae\xf9\xcd\xa7\xf5Ne\xed\x99\x1c\xa3u\x14\x12N*\x86y	'$\xa8\x81b\x8f\xd5\x17\x9d\xf6\x8d\x15\x1d\xa0{\xcf\xd5\xb1\x0f\xe1\xd4\xff\xfe\xd8\x1a\x171}\x85\x158\xbd\xf9\x9ej\xec\x05\xa0\xca\x02\xa1\x84\xad\xb5\x18\\\xaf\x0f\x01\x1d\xda\x1e?\xd2\xe8.a\x15\x8f\xd8\xf6\xe4Ej\xf4\"\xa1\xb9\xe2\x91\xa5\x95\xbcV\xad\x0e\xa5\xb6u{A\xd4\xd4H\x986c\xdf\xaa\x8f6+\x9c\x1a\xa0\xba\xed*\x06\x13\xe4\x03}\xa0\xb8\x0eV\xbe\xd5\xcb\xc2\x95\x1a\xa9U\xca\x9b\x15KT\xab\xaf\xdd\xc3\xad\x9d\xe7\xbdxE\x85\x0e\x04\x96\xfd\x95&\xb8@\xcc|\x18\x06IQT\x13K\x91D\x82\xdb\x03\xe8\xcd\x1f\x89$%6\x96<7\xd5\x15\x18\x13ZH\x9b\x0e\x18.\xee\x7f\xf7\xe5R\x04\xb0\xa2\\}\x9f\xc9&\xe2\x17\xef\xcbq\xe5v	\xe7T\xf0P/\xa6:\xde\xeb\n-\xed7\x92<P\x88\x90lzN0\x93L\x01\x8d\x9b{D\xc2\xd0|l\x0fU\x1f\xa8\xca\x8a\xc7kF\x17\x16\x11\xfb\x86\xc2{c\xfd\xa8\x815*\xd3-\xad\xa0\x10\x1fK\xcb\x89u^\x89g\xea\x06C\x07\xa1\xadw_\xab\xf3\x8d\x0c\xf5n\x88\xa5\x8d7[O\xcf\x946^v\x1d\xc4Y\xeb\xf2\x15=\x0b\xe9d\x96\xfc\x99I\xca#\x82u\x86\xd8\xa8\xef\xd4\xd9\x90\xd2\xd9\xeeF\x8e|p8s\xcb\xa4\xab\xda\x90\x1avWc\xd6h\xc9edU\x97U\xff\xd1O\xd1\xfe\xf35t\xd9E.L\xbaR2\xfd\x05\xf3\xaa`\x85\ntt\x1c\xe2\xf0\x89\xff\x90\xb6\x12\xb9Q5\x8c\xea\xac\xc8\xb7kwP\x94H\xfd\x14\xa1x\x8ca6v\x00SjO\xa1\x99\xd3\x0b\x97\x9c^r\x0cT\x8djK\x1f\x17\xf1\xbd\xb6e\x06=\x9aJ\xb3\xf1/\xde\x9fq\xec#\xc3xA\xca\x84\x9c\xcb\xdf\xb4x\xa9\xfb;\x93j\x99\xfe\x85_K\xc9\x0cS\x9f\xab\xb2U@\x00\x03\xb5\xf0\x03e.\xad\\\"k\xbcuW\x95\xd1\xb7\x1c\x80\xc9\x1fIY:Z\xa9\xe1\xc71S\xdd\xc7\xfa*\x8cO@a;\xc8\xf8\xecF@\x95\x9c\x97\x07T\x91\x8f\xa6\xd2\xe8 \x15z\xe2>S=F,b>\xc9\xb1\x8a@\x18\x8d\xcf\xeb7ZH\x83\x1co\x88P#\x0b\x89\x19\\3\xd9\x11'\"\x96u\xc2\xef	\x8a\x03/\xb5(\xb7\xad\xc6sM\x99fL\xaa\xee\xf9\x0bC\xe5\x0b\xe7\x18\xcc2\x1b\xee9WY\xacH\x98\x15-1\xd7\x98+_Gl\xec\x98\xac\xe1\x8b)^\xeayA\xf5\xc7\xf4f\x8c\x02\x9bC!\x18g\xb1\xb0\xc6D0\x19\x91\xc7\xa3\xee\xda\xb0c\xe8\x9a\x1cX\xf0\xf0{\xf1\xb0\xfe\x95\x85\xa6\xf2\xcc\xe6\xfa\x08Q\xe7\"\x1a\xe0\xcb~@+/\xe1\xc9>9>w\x07\x05@\xd2\xeco\xc9)\xed\x12\x1f\x9e\xfelk\x15tr\x07\xd9a\x8f\xe8\xb3\xda\x87\xf5.\xeb\x9f\x8d\xce[1O\xa0Z\xa7\xbc\xdcY\xba\xb6\xd1g\xf3\x1c\x10\x12\x04\xd0\xce\xe9\\\xe8\xc3\x12\x11D\x9d\xdbR\x83:\xc0J\xae],\xb5\xac\xaa\xb1\xe6u\xf3Y\xc9\xb6\x8e\xcc\x001/\xba\xdd\xe8@\xd5!\xd0\xb9\x1e\x9a\xe2\xe8\xf9\xe3\xfdH\xe2;\xf0\xc7\xb2`\xba\xc4e\xe6\xf4\xc6\x12/E\x1a\xd8r\xd8\x97\xd3\x8bq\xa3EN\xfa\xd8\x9cN\xfb\xef;\xd2 \xdcF\x9d\xe1\xdd\x11G\x86\xa1\x00\x82\xab\xb4O\xc8\xf8x\x9b\x7f\x02\x7f\xce$?&\xad\xe0\x8a5\x87\xf7\x15\xdeK=\x92\xc0\xae\x88\x01\xb40\xb6Tq\xcf[\xaa\x84\xa7\x801\xf8\xcf\xb8\x1d}Kv\xf7\xf7O\xbeT\xe6\xac\xf7o\x1e\xfdM\xbe\xfd\x95\x90\xa06\xaa\xb3$\x02{`/\xa4#Z\xaaT^\x85\x8e\xd8\x0b\xdbH\xb8\x98\xec\xef\\\x97d\x07\x18\xb1\x11` \xd2E\x83?\xef\x06\xc1\xed\xc6\xb5\xb3\xd0\xcd\xb2C\x91\x7f\x08v\xd5\xbcX\xed\x85/\x07\xd6~\x7f\xed\x95\xf0}\xe3\xce\x82Rw/}`\xa6\xea\xef\xbd\x9b\x8eE\xc4J\nZ\x87CGw\xae:\x84h\xaa\x85\xd1\x02\xc2\xe7\x9c\x93 \xc6\xe3Bw\xeb\"Mo\x93\xf8\xa6P\xc9\xbb`]\xa7\x1b4x\xd6?\x8d\xe3\xd6#\x8fv\x95\x95\x16\x88y.\x9e0\xa1WP\xdb\xac\\o\xb1\x8f\xd9\x1d(a\x8cC\x96l\xd0\x1f-|\xf3\xc1\xedX\x97{\xe7_r\xf5\x85\x92\x17:7\xee\x9bq\x8ftDJ\xc8Z\x7fH\xc1'\xbf\xe9;D\xe2U\x86\xf6\x18\\\n\xce\xbfI\xc5nu\xc2\xb8\xdd\xee\xb3\x9a\x0c\x90b5\x1b\xed\x9fD\x1fDr[p\x12\xd0\x03\"U\xdeA\x87\x96Dky\x1a\x82\\\xfe\n&,\xf0C\x8c\xbc\xb4\x95\x9f\xb5\xf8\xe3\xfe\xe1\x15\xd2\xf3\\\x04\xff\x1d_Z\xe34\xfe\xb3\xd8\x93f\xa9%t\xee\xc2\xdfM\xeeK9\xedK\xdd\xc3`\x96\xd5\x12M\xc1\x90^\x17\xf2B%\xfbg\xdcW\xa7\x8e\x81\x0ck\xdf\x9c\x97yW\x80\xf6\x8e\x99\x8ad\xbf\xe4\xe1i\x10n\xef\x0d\x1f:\xf2R\x8d\x98\x91\x8dy\xaf\x1a\xc2a\x9ew\xdc'\xfc\xe0k\xc6>\xfd\xec\xdc\xc7\xc0\xbc\x12\xcb\xfe\xcf\x1aE\xf5\xe3I\xa4P\xdb\x86\x81\x1bx\x0f4\xe7\xe2 \xec\xf4O\x1c\xa7xX\xadz\xea\xaf$*s\xb0\x97\xc1\xdd\x85\x9ay\xa0\x8e\x02\xbb\x8b8\xde\xedU\xbd\xf6v\xf7G\xf7\x01\xd8K\xe1\x06\xc5\x8b>m\x8a\xc2\xb4\xb5Vi\x0cl\xc2m\xd0@\xb92)\xeb\x9c\xe8zf^\x17it\x00\xd9|\x87\xf8\xbb\xff\xf1\x94)\xfdg\x05\x85\xf2]Y\x86\xb3&A\xffc\x02o\xc4v\xd5\xd2b\x9e\xae\xcad\xad\xd8\x93\xbc\xdbb\xe7\xb0\xf5C\x7f\xa1\xc5\x9b7n-\xed~x\\0\xcf\xb7\xe6[\x82\xfb\x14!}\x16\xb4\xeb\x14]\xf8\xfb\xaaA%\xa9\xa13\x01l;\xa5\x8e\x1e8\xcf|\x17\xbe/\xbcu\xd2H\xf15\xc2\xa8\x84t*$\xe8\xf4\xae\xc4\xa5{\xfc\xd3\xc5k\x92\xa98q+2c\x06z$\x17%\x00\x892\x05\xae\x00H\x10\xab8\x18\x11\xa7\xa1\x12\xe0vya*\xfe\x06*\x7f\x96\x01h\xb7\xf9\xa7L:\x06O\xa2\xe9\x11\xff\xd3\xc2\x0cpL2\xfbuVD\x18\xa4\x1f@\x1aC8\xb3A\\Z\x11c\x94\x92\x02\x95 A7\x1c\xab\x0f\xd8\xe1\x96\xed\x801\xc6\x98\x95\x06-g@\xa3\xa5#\x13\x8c\x13\x0c\x063\x9a\xd9\xdd\xd5B0fcAp\x19\xd5\xf6\xc7RZ8u3\x1c}%\xce\x80\x1a\x10h\x8c)\x050\xee%\x98E\xe9\x18i7\x9c\xa0[\x8c%9\x06\xbc\x8af\x08\x8eukG\xdb!b\x1c\xe4m\xd5\xcdb\xe9I\xd1\x06f\xe2\x1e\xd9q^d\xac\xa1|\xf4M\x10\xe0\xa4\x85=\x14w\x0b\xa9\x8c\x0d\xc3\xd3D\xc0\xe3\xd8bq:#\xfc1\xa4h\\&\x00\x07\xe2\x12\x10\xc8\xa4\xec\xd8\xd0SMN<\xe8\xc7z\\F;%\x1f\x1c\x1bD-xo \x1e\xba\x1c_@\xba\x19#\xaf\x93G\x8d\xae2c\x8b\xd1\x02\x95d\x8b\x97Q\x1bT\x9e& \x99\x9f\x17\x8f\xc4\xa5U\xbaK\xb6\xe0\x9fgW>\xdf\x93z\xca\x19\xf8\xbc\x9d\x9a\xa1\x86\x8c\x1dA1\x11HT88\xef'R\xe2g\xf9\xa4\x19\xb3$bp\x12);i\xbe=\xe9+\\\xfe\x7f\x9d\x19\xf2\x7f\x95\xd1\x8f\xff\xd7\x99\x8dJ\xfe\xe3\xcc\x0f\x8b\xab\xebZ\xeb\x1a\x80\xe6wj@5\x05\xa1\xb7\xb7\xfem}qW\x05@\xd571A\x05\x99\xacm\x18)\x18\xfb0\xd7?9\xf5\xa9s&\x8fH\xea\x1b\x98_\x9f\\\\\xca\xeaY\xf9\xb2\xb1Q\xbaEN'\x8e\xcf}\xda[/\xa6e9\xa7\x1d\x16i\xfc\xc1\xf5\xc7\"f/_)\xe2\x9cY\x9f\x0cEc\xeeG\x81X\x84\x04H\xbay\xdd\x81tl\xc2\xfe#\xa9\x02]\xf8h\xaa\x0b\x87\xd6\x0fv\x1a\xc90\xce\x1e\x11H\xf3\xe4\x00\x98\xadlQ\xe9K\xaa\xbd\x82e\x87J\xeb\xf7\x12Z\xae\x87\x97v\x1c\x12wja\xc9W\xbbAC\xfe\xfb\x03\x02G\x08\xa3@\xf8	S\xc0\xce\x84\xfb\xe7\xb2@Tr1\xd7\xc4rM\xd1\xb5\x0e\xd9\x16\x1ac!\x94L	\xdfm\xb3\xf1@P\xb4\xe1G]m\xbaww\xbe\x1a\xb6\xe4\x9b}f*C\xa1\xbf\xbdGC\xdac\x94\x08\x9e\xf7\x8b)\xee\xceX'\xfe\xce\x03C\xd8\x84\x81p\x01\x0f\xd5\xe5\xf1,\xe8y\xae\x82p\xed\xea`\x00\x9d\xf4G\xcae\xdf\x98\xe1\x94[%o\x13;\x02\\,\xf6=R\x8f\x90y9\x0e\xcf\x0b5_\xb2\x07p5\xbb\xcf:\x03\xed\xd7\x92Cz]\xfc\xc4\xbd\xa6m\xe8u\x7fH\x95N\xed\xe8/\xc8T\xcby\x8c2\x0eR\x7fk\xc4\xb4\xff~?\xfc\x9c\xea\xfc\xeb\xab?Z\xe4\xd1\xa6\xf8_\xa8\xab'\x07\xdf\xad[\x83.\xf5\xa3ZN\x07\xe6T*\xa0tO\x14\xc3\x96\xf6\xcb\xa8x\xd9\x8f\xa6\\\x0b\x817\x8f\xde$\xfb\x9d\xffh\xb3y\xb0\xd7\x11\xf7\xfa\xa9\x1e`\xc7\x19\x04\xfd\xec\xca\xa3\xeb`\xfd\xf3R\xc7\xcb\xf1Oz\xb6\x1f\xeb\x18\xf3J\x00A\xfd\xfa\xb7p\xceP\xa8~\xdc\xbd\x0c\xcd|q`\xc0\x84?\x00\xd0\xce\xe6g\xe9\x83\x11\xae>\x17u\xa1\x02Q\x82\xbbdt\xdd\xe8\xae\x99[&\xad\xde\x0d&\xdeS(\x96\xe7cf?#s\xc4\xaa\xb6j\x94\xfa\xea\xc5\xfa\xe5\x8b\x9f\xc7\x07\xb2\x8a\xc8\x15\xfb\xad\x86\x95\x9cP\xc33\xcd\xab\x85\xb2m5\x12~\xde\xf0\xb7\xeaV+QD\xd6\x19\xb9 ]i*\x8d3\xa2\x9a6\x05R\xa0	\xe0lg\xabd6c\x7f\xbb\x04IZ\xfb\xba\xef0\xa3\x02\x16zP\xa5-\xf6bp\xd3\xe7\x01\x16\x8a\xac\xf0\xbe\x99Pj\x92\x02\xb6\xc3\x94\xb4ku\xb2\x94\xb9\xc3\xe69\\\xee]@\xaak1\xc9j\xbbv\xcf\x95\xd7\xd2\xab*\xd4\\\xab\x11\xf5\xe4?\x82\xc2\x86*\x81U\x96\\\xcc\xf17\x9a#\xffUof\x16\xc7JM\x95pX\x8935\x10\x12\xb6\xbf5\n\x83\xdd\xae\x17?u\x1e\x13\xf2\x84\xf7xXQV4\x97_\x12-~+H\x89\x1a\x95k0\xaa\xbem(\x14I\x13\xab~\xa6\xf3\xc0\xd9K\xe2|\xb1\xf6\x03\xca\xf3\x15\xe4(\x92\xe6\x96%E\x00\xe2\xa6r\xf1(7F\x8c\x83\xf5+K\xcf\x99\xcd\x0f/\xcb\xa7\xcc[b\xe5\xdfP\xbb\x8dE\xea\xfc]IXg\xa6\x81\x0f\xc3\xc0\xc4\xb9\x8f\xfe\x1f\xd4\x93\xed\n\xcf\xee\x8c\x1f>T\x14\xbf2-\xca5\xfe6\xfa\xac\xd0kL\x88\x86\xe6\xf2x\xbfU\xd4\xf9\xda<\xaeQ\xeb\xb4\\\xf6\xe1(\xa3y\xeco}\x9e\xf8d\xa8~iE\xd7\x03\xcdDB\xbf\x16N\xa2\xc5\xd3;^D\x9b\xc8\xf4\xe4CC\x8c\xe4\xe3\x88\xb7\xb3t\xa6\xdc\xc0\x145\x9f\x8f\xff\\\xcb\xa4\x9c\xe0\xf4\x90\xb3\xb5(\xb8\xa2:\xc0O\xee\xc9\x02\x18\xfd\xf53m$\x08\xe4} *\xb7h\x13\x1e\x913\xd1 \xab$\xb6\xca\xf1DTn\xbe\xa0\x8fE>\xe7\xd98\xea\xd7\n\xc4\x8a\x11\xf9\xe5\xb9\xd4E4J;*\xeb\xa8\x0d]v\xfbl\xc1w\\\xf4'\xfa\xb1_\xe4\xe5\xce\xac%T\x1cMn\xfa\xd4\xbd\xa3\xc4\xcc\xc8\xf7\xf7p\x80e9\xd8\xce{\xb4\x1bUG\x8d\xaf?\xdd`\xe5M\xe5\xcbqf\x90\x0e\xfe\xdai\xba\xf4n[=\x859\x1cX\x8ec\x88\xfc\xd1\xcf\xeb\xfds\xb9\xb6_A~c\xde\xb6\xa7\xeexx\xf6\xdd\xd1\x89/\x07{\xd8v\xf9\x8b\xa3\xee\xd3\xbf\xbfm{\x16k,\xf3\xd7\x19\x91\x00\xec\x8d\xc1\xd3\x8cPN\x1d#C1\xc3\x94;O'\xdb\x0b\x0e|(\x81\x81\x93\x03,\\z\xe9\xfdR\xda\x1d\"\x1c\x1aF\xd5\xf5\xad\x7fR:!\x02\xe0\xa3\xa8\xb4\\\xfc\x87\x82\xb7\xc1n\x96`\xe1\x89E\xa7	\xae\"\xfa\x8b\xa2\x11\xa3\xf7\x91\x80\xf6#,[\xe6\x90\xf2\xb1\x12- \x8eV\xc3\x82\x90`\x98\x16r\xcc\x8c|?+\xbe\x0f+9\xc1\xf7\xe7\x02zm\x9dn\xfb)\xd8\xca\xe1k\xfe\\\x1f\x8fu\x83\xdd\xbf\x11\x80	\x1a$\x0c\x16D\x82-\x12\xb9;o\x17jplB\x12\x0c\x8f\xbf\xb1\xba\x93\xa4El.S\x03x\x9dV\x7f\x15\xa6\xab\x19\x16\xa6x\x03\xd4\x04\xce\x0c\xb8\xd8\x15\xf8g\x9b\xa7\xdf\x1f\xf0\xad!\xd4W\xac\x0f\xa3\xd8\xfc\xa7\xd9\x0e\xda\xbbxv\x98\xa2\xba\x11\x99\x05cn\x1ak?\x0c\xc5\xbeZ\x08\nl\xc05WL\x1e\xe1|r\x05\xd9E_j\xdc\xafO\xf1<\xa4@\x07L\xa6\x03\x06\xc9\xf6\xdaG\xf0\x0d\x81\x00\x05\x15\xe3#\xe7\xb4p{\x8em\x9e\xa1!\xd9>\xb1\xf8\xe8tg4s\xe5\xd5\xb5\x1bi\x0c\xe2\xebe\xd2b\xf24\x8f\x97&\xd3\xbf[\x14\xf7\xaf\x07\x08\xbf\xc6\xf3\x83\xa0\xf2\x80\xe4\xcb\x11a+fDxk-\xa5\xd0\xb2\xbc\x9e;sC\xee\xc6t\"E\x07\xac\xc0\x10-\xf5\xea\x94uK~\xfc\x8f\xae!\xf6\xd3\xab\x14Fu%\xe8\xd8=\xae\x9e\xc1\xcc\x08J$\xf3\xcf\x9cO\xbe\xfb/\x0b\xcc\x01\x02\xc55`\xd8\xbf\x1cW\x08\xdf+\x13\x12p\xff\x14\xb6\xca\x92C\xeb\x85\xd0:\xa9R\xd0\x93\x94bey\x8d\xb8\x04\xce\xa8\xa3\\\xfcs/7\xa46\xd4\xee+N\xa7\xc4\xfa\x95\xca\xc9\\y`\x92\xfe9\x1du\x9c3\x19\xbe\x8d\x1c\x85\x02y\\\x19E\xf2\x1f\xb6\x99\x03-\xae\xc7\xff2.[u\x14\x12(\x1e\xd5\xd2\xa4y\x1b\xecvw\xc7\xf0e\xe8c\xa6t|U\x0b|\xf0\xb1\x90\xe2\xfd\xc2ML\xf5\x0b\x9e\xfcGS\n]\xb5\x1e\x19\xe5\xd7\xd4\xab\xa9\xbb\xe6E\x9dw\x1019\xac\xbbf\xa6\x1e\xc4Z\xb3\x8c\xbaA\xcb\x1a\x8d\xa9\xca\xc96.\xf6\x02~2!9\xd7cn\xf7\xc1f\x13\x1b\x12sOQ\x91XP\xed\x97\xeca-\xf2\x83z\x01C?s\xdc\xa8\xb1w\x1b@\xf2\xcd\x96i\x8c\x82*\xf5\xea(\x1e\xf1})N\xf0o0\xdc\xdb\xe5\xd1W\x18L\xd4Om\xec\x04\x90\xaeJ|\xd8v\xec\xaf\x92bH/T\xab&\x86G\x81P\x02Czy\xae\x9c\x19\x8fk\xac\xe2A\xd4\xb7u%,\x91\xb3\x00\x9a\x82\xb4\x81v\x8d\xf0$\xd1\xdb\x05\xbd\xe9\xfb\x87\xe4W\xff\x91\xbd\x16i\xdc\x91\xf6\xf6\xd2\x9d\xb9`ln\x8b\x7f\xbfa\x7f\xe0\xb6\xfd\x0d\xf4\xd0\xfe\x8d\xc0\xb6\xae\x7f\xb5\x86,\x93\xe6\xf4\x89\xbf(\x04O	>\xe7\xe6Kk\xfc,oS}E\xf5\xf2,\x89hm\xbe\xce\xfb\xaf\xd7\xc2}\xcf\x99\x0c\x83\xe5\xde\x91\x16\xebC\xc5jI\x87i@\xfa\xa0\xb2\x1e\xc48o\x9e\xf2@\x0eDKo\xeb\x0e\xc1\xa08\x9bYZD\x0cA\x80\xee\xe1\xadE\x97\xb6\xf7\xf3\x01\x95Ab\xb4K\x0c\x8e\xe3\xb5\xb93LA\xde	\xab\xf9\xb2$&\xd1%g\xd5\n-\x1a\xb6\xd2\xe5Q\xa1\xec&}\x90\x8f-\xad'G\xff\xdd\x9b\x98\x17\xdbB\x0b\x06\xc5\xf4o\xef\xb3\xbb&\\~1\xca\xfa\x98\xf2z\xca\xb9\x19F\xd4]\x0b\xaa\xe5\x14c\x1f\x92\xcc\x0c\xd1\xa1\xc1\xee}\xbf\xee\x04\x00\xf1\xbfi]6\xb9\xcc\x07\xad\x9f\xe2r\xa6_\xa1\xb8\x9as\xe2\xf0\xfao`;\xdc(a[\xf9~\x9bA\xf6^\xfd\x98\x14\x18e'G\xf8\xc77\xdes\xbb\x8f\xfdmB\xe9\xee\xc4\x88\xd9]~{\xf8\xcd(\x8b\xabgv#5F=oA\x91\x02)\xf0\x8fZ\xbf\xdc\xf5\xea\x1c\x7f\x85t+N\xab\x12\x12oJ>\n\x11\x0b^/9pp\x1c\x1d\xa6\x85[\xb2rI\xacp\xbc\x91\xdcu\x11\x98#:]\xfdV\xc1\x99\xf7\xc80zy\x9e\xf41\x90\xdaM\xc4#\xe9l>e\xf1\xbb#\xa2'\x07\x90pX\xfe\x8a\xd0\x95M\xe1&\xec\x07\xbe\xcb[\xfc\xb0\xf4SY\x81n6J?\xacj\xfec[\xab\xe3\xc8\x9e\x06\xff\x9d\xb6\xe5\xe49\xd9=g\x8b\x19\x85\x99\xaf\xe4\x8f#\x1ah\x1at\x90\xd2\xdf\x95\xc3\xc0V\xf9\xab\x93\xee\xee\xc3 \xe0\xfa\x08\x99IJd8J\xc4'\xdf\x8a\xca\xed\xfe\xf1\xf9\x94\xa0\x0b2\x1c.?\xd1\xc7\x0d\xfd\x91\xf9\xae\x0b\x94s\xd1\xd2\xbdb\xb7\x97\xf8\xfb`~V\xc5Y\x80\xa2\xb0\xc3\xe8R>7\xc9\x1do\xf8I\xef\x81c\x89\xd5\x86\x9d\xfd\xe9x\xd6G\xff@\xd3\xbb\x9d\xbfQjj!3{6G]aO\xea7\xb5\xcbT.1\xe1	/F6TB\xf5\x1c\x16\xe5\xffh\x17n\xbb\x15\xb8Q\xf8\xec1K[\xfe3\xf0\xbeiS\x8c[:9\x8cg\xcd\xb9\xc5\xc0K\x13\xbf*\xb3s\xf6\xba\xf38\xe2O\xaa\xdf^ \xf5\xee\xccV\xfc\x1d\xd5?\x95\xd2\x18\xbf(\xd6\x80!\xfdS\xed>\xc2\xde{\xcb\x8b#\x9e\xad\x90\x996\xc0\xfa\xd2\xfa\xb56^\xb3E\xff]\xf2'\xa1\xbaR\x01\xcc\xc7\x0e\x04\xf9\xf9\x1f\xa2\xe5lq$9\xfcR\x98\xf6y\x06>\xffGY\xc5\xe7\xa6\xe1\x9e\x90\xaa\x1a\x95\xad\xda[\xbc3a\xde\x12\x1e\x81\xde\x94\x02f\x15\x15\xae/\xce)\xc83\xa4w\x9a\x1195txv\xb1\x14\xb0\x80<\xe6\xe3Z\xe5c*\x84\xe1\xefI\xde\x12\xcd\xf6\xb6\xefP\x8a\\0\x99\x03r&T\x18\xb1\xad\xf2l\xcc\x87>\xaaoT\xff\xa2Y\x87\x1b`\x9dD_\xfcl\x00\xfd2\xedYh\x0cR\xbc\xa9\xe9\x0f3\x8d\xb2q\x8c\xec\x18\x8c\xfa\xd7\x16u+%|F\xe6\xc9]\x87g\xd4AO?\x0fT\x88qWD\xd2\x8cD\x91\xe6\"\xb9O\xe8\xd9\x1d\x9fQha\xa8*\xaf\xc2*\xa3\xfeU`.\xa0\x98\"\x00\x1b\x9c\xf5L X\xe7\xd4\x15{\x0b\x1f1\x12\x17N;\x8c]/\xc5\x8ai\xf1Ij\x81\x82\x07!4&O\xcf\xdf\xe0\\\x00\xe0\xfc\x16\xcd\xadIt3[4\\<\xfa\x01\x9c\xa7\xa41J\x96,\xf30\x1a\xf4\xe2wtoS\xa4Ev\xb8\xb1Y\x8cvLLHy\x0cy8\xc6\xda-f\xc5\x18s\x12\x17\xe5@\xc6\xa5\xc7\xe9\xba\x90\xc3\xed\xe0\xb1\x9e\xed\xd1&dC7\xadH\x0f\xf98\x9b5F\xbdl\x83\xa0\xec{pD,2\x93a)(\xc6\x97\x1a\x87\xad\xbcJ\x9f\xca\x1c\x95\x1d\xcb\xb7)s-\x18\x9f&\x1f\x9fn\x16\x9f\x19\x1c\x9f\x9d\x1d\x9f\xdb\x1e\xff\x8a\x14o\xc1H	(\xfd\xbf\x00\x00\x00\xff\xffPK\x07\x08]12\x95\xaeZ\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00{	5P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00migrations/001_init_main.sqlUT\x05\x00\x01\xdbO&^\x8c\x94\xdfo\xdb6\x10\xc7\xdf\xfbW\x1c\xce\x0fi;\xc6Q~\xcd\x05\xf7R'K\xbc\x0d\xe9\x96\xb9\x1b6d\xdaX\x9a<\x8b\x84)R \xa98n\xd1\xfe\xed\x03\xe5\xd6N\x8a=\x0c\x02\xc8\xef}\x8eG\xde\x9dDY\x9f(f\xb0>\x07h\xa5\xf5\xf0|E\x1b\x06\xf7\xd2\xbdxv/]O	\x9e\x1f\xa8\xe0\x97\xb69`p\xf0\x01\x95\xec\xb22\x12\xf9R\xbaD\x0c[\x99\xfbH;\xb3\x8b\xbd'\x91M$\xa9\xd3\x8enmA\x0f\x9d\x8d\x1b\xe4\x93\x12\xf6 \x92}O\xc8\xcf\x19jZ\xca\xdee\xe1\xa4o\x90#y1\xbb\xc0=\xce\x86ZB\x8em dh[\xd9\x90\x88!d\x11\xee)F\xab\x8b\x0f\x19:\xebW	\xf9\x07<SFz\xe4hr\xee\xf8\xd1\xd1z\xbd\x1e\x0fh\x1cbs\x84\x1f\x19R+\xad\x13\x14c\x88\xfb\x1c\x7f\xba\xbd\x9a\x89l\xfav\xe1\xa5u{\xaem\x92\x0bWN\\\x84\x9c\x1e\xd5\xfd \xd6Vg\x83\xfc\xdb\xaa\xaa\xb6\xc0\x90mL\xfeB\x1e\x1f#\x12\xc5{\x8a\xa2\x0b1#?\x7f5a\xa8\x8c\x8c\"\xa9P\xbaw<\xa9\x18v!e\xa1\"\xc9l\x83\xdfy\xce\x87\xbd\xb6U?eC\x0f~\x9f\xdf\xecK=>\x99\x8c\xabq5>F\x86I\xba\x8c\x1co\xa6\xff\xef\xc1\xaf\xf2\x95ZGJi\xdb\xda'\x9eN\xa6\xb4\x0eQ\xff\x87\xebs\x91Ob\x97Dz!\xd5J\x0c+\xb7\xecz\xfa+r|\xdbw\xa5\x1d\xa4\xa1\xef\\\x90\x1a\x96\xd6\x11\xe4MG	d$(o\x84\xc1\xed\xcf3\x06\xd3a\xfc\xe3\xea\xe2\x0d\x837\xb7\xa7\x0c\xaeo\xa6\x97E\x9e1\xf8eVV}\x7f\xcd\xe0\xee\xc7[\x06\x93;\x06\xbfM\xe7\xe3\xd9\xe7\xf9\xcf;\x06\xf3\xe9\x9c\xc1\xe5\xc5]\x19\xe6\xe3\xda\xd7}U\x9d*\x13\x87\x99\xae\xbc\x92\x89 \xd3C\xb9\x08\xbc\xf6\x00/_\xc22DH]\xb0\x8eb*\xe8\xf5\xeb\x01-\x82\xd3\xc5\xfc\xf4i0m\x96\xce\xaaa\xc1?q \x91\xf4\xb0\xd5\x80\x16\xdb\x18\xd7\xd3\x8e\xbd{7\xb0.\x86&\xca\xd6\xfa\x06T\xd0\x04\xc66\xc6\x95\xaf\xc7\xfa\xe6\xeb\x04\x7f\x90\xc9\x80\nm+\xbdN\xbc\xf6#}\\U0:\x19\xa6C\x98\x07\xe7@[E\xb5\x1f-\x9d\xed\xe0\x10.\x83\xf5Pt\xedG\xaf\x16\xd298\x84\xa9\x87A\xd6~\x94\xd6'g\xfc\xb4\x82\"\xf8^\x16\xf1\xcd#}xZ\xb6\xaf\xf1\xed\xc6\xab\xb5\xcc\xca\xd4\x08i\xe3\x95\x89\xc1\xdb\xf7\xa5N\xdb\x12\xa8\xd0\xfbL\x11\xd9\x97\x7f\x83\xc8\xb2I\xc8\xff\xc2\xae\xc4\x84\xdeY\xb1\xf2a\xedH7\xe5\x02+\x1b}@\x86F\xae\xfaHVD\xb2m\x8f\x7f\x7f<x\xf1\xdd\xb3\x7f\x03\x00\x00\xff\xffPK\x07\x08\x04\xd7\xecx\xa6\x02\x00\x00\x8f\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00}\xb0GP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00migrations/002_images.sqlUT\x05\x00\x01\xce\xde=^t\x92M\x8f\x9b0\x10\x86\xcf\xf6\xaf\x98\x1b q\x80m\xd3\x1e\xaa]iw\x9b\xa0T\xfb\x81\xd2U[\xe5\x12\x19\x98b+\xb6\x89`HE\x7f}\x85M>\xd4MN\xefh\xdey\xc6\x9a\x19\x97-\nB\xa0a\x87\xf0[i\xdc\xb8Ht\x80\xb67\x10r\x16|\xcb\xe7Y\x10s\x16\xe4/^\xb3\xe5\xc2\xe9\xcf\xf9\xc3\xb37\xbe\xfa\xc4\xf7\x1f\xbe\xe09\xff8\xe9\x07\xa7\xaf\x99\xcf\xaf\x97\xb9\xd3\xcfk'o\xd9\xa4\xbf\xbc.\x9e\xee\x1f]\xf0\xf2\xbaY,\x9f\xe6\x93\xf9vx\xcc\xc3\xab\xfb\x95\xd3\xc7\x87\xf5\xa4\xab\x80G_8\x9f&\xa9\x1a#\x94\x85^YJg\xe3 \x9d\x11Z+KPJ,\xb7\x10\xee\x85\xee\x11\xeen!9\xa3H\x14\x1aA\x19Qc7N\xddI\x91B1\x10\n\xd8\xb5\xca\x88v\x80-\x0e\x87\x16MIH\x1b\x8d\xb6&\x19\x8e\xa5\x11\xdc\xc2M\x12\xc5\x9c\x99j6q\xb6!\xb0\xbd\xd6\x17!S\xcdF&\xfd\x14\xc5\x9c3\xd1W\xaa\x81\xa2i\xf4\x91\x8a9\xdb\xab\n\xdfe9;]\xe9\x14\x9dQ${S\\\xf79\xfb\xa3*\x92\x87\xfd\x9c\x81\x12U-\xe9\x82\xe1;^\xc3\xbc{\x0d\xe6\xacS\x7f\x11\nU\x8f\x17\xf8\x7f%\xce\xbb\x03\xb7\xb8\xaao\x05\xa9\xc6\xc2\xa5\xc2\xa3\xe9\xce6\xb6%E\x1aa/\xdaR\x8a6\xbcI\\\x0f\xd1\x92\xea\xe8\x98M\x93$\x1a\xbf\xc6\xbf\x00\x00\x00\xff\xffPK\x07\x08\xd5\xc6\x033f\x01\x00\x00\xe4\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfa\x99\xb5P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00migrations/003_threads.sqlUT\x05\x00\x01h\xd3\xc6^\x8cVM\x93\xa36\x10=K\xbf\xa2\x0fS5\x90\xf2:x\x92\xd3:\xdeC\xfeF*E	\xa9\xc1\xda\x11\x12\xd6\xc7z\x9c_\x9f\x12\x08\x90mfk/\xaer\xf3\xba\xfb\xf5\xeb'\x01\xb7\xc8<\x820=\x93\x1a\xbc\xec\xd1y\xd6\x0f\xfe\xbf\x9a\x05ojm\xae\xc0\\\x1e\x07m<\xe8\xa0\x14\x08lYP\x1e\xb4\xb9\x16\xe5\x91\xd2T\xca\xe1%\xa0\xe6\x08\x83q\xbe\x96\xa2vx\x895\x1a\xd9I\xedW\x9cg\x8dJ n\xfa\xdeh((\x91\x82\x122!)!s+J\xc8\xd2\x0c?\xfc\x0f\xa6\x8a\xd7\xac\xfa\xeb\xd7\xaf\x16;\xae\x98s%%\x84\x9f\x91\xbfC!\x05|\x83\xaa\xdcQ25\x14\xb5\xd9\x1e\x90\x96\x8f\xa4\xfc\xd9\"\x13.\x12\x1a\xac\xec\x99\xbd\xc1;\xdeb\xc9X\xce\x85\xe6;r\x0f?\x98\xe5gf\x8bCU\x95\x8b*;J\x9a\xd0\x0f\x9fw\xdbQ\xe2Y\xe7\x96\xec\xb7\xaa\xfc\xe7\xdfU\xd4D\x9eY\xcbn\xb5B\xdd\xf9s\x11\xf1;8\x94\xd0\xa0\xbf\"j8\x00\xd3\x02\xfe(iI\xa5>\xa3\x95\xdeA\x91)\x99\x0d$\xb5\xc0\x8fy\xa0:V\xaa\xa5\xf8\x80H.\x0d\x19\x9c\xd4\x1dtR\xc3\xd8\xe8I\x8c!4J\xf2\xfa\x1don\xdaP\xdc\xa4C+\x99\x82L\x9d\x1d%	)\x05\x84\x10\x7f\xb4\xbc\x04\xcc\x95YKAs\xf3\xc8\x1e!\xf3\xf4\x86{\xf4\xf3\xf4kR	\x7f\x9d\xe0P\xbd\xfdY>\xaf,N\xff\xd9\xc2\xa6I\x93\x01\xd7f\x16[\xb4\xd1\xa9n\xd1\xc2h\x10\xa8\xd0#p\xe68\x13\x18I\xb3\x0e\x9frg;V3\xe5\x11\xf5\xed4\x1a\x8e\x123\xa0\x86\xc6\x18\xf5\x9c\xe1m\xc0\x08qc\xd9MH\xcb\x94\x8b\x9d5\xeb\xf1\xceeq\x16+\x87\xc7X\xabX\x07\x93\x99\xc6\xee\xb9\xcc\x13\xefl\xd4l\x9d\x11\xdb\x18q\x83\xef\xce\xe8&[\x14%\xb2\x1f\xe9\x8dK\xca\x92\xc7\xb0\xdb\xa5\xe7\xf5\x1d\xc1\xb7\xfc\x18,\xb3\xbc\xbe.h7\x18\xa9\xd0\xa2\xf8\xd9\xd8\xbfl\xe9q\xe1\xf5\xb4\xb8\xd9\xd2\xc9\x04S\xb0<n%\xc4==\xc0ch\x1b\x1c\xd7\xf8\x00\x8e\xa1m\xf0z\xcb<\xa4\xac\x0f>\xa1\xb4l\xe4\x91\xd8\xea\xfc\xcd\xc4I\xd6\xfb\x9c1\x96\x89e,X\x1c\x14\xe3\x08m\xd0\xdc\xcb\x04\xad\xb9	\xda\x17wG\xa3\xa4\x16}\xb0z\xbe\xab\xa9b\xba\x0b\xd1\x08\xee\xa2\xc0\xa5\x83\xc6,S\n\x158\xd6\"8o%\xf7\x949xy\xa1\xc4\xa1\x8a\x17\xe3T\xfa\xb7\x92\x92\xd6\x9a~bF\xc9\xf5\x8c6\x9d\xd3}j{\xca\xa8\xa4\xd8\x91\xbe\xbc\xfc\x9c}\x83\xad\xb1X'	\xb4C\xeb\x8b\x95\xb9\xb7\xb2\xeb\xd0\xae\xd4\x075t\xee\xa2\x12E\x81\\1\x8b\x94\xcc\xe9\xf5\x9d\x02G\xda`'\xf5\xf3\xe3\x9ci\xa1\xf1\xba_<F\xe2\xbf\xf1\xf4\x9f\xe2\xa5\x81\x94\xc4I5<W\xa8\xc0\xc7\x07\x15%\x04\x95\xc3'\xc4\xefp\xa8*JP\x8bc<\x81\xedtB\xf0\xba\x1f\xaf\x8a\x98\x1b_nL)\x88o\x98\x94\xf5@\x06\xb5\x00\xd9\xc6\xfcI\x90\x98\x7f\xa4c\xc9\\\xd7\xa4\xd2\x96\x96t\x8a\xc1\xf4oq\x16m\x8d\x05d\xfc\x0c\xd6\\\x01?\x90\x07\x8f0X\xc3Q\x04\x8b\xdb[9R\xfa\xe5\x0b\xfc\x1d\xfa\x01X\xbac\xc1\x1b\xf0f\x00\xd3NV\xde\xd8tV4\x9bS\xae.\xcdm9[/\x0cb\x1c,]\xe4\xf1\x9b%\xfa\xd1\xc3\xfa6>M_*\xb3\x13\xfd^\xc6\xadd=\xf6r\x96\xe9\xff\x00\x00\x00\xff\xffPK\x07\x08\x07\xe1\x0b\xce^\x03\x00\x00\x16	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00qU\xa2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00migrations/004_antispam.sqlUT\x05\x00\x01\xd7N\xad^\xac\x90=N\xc4@\x0c\x85k\xe6\x14.\xd9\"'\xa0\xa7\xa2@\xe2\x00#g\xe2\xecZ8\x9e\x91\xed\xa0,\xa7G\xd9\x84\xf0So7z\xef\xe9\x9bO\xee:xE#\x0d\x08\xec\x85`\xac\x06(\x02\xb446\xd6\xf3\x16{*F\x18\xb4\x8f\xb6\x92\x1c\x1e\xd3\xc3\xedM\x0e\xc1\x13y\xe0\xd4\xe2\x13\xb4\x06\xe8,\x92NO)u\x1d<W\x83\x11=\xc8\xa0\x08\xa1\xce\x0d\n\x8a\x1cT\xd6\x81\x96\x83\x9awd\xe6a\x81\xaa\xbf~\xdb\x8b\x9d\xfa&<\xac\x86\xa8\xc1\xdep\x02/\xd5\xfe\xab\xaeE\xde\x8a\xd5\xb6\xcd\xbdp\xc9\xeft\x85\x9e\xcf\xac\x01\xcdxB\xbb\xc2\x1a\x19\x8dd\xa4\x85\x1c~\x86\x9eN\x89\xf5B\xc6\xf1\xad\xc0\x87\xc3\x0bz\x80W\xf9\xa0\x01\n\xb6(\x17\xbc\x9dpv\xb2\xbf\"\x82\x1ey[\xe6}yw\xa3\xaf\x00\x00\x00\xff\xffPK\x07\x08\xd1\xe6\xed\xc5\xd9\x00\x00\x00\xcd\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00/\x8d+P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00migrations/005_feeds.sqlUT\x05\x00\x01\xca\x08\x1a^$\xcc\xc1\xad\x830\x0c\x06\xe0\xf3\xcb\x14\xff!\x07X\x01\x86y2\x91\xa1\x91L\x92\xda\x7f$\xd4\xe9{\xe8\x02_q\x15*\xba\xc3u\x98\x14\xc59[a\xed\x0d\xec\xff\xb3\xd5g!Xo\x0d\xca=\xf8Y\x93+\xa7\xb7\xc0Q\xaf\xda\x98L\xda5\xe5R\xc4\xdb\x10\x94\xc3\x14C\\\xcc\xd4\x10r*\x82^\x0b\x93\x04rN\x7f\xa1\xa6\x85\xd0\x87.\x85\x8b\x8e^^8\xbd\xdf\xe0\xbam?tO9\xef\xe9\x1b\x00\x00\xff\xffPK\x07\x08\x1d\xd4\x12-|\x00\x00\x00\x9b\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfa\x99\xb5P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00migrations/006_image_insertion.sqlUT\x05\x00\x01h\xd3\xc6^|T\xc1n\xeb*\x10]\xc3W\xcc\"\x92\x93'7R\x17]Y]\xbe\xef\xb0\xb0\x19\xdbSa\xe0\x01~i\xaf\xfa\xf1W\x03&qt\xd5\xbb\xea\xe1\xcc9S\xe6d\xcc\x18P%\x04\x17 \xa07jD\x986;&r\x16\xd4\x940\xf4\xde\xc5\xd4o^\xab\x84\xe7\x8b\x0c\x98\xb6`#\xa4@\xf3\x8cA\x1ae\xe7M\xcd\x08\xde\xf89\xfeg\xa4\x8ap:\xc9\x01g\xb2R\xd0\x04\xd6%\xb0x\xbbF5\xa3\x14BY\x0dg)\x848;\xa3\xaf\xb4\xb2\x95\"\xd8\xcd\x18\xe0Z\x95?U.lp\x01\xb2\xc7y\xb4OR&Xq\x91\"-h\xa5\x10\xa32\x06\x86m\xf5}Z\x02*}fY\x81\x97N\n\xb4\x1ah\xea$Z\xdd\xc9\xd3\xa9\x93R\xbe\xbc\xc0\xbfvt\x1a\x81\xc7\x85\xe0n\x90\x1c|Dg\xe5_\x02\xc2l9\xfbl\x8a\x8fp\xd87\xfc\x11\x0d\xc4\xa4\x06\x83\xe0UP\xc6\xa0\x81\xa8&\x84\x98\x02\x8di\x8fM\xe3hT@)\xb4J\xaa\xb4\xe9\xa4\xa0u\x86\x9cG\xecj\xb0\xb9\xfe^\x14\xfd\xb0\x91\xd1\xbd\x1b>pL\x9cmC\xbai\xc1_I\xb7|*\x83g\xa6\xc0\xccz5c\xe6\x18\xb4\x92\xa92\xa9\xee\x9dmZH\xae\xdf,}\x9e\xfd\xf5A_\xb2\x93\xf3\xceN\x06\xc5\x19k\xb3\x98\x9b	\xd1X\xb5\x16\x86A\xb9F _.\x11\xc8gf2j\xce\x0c\x83\xd2hp\xfa+S\x0c\xb2(\x0f\xde\xb4y\x0f\xa4\xb8t2o\x95?,\x08\xaf\x01\xaf\xcf\xfe\xe3G48&\xa0\xeb?@69\xa0u\xe6\xf5\x99\x82[\xf7\x14\x81\x98\xb8-\x18\x10\xe8\x1a\x17\xf5\n\xef\xb5#\xf7\xaf\xe9\xe6?\xdf\xdf?\xa4\xfc\xb8\xda\x0f\xf5C\x08Y\xd9\xd7(80\xef\xc8`@}(\xdf\xb9\x1c\x05\x8b\x16\xf5\xda\xb4u\xcbh\x9d\xf3][h\x16\xfcl.{\xa7U\xbf=kV\xfd\xf6\x90\x14\x8d\xda4\xb9\xa6\xe5(\xae\x19\xef\xde\xffIc\xe53\xae\x86\x89\x0c\xf6\xe9\xcb\xe3^\xbc\x9fwcZ\xb6u8\n\x1eDmq#\x9d\x96\xbd\x9a\xf1n]\x90\xe6%\xed\x85rxjz\xf4\x1d\x98'\xcdS\x8f#uO\x8e~\xd5\x9b1\xdc\xcdz\x0b\x8a\xbf\xdb\xbdR\x8f\xd5\x94(\x99\xfb<\x8cw\x9b\n\x89b\xfdo\xe5\xc0\x85\xfc\xe4\x1c^\x13)\xca\xe7\x9f\xd7\xe6\xf0\xb8\xfc\x0e\x00\x00\xff\xffPK\x07\x08\xa6\x97\x9a\xaeJ\x02\x00\x00a\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x16\xa9HP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00migrations/007_thread_reading.sqlUT\x05\x00\x01]#?^\xacU\xcdn\xe38\x0c>KO\xc1-R\xd8.\x12\xa3=\xe4Ro\xf7\xb6X\xec\x1ev\x0e\xf3\x00\x86l1\x8e\nY\xd2H\xf24E\xdbw\x1f\xe8\xc7N\xda\xb4\x9d9\xcc)\x16\xf9\x89\x14?~d6\x1b\xf8[\xf5\x9a#\xf8\xbdE\xc6\xa1\xd7r\x1a\x15\x08\xe558o\xa7\xde\xd3\xde\"\xf3\x08\xda\x82E#Y\x8f\xb0\x9bT\xef\x85V\x80\xf1n\xe9\xf3m\xb7\x06\xc3\x06\x84N\x0cB\xf9\x8aZ\xf4\x93U\x0e\xee\x9dV\x1d\x95L\x0dSp\x1bi\x06\xf7M\x82\xf3\xac\x93\x08\x86Y&%Jpl\x87!\xa9\xe8=e\x0eV+\xda\xe1 \x14%)N\n\xd3v\x93\x90\xbc\xd5\xdd=\xf6\xbe\xa4\x84\x14\x82\x17k\xf0\xb5\xe0\xebp2\xda\xf9\xb6\xd7\x93\xf2\xc5\x1a\x8e\x872\x00\xaa\x88\x10#\x1bp\x81\x84\x10\xc4\xa1\xc4\xdeCB^U\xc1\xb4\xb3z\x8c\xf7\x1d\x98p~\xd8\xa3E0u\xe6\xe9.f\x04\xa68\x98:F\x04\xe1@i\x0fj\x92\x92\x12\x92r\x05:\x8a\xc4J<'.y\xabUx\xb3n'%\x0e\xa5\xaf\x8f\xe6t\xad\x9bFs\x8eZ\xac	\xe4\xa6\xc8A,>\x7fG\xbbg\x83\x8b\xc6\xf0AI\xd5PT\xbc\xa1\xabUC\xe9f\x03\xff\xe0\xdc.\xf8\xef\xeb\x97\xff\x83)<\xefv6\xc6\x0ez\x0d;\xf4\xfd\xbe\x0en\xf2\xef\x0e67\xebdA\x07\x929\x1faG\xef\xf6\x8dw\x9b\xa8\xab?\x13\xcf\x80\xbeM9K\xc1\xb3f~\xb7\x808\xf6\x92Y\xa4dd\x87\xf6$tCI.7+\xf7\xd2\xea\x07\xffh\xb0\xa1\x94p\xe6YJ\xd9P\x92$\x90OY\x8fY.#;\x94\xa6\x0eQ\xab40s\x12z&\x9f3\xf5\x1c\x8b\xaf\x05o(\x11\xbb\xe5v\x14\xd2$e -\x9e\xff:\xba\xfc\x1e\x15%\xf3@\x04TC	*\x0eb\x97\x82D\xd4\x1dlnfh6\xcc\x11N\xe0K\x1d\xf3\x14'\xf2s-\x81\x84\xb9\x8e\xcc\x11\xf8\xa5\x92(\xfew\xab\x88\xe4\xcd\x15|\xfa\\Jz\xe6\x10\x12a\x0f{T\xb0\xd9\xce7b\x94\xbb\x14\xec\xf9\x19\x8a\xa7\x8b\x00\xbb\xb8\xbd~)\x1a\xba\xcck|h$9LhZ\x0fl\x18\xca\\\x901\x15h\xcb\xd1B\xf7\x08\xc6\x84\x05\xb0Lv\x1c\xfb9\xceU<\xbc\x9d\xf8\xa5i\xe7\xa5\xd2\xe8\x9fT\x901\x93\x92~\x10,%y\xed8O\xf3\x13q$\x0c\xc9\x9b\x86\xc3\x1f\xef\xfa\x8fu\x06\x0cG\xd7'\xbb\x14\xa3\xf0\xb0\x8d\x87\nZ\x1a\x7f\x8c	m\x90.\x08u\xd6\xcc\x9fp=\x93O,\x13\x0e\x01\x0f=\x9a8\xa9\x85P\xdf\x99\x14y7\xa8i\xec\xd0\xc2e\xdek\xa1\x1f\xc7\x96\xfejkN;\xf3\xaa1g+\xb7\xfc\x88\x98\xbc|\xb3\xc0O\\Q\xc54\xb1\xf2n\xfb\xb2\x08\x83\xfc\x1a:k-\xbd\xd1\xa1/\x83a\x0d\xc5S|\xcaK\xfe\x1bqU(/+9 N\xd6\xea\x8f\x00\x00\x00\xff\xffPK\x07\x08>\xe6\xe0+\xd4\x02\x00\x00F\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00.\x8f\x8cP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00migrations/008_thread_add_last_page.sqlUT\x05\x00\x01\x89V\x93^\xacU\xcdn\xc36\x0c>KO\xc1\x15)\xec\x14\x89\xd1\x1er\xa9\xd7\xdd\x86a;l\x87=\x80![\x8c\xa3B\x964I^S\xb4}\xf7A\x7fN\xda\xb4\xdd\x0e;\xc5$?\x91\xe2\xc7O\x0c\xddn\xe1g5h\x8e\xe0\x0f\x16\x19\x87A\xcbyR \x94\xd7\xe0\xbc\x9d\x07O\x07\x8b\xcc#h\x0b\x16\x8dd\x03\xc2~V\x83\x17Z\x01\xc6\xb3\xb5\xcf\xa7\xdd\x06\x0c\x1b\x11z1\n\xe57 \x99\xf3\xdd\x99gM-\xfa\xd9*\x07\x8fN\xab\x9eJ\xa6\xc69\x84\x8d4\xa3\xfbK\x82\xf3\xac\x97\x08\x86Y&%Jpl\x8f\xe1\x1ab\xf0\x949X\xadh\x8f\xa3P\x94\xa4<)M\xd7\xcfB\xf2N\xf7\x8f8\xf8\x9a\x12R	^m\xc07\x82o\x82e\xb4\xf3\xdd\xa0g\xe5\xab\x0d\x9c\x8c:\x00\xd6\x11!&6\xe2\x02	)\x88C\x89\x83\x87\x84\xbcY\x07\xd7\xde\xea)\x9ew`\x82\xfdt@\x8b`\x9a\xcc\xdcC\xac\x08Lq0M\xcc\x08\xc2\x81\xd2\x1e\xd4,%%$\xd5\ntT\x89\xa7h/\x1cUg|\xc5H\xe2\x9dwZ\x85nt7+q\xac}sr\xa7\x84\xfd<\x99K\xd4\xe2M 7Gv\"-\xf9;\xfa=\x1b]t\x86\x0fJ\xd6-E\xc5[\xbaZ\xb5\x94r\xab\xcd\xe5\xa8\xcb\xa0\xf3D[\x1a4\xf4\x0b\x16	\xc0o\x7f\xfe\xf1{p\x856\xee\x8b3j\xc0k\xd8\xa3\x1f\x0eM\x08\x93_\xf7\xb0\xbd\xdb$\x0f\xba\xd8z\x84\x9d\xa2\xbb\x0f\xd1]\"\xbf\xf9N\x90#\xfa.\xd5\xac\x05_t\xf8\xffJ\x90\xe3 \x99EJ&v<WwKIn7\x93tm\xf5\x93\x7f6\xd8RJ8\xf3,\x95l)I\"\xcaVVt\x16\xdc\xc4\x8e\xb5iB\xd6uz\x84\xa5\x08\xbd\x10\xe0\x85\xfeN\xcd7\x82\xb7\x94\x88\xfdr:Jq\x962\x90\x16\xed\x9fN!\x7f@EIyR\x01\xd5R\x82\x8a\x83\xd8\xa7$\x11\xf5\x00\xdb\xbb\x02\xcd\x8e\x92\xe1\x0c\xbe\xf4Q\xe4\x92\x95\xbe`sW\x81\x8e\xd2Qf\x0b\xfc\xd2S|H\x9f\xf6\x13i,\xbd|{qJ\x06\xe6\x10\x12uO\x07T\xb0\xdd\x95\x131\xcbCJ\xf6\xfa\n\xd5\xcbU\x80]\xdd\xdf\xbeU-]\xde~\xbch\xa4;\xbc\xf6\xb4j\xd88\xd6\xb95c\xd6\xa0-G\x0b\xfd3\x18\x13\x96\xc9\xb2%\xe2\n)yn\xa2\xf1q{,\xe3\xbbl\x95\xc6\xf8\xac\x82\xa0\x99\x94\xf4\x8bd\xa9\xc8\xfb\xc0e\x99\x7f\x91I\xc2\x90\xbc\xb58\xfc\xf0i\xfc\xd4g\xc0ptC\xf2K1	\x0f\xbbh\xac\xa1\xa3\xf1\xc7\x980\x06\xe9\x82d\x8bz~\x84\xdbB>\xb1L8\x04<\x0eh\xe2\x9b\xad\x84\xfa\x9bI\x91\xb7\x84\x9a\xa7\x1e-\\\xe7\x1d\x19\xe6q\x1a\xe9\x7f\x1d\xcd\xf9d\xde\x0d\xe6b}\xd7_\x11\x93\x17y\x96\xfaY(\xaa\x98&V>\x1d_\x16a\x90_K\x8b\xd6\xd2\x1d\x1d\xfa:86P\xbd\xc4\xab\xbc\xe5\xbf$\x17\x16iQr@\x9c-\xe2\x7f\x02\x00\x00\xff\xffPK\x07\x08\x81!\xcb\x17\xe9\x02\x00\x00\xa5\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xbdb\xb8P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00migrations/009_validate_tags.sqlUT\x05\x00\x01\xe6f\xca^|\x93\xc1\x8e\xd40\x0c\x86\xcf\xf1Sx\xa5A\x9d\xa2=\xb0p\xa3\x9a'Ah\xe4I\xdd6\xc2\xe3\x14\xc7\xdd]\xde\x1e%e\xa4E\xcbp\x89Z\xdb\xff\xef\xcf\xad\x13\x8d\xc9\x19\xb3\xa1\xf1*\x14\x19\xa7M\xa3\xa7\xac\xf8L\x92Fr>;\xcd\xe5X\x0f|&\x8b\x0b\xd9\xf1\xf3\xa7\xfe\xdb\xf7\x1e\x8c}3-x\xc9Y@H\xe7\x8df\xc6U\xd6\xb9\xfc\x14L\xd7\xeb\xe6t\x11\xc6\x95\x8cDX\xb0\xd0\xc4X\xdcRt\xa0\x82\x87\x03\x8c\x1c\x85\x8c!8\xcdo\xed\x07\x08\xc2\x8a\xe5J\"I}\x80\x0b\xcfI!L\xd9\x98\xe2\x82\xb5<)\x92\x19\xfd\xaa/\x05\x82\xe4\xbcB\x08iB\xc9/l\x95\xb8\xc7\x87S+\xf5\x85\x15B\x08F\xa90\xf2k\xe4\xb5\xcd\xd8\xd5\xa4f\xdf%\x91\n\x7f\xc5\x0f\xddc\xd5\x0c\x10\x02\xeb\x88i\x1av\xd7Zz\xc2\xae\xbbo\xc6\xd7\xd5\x1bM\xf7\x97\xb8>T\xb8\x01\xf6\xa1N;\xf6YXg_\xda\x97}\xc4\xa7:r\x9av\x18V\xbc\xb0\xbf0+>!\xe9\x88_nM\xdf\xf5L\xda\xfeS\xa3+\xdc\xb4\xb3/\xfb\x14\xc2\xfa\xa7}\xe3H\x13\x84p\xac\xe4\x85\x85\xa3c\xcc\x9b\xfa\xf1c_C\x93\xe5+\xb6\xe4-;\xa6\xe2I\xa3\xe3k\x8b\xb6\x82M\x95\x8b7\xe4~\x8f\xf7x\x86\x10\xaa\xc5\xc3\xa96\x84p\x8f\xf4F\x18\xb3:%-8n\xab\xa4H\xce\xa5{\x83	a_+t\xdbx\x00\xd6q\x80\xc3a\x00 q6\xdc7\xca\x17c\x1a\x0b\x8e\x96\xd7jX\xdc(\xa9\xdf\xe2me\xcfq\xe1\xf8\xe3\xdfB\xa0q\xfc\xbf\x0e\xda\x89\xc7\xf7\xb7\xa0\xef\x07\xf8\x1d\x00\x00\xff\xffPK\x07\x08\x16\xbb\xee\xd9\x9f\x01\x00\x007\x03\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00T\xba\x95O]12\x95\xaeZ\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01Q\xa8\xfe]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00{	5P\x04\xd7\xecx\xa6\x02\x00\x00\x8f\x04\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf7Z\x00\x00migrations/001_init_main.sqlUT\x05\x00\x01\xdbO&^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00}\xb0GP\xd5\xc6\x033f\x01\x00\x00\xe4\x02\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf0]\x00\x00migrations/002_images.sqlUT\x05\x00\x01\xce\xde=^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfa\x99\xb5P\x07\xe1\x0b\xce^\x03\x00\x00\x16	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa6_\x00\x00migrations/003_threads.sqlUT\x05\x00\x01h\xd3\xc6^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00qU\xa2P\xd1\xe6\xed\xc5\xd9\x00\x00\x00\xcd\x01\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Uc\x00\x00migrations/004_antispam.sqlUT\x05\x00\x01\xd7N\xad^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00/\x8d+P\x1d\xd4\x12-|\x00\x00\x00\x9b\x00\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x80d\x00\x00migrations/005_feeds.sqlUT\x05\x00\x01\xca\x08\x1a^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfa\x99\xb5P\xa6\x97\x9a\xaeJ\x02\x00\x00a\x05\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Ke\x00\x00migrations/006_image_insertion.sqlUT\x05\x00\x01h\xd3\xc6^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x16\xa9HP>\xe6\xe0+\xd4\x02\x00\x00F\x07\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xeeg\x00\x00migrations/007_thread_reading.sqlUT\x05\x00\x01]#?^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00.\x8f\x8cP\x81!\xcb\x17\xe9\x02\x00\x00\xa5\x07\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1ak\x00\x00migrations/008_thread_add_last_page.sqlUT\x05\x00\x01\x89V\x93^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xbdb\xb8P\x16\xbb\xee\xd9\x9f\x01\x00\x007\x03\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81an\x00\x00migrations/009_validate_tags.sqlUT\x05\x00\x01\xe6f\xca^PK\x05\x06\x00\x00\x00\x00\n\x00\n\x00D\x03\x00\x00Wp\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01\xda\"\xee^\x00\x8f^p\xa1GIF89a\xe0\x00\x80\x00\xd5+\x000\x00 \xf8\xf8\xf8\x80\xa0\xa8\xe0Px\xa8\xa8\xd0` @\xa88`\x88 0\xf0\xe0\xd8`\x10(\xd8\xb8P`p\x80\x10\x100 (8@\x00(XXhHP`\xd8\xa0\x98`h\xa8 (H\x10HX\x90p(\xa8`h\x00\x00\x00\xd8\xb0\xc8\xa8x\x98\x18h\x80\xe0\xe8\xe8\xf8\xc8\xf0\xe0x\xa8\xf8\xa8\xb0\xf8`\xa8\xd88p\xa8\xa8\xf8\xb0Hp\xc8XP\xa8\x1880Hp((P\xf0\xb0PX8\x18@P\xb0 0\x80\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00!\xff\x0bXMP DataXMP<?xpacket begin=\"\xef\xbb\xbf\" id=\"W5M0MpCehiHzreSzNTczkc9d\"?> <x:xmpmeta xmlns:x=\"adobe:ns:meta/\" x:xmptk=\"Adobe XMP Core 5.0-c060 61.134777, 2010/02/12-17:32:00        \"> <rdf:RDF xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\"> <rdf:Description rdf:about=\"\" xmlns:xmpMM=\"http://ns.adobe.com/xap/1.0/mm/\" xmlns:stRef=\"http://ns.adobe.com/xap/1.0/sType/ResourceRef#\" xmlns:xmp=\"http://ns.adobe.com/xap/1.0/\" xmpMM:DocumentID=\"xmp.did:CB3790B2E86211E4969693F512777AEC\" xmpMM:InstanceID=\"xmp.iid:CB3790B1E86211E4969693F512777AEC\" xmp:CreatorTool=\"Adobe Photoshop CS5 Windows\"> <xmpMM:DerivedFrom stRef:instanceID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\" stRef:documentID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\"/> </rdf:Description> </rdf:RDF> </x:xmpmeta> <?xpacket end=\"r\"?>\x01\xff\xfe\xfd\xfc\xfb\xfa\xf9\xf8\xf7\xf6\xf5\xf4\xf3\xf2\xf1\xf0\xef\xee\xed\xec\xeb\xea\xe9\xe8\xe7\xe6\xe5\xe4\xe3\xe2\xe1\xe0\xdf\xde\xdd\xdc\xdb\xda\xd9\xd8\xd7\xd6\xd5\xd4\xd3\xd2\xd1\xd0\xcf\xce\xcd\xcc\xcb\xca\xc9\xc8\xc7\xc6\xc5\xc4\xc3\xc2\xc1\xc0\xbf\xbe\xbd\xbc\xbb\xba\xb9\xb8\xb7\xb6\xb5\xb4\xb3\xb2\xb1\xb0\xaf\xae\xad\xac\xab\xaa\xa9\xa8\xa7\xa6\xa5\xa4\xa3\xa2\xa1\xa0\x9f\x9e\x9d\x9c\x9b\x9a\x99\x98\x97\x96\x95\x94\x93\x92\x91\x90\x8f\x8e\x8d\x8c\x8b\x8a\x89\x88\x87\x86\x85\x84\x83\x82\x81\x80\x7f~}|{zyxwvutsrqponmlkjihgfedcba`_^]\\[ZYXWVUTSRQPONMLKJIHGFEDCBA@?>=<;:9876543210/.-,+*)('&%$#\"! \x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\x17\x16\x15\x14\x13\x12\x11\x10\x0f\x0e\x0d\x0c\x0b\n	\x08\x07\x06\x05\x04\x03\x02\x01\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9af\x00\x9d\x9e\x9b\xa0`\x9e\x05\xa4\xa5\x9f\xa1\xa8V\x9d\x05\x06\x03\xae\xae\x06\xa5\x05\x9d\xa9\xb5O\xab\xad\xaf\xba\xb0\xa6\x00\xb6\xbfH\x00\xac\xbb\xc4\xaf\xb1\xa4\xbe\xc0\xca+\x00\xb9\xc5\xcf\xc7\xb3\xcb\xbf\xcd\xcf\xd6\xbc\xb3\x9e\xc9\xd3\x9a\xd5\xd7\xb0\xd1\xb2\x00\x0c\x13\xe5\x0c\xb4\xdc\x98\x00\xdf\x03\xe1\xb2\xb3\xe4\xe5\xf2\xe7\xda\xe9\x92\xde\xd6\xef\xef\xe3\xf2\xfd\x0c\xff\xff\xb6\xd9s\xb4\xee\x9a\xbe^\xe4\xfe\xcd\xd3\xc6\x10\xdd\xc0D\xc2\x0c\x962@\xd1@\xa7x\x00\x03\n\x93U\x91b6\x81\x0f\x07\xe1#6\xb1\xe2Es\x19;9\xc3\xe6\xcc\xa3\xc3\x90\x81\n\x16#\xd5\xd1\"\xc6\x8c\xe7V\x12\xa3\xa8\xcb%H\xff\x98{\"\x16\x8bU3\xd68\x9c\xe7\xd8\xc1\xea	\xeb'P<#u\xb1\xaai\n\xa7L\xa5\xbbrY|\xca\xe7j\xcf\xa9\x15\xc5\x1d\xed\xe4\xca\x03Vk[\xb9\xe6\xf1j\x8c\xaa)V*\xcb\x9e\xbd\x96\x16X\xbd\xb5\x0d\xc9\xf6tkJ\xa7\x87\xbf\xbb\xcc\x0e\x98\xa8T\x1a5\x05\n*T8%\xc7\x13\x84\x05\x90#/\x80\xe0	\\Xq\x05\x02\xff\xdd,\xb7]\xe6c:w:\x05\x05\x00q\x05\xc4\x89\x19\xb3\xe9\xf4X\x80\xeb\xd7\xb0\x05L\xf6\xd4q\x9f_]\x9b\x05\x0f\xc3\xf6\xcd\xb0-O\xa7\x13\x9bV\xcd	\xc0\x82\xd8\xc8\x91\xcfn\xa6o\xa4\xe0\xc0\xbc\xe66\x9d\x06\x1c\xf5\xf0\x97b\x8c'\xdf\x9e|\xc1\xaa\xb7<\xaf\xe9\x0e\xcd\xae.\xf5N\xc1Q/\xc6\xde\x05\x00\xf7\xf7\xdd7\xce\xfa\x8c5\xf3\x00\xc0;Ie\xf5\x9d\xae\xba\xf5\xf5\xa3\xa9\"\xc0\x06\x1b\xc0g\xe0k\x1byC\xde~\xafp\xb6\x12Q\xf7\x99EQ\x80\xcb\xf8\xa7\x1eqU\xb8G\xe0\x81\x1c\n \xff\x0c>\x0b\xbe\xb2\xdbW\x11\xb6#\x148\x14rc\xe1u).a\xdc\x86\x1dr\x18W[\xf9\x84v\xe2`z\xb53\xa1ZC\xac\x98\x1a{MhX`\x8c2\x86\x06\xe1W:Y\xd4\x12m\x96\xb5\xf8\x90\x8f\x00\xde\xb2\x00\x81C\x12i\xa0w\xd0\xb83\xa2+3\x1a\xb5\x8a\x8e;\xf2x\x04\x94\x18\x1a!d\x95V*\x07\x01\x04\xaeE5\xd4T=\xc55\xa1\x05\xb4\x11e\x9e\x98f\xa2g\xdd\x8f\xa3iW \x8ci&\xc7\xa6\x00\x94\x95\x07\xe7+\x00\xd0i\x92\x05t\xaa\xa4$\x7fx\x06\xa3\xe7\x7f\xc4\xb9\x07\x1b\xa0iZ\xea\x1ad\xb2\xb9\xb9_x&^p\xc1\x84\x11D\xd0(s\xcd8\x19\xa9\x10d\xd2\x02\xc0\xa0\xc7\xbd\x86i\x8c\x9d\xc0\xc6\xe6l!\x9a\x08\xea,t\x8aZ\xaa\xa9\xdf\xad\xa2\xea\xaa=Nz\xa1q\x9c\"7\xebv\x83\xb6\xa9\xdd\xa6\x91	\x03jV\xcd\xf0d\x11\xa3\x9dDpA\xa9\xa3\xf8\x02$\xb1A\x1a\xfbjd\xdcQ\xf9Z\xb3\x9b6\xeb\xff]\xad\xe7B\xb6QG\xd4Vd\"\xb6\xbfn+,\xab\xe0^\x01\x1c\xb2\xb1\x1e8Yl\xaf\"\xd8h\xbf\x8fy\xe7\x11X5I\xbb\xa8\xa8\xdb\xfez\xca\xb0\xf9\xbaXp\xbf\x06\xb6\x06\xe3\xb3\xde\x01\xdbl\xc1\xd5\x16e\x92\xa3FY\xc0\xf0\xb6\x08D\x00q\xc4N\xf0\x1bc\xb3\x04z'\xaaw\x16pk\xe9d\xeezlR\xc9\n\x03\xa0\xed\x05\x010\x8c\x00\x02'\xa3,\xb1\xbb\x1d\xfek+\xb2\xbe\xca,\x1b\xcd\xeb\xdal\x11\x02\xd8\x92z\x01\x01=\x8b\xfas\xd0B'\x81\xac\xa6\x9b\xbe\xd7\x1al\xaf\xae\xa9\xf3\x058\xf3\xfb\x18e\xcc\xd8\x0c\x00\xd4t\xc2\xa5-\x01\x05\xf0\x1c\xc1\xd5\xe0\xe6\x85u\x8fbk\x9a\xecv4\xc3\xe6\x1de:\xcfm/\xbf\xc6m\xa3pGk3j\xf2,\xa5\x060\x00\xcf?\x03\xbdj'\x12P\x1d\xc0\xe5\x04H\xd0\x10\x14\xda\xc4J.wkR\x0c\xf8\xc8s\x9b\x8c,eh\x17\x8b\xb0\xceP\xcb<\xb7\xe3dG~\xf7o\x96_n\xff\xfb\xed\x98k~\x17\x13\xda}\xcel\xe8`\xef<2\xd4\x9d,W\xa9|\x8c\xff\xcc\xed\xdc\x08\x0c\x16\xb9\xe4b\x02\x80\xfb\xf4\xd4\xe7\xbe\xbb\x99\x13\xef\x8d\x1c\xe0\xda\x8fM\xb5\xdc@\xb3\xe6\x899\x0c\xb1\x1al\xe4\xdc\xfeL\xca\xf3\xb3\xd7\"}\xf5\xf0\xe3\x9e9\x86\xfcj\x1f\x1be&\xef\xed\x1d\x02<\xf7\x1c\xc0\xd5\xaf\xd2\x06\xf90\x80\x81\x0cd\xc0nk{\x9e\x02e7\xb9\xf89\xd0v\x04\x98\x1f:\xc6\xe5\x1d\xfb\xbdf6@\xdb\x1be\xf8W5\xd9yBv\x01\xc1\xc0\xed0\x90@\x00H \x01	H\xe0\x02\xd97\xb9\xda=0~\x11\x94`\xd8\x8aG\xb1\x0b\x8a-\x83\xfdb\xdd\xf7b\x074\xc4x\x82[\xe7\x10\xa1\xedH\xf83:\x11 \x01\x9ac\x9e\xf2\x18\xa5\xbc\xf6\xfd\xe6\x85\xd3\x8b`\x14#\xa8;O\xf4\xeb\x8a\x93\xc1\x1f\xb26\x95\xc0\xcc\xf10|\xe9iH\x06\x08H\xc0\x04\x1a\x11\x89)L\x94\xa9\x14\xd7\xa8\xbaA\x91z1ta\x1c#\xd8;\x8ce\xff\x912tJ\xd6\x06\x11\x10\x00	|\xd1t\xc6b\xd1\x01\x7fe\xc4\x13\x12\xc0[\x9b\xa3\x82\x13\x1b\x01\x00\x17\xbe\x11\x82s\x8c\xe4\xd6z\x07\x995\xe5-Y\xac\xe3c	J\xa0@\xf9\xb4*QgL\xc0!\xdb\x13\x80om\xe2}\x8f\x9cb$\xe9\xe8\x9dCJ\xc6\x92\x96\xdc\xe2\xfe\"g5\xf6!0=,B\xe1(\xb9\x80\xca]\xba/\x95\xf0[%\x1dY\x19\x19X\x8a\xad\x98*\\!\xd0P\xc8\xccf~\xb2=\x0fp\xa1)/\x81J`VO\x98\x87,\x981\x8f\xe9.%*\x13\x00\x07\x08\xa78\x9b\x89\xc2gb\x01\x00\x0fH\xe7\x03n\xe7K\xd28\xd2\x9a\xaa\x9c\xe3\xd6\xb6y\xbad2\xf1y&\x13\xa7>\x0f@\xcer\x06\x92O\x19R\xa7:\xa5\xb9\xc8B4\x12\x9e\x0f\x94$e\xb2	\xcb\xad)\x91\x90\xe8\x03\xe7>\xc3\xd9\xcff\x8a\xc0\x9c\x9c\x13\xa8@\xd9YPBT\x13\xa1\xc1\x8cc'\xe8\x18K\xe3\x00\x8eu\xbf*U\xa3&J\xd1\x8a&@\x04\xff\x1d\xe8\xc0E\x99\xf1\xcf(\xa5\x0c\x00\x0d\xd0h:	z\xcaw\x824\x9e\x87\x8ca\x00\xb3\xd9\xc8CfK\xa5\x9d`iKQ\xc8O\x14\xc2\xf4\xa2\x9d\x98\xa9\xf9\x00\x80K\x80\xba\xc8\x139\xd5)G\xbb\x11\xc7\x9f\x86t\x95E5*\x1d\xc3\xa9\x0d\xa56U\x9f\xcc\x84i'@\xc0V\xf6|2\x914\xd5FV\x05\xcaSu\x08\xd3\xab\xf2\xc3fP\xc7j\xd6\xbe\xa6\xb5\x03\x00\x00\xc1\x07\x06\xfb\x01\x10\x04hE\x8aIl\x05P\x80\x82\x87y\x02\x85sU\xe7V\xa9\xa9\xd7\xbbz\x15\x9b\x12\xed+K\x9d*\xd3\xc0\x82\x80\x04p\x95\x14U\xad\xe3\x00f:\xc0\x01\x8c},Z\x13\xa0\xd3\xbaVBz\x95\xf5\xe9e%\xd9\x81\x04hv\x9f	\xe8\x00\x078\x00X\x12\x80\x16\x94cq\x92'P\xe3\x00}\x9ev\xb1\x00\xb0-n#\x9b\xce\xc9R\xe2\xa0\xab\xc4\xab\x03\xe7\xa8\xb9\xdd\xd6V\xb9f\xcd-ow\x0b\x80\xdf\xc6\xccd\xfe\x98&\x11J\x83\x98\xd2R\xf4\xb8\x8b\xd9\xffl\x02\x98\xeb\xda{XN\x8a\xd2M\xa8H\x01\xa0\xdb\xdd\xf2\xd6\xa5/\xad\xafn=\xf1]`\xf5\x83|)S\xcfi\x13pZ\x07(&\xb3\xb8e\xadF\x9d\xeb\xde\xf8B\x91\xba\x88\x84iLc*\x82\n\xc3\xd4\xba\xf6\x05l\x7f\x815\xbe\xff\x0ek\xb4\xa6)\xf0iS\x93]\xe6\xe2N\xbc\x109\xa8\x83\xaf)\xc9\xf1\xd2\xd7\xbe\x18\xce\xb0u\xedf\xc0\x03\xc6\xa3\x1f\x1f\x06\xf1\x9e\x86\x93\xdd\xf5\xd2\x95\xc1\x91\xe8\xc4\x8a\xd9)L\xa7tB\xbf0\xa6\xb0j\x000F2\x1e\xc5\xc3Q@\x0f\x80\x18b^\xa5\xa2p\xc1\xb7\xebhWV\\Y\nyB\xc2J\x06\xae\xf9\x12\xd0\xe42*d!S\xf0\x84\x88\xd7<`+G\xb6\xbdA\xc6kl\xdb\xa9\x84\x860\x8aN4m\xa6\x10\x03@B\xab\xa8\x82\xcd\x80\xae\xf2j#\x0bdH|\x14\x98\xb1\xad\"\xef\x00\xd2\x89;7\x8a\x9c\x06\xccrJ\xa2\\>\x00\x04:\xd0\xd8m\xe9\x8fm\x87\xe2C\xa8\xd8\x9a\x95\xd5\x9d	\xffF\x9d\xa2\xa30Z\xb5\xe2$ \x99ow\xc0q\x04\x0d\xa7\x0d\x88u\x03:q\xe9Z\x0b\xda\xc7\x92\x85\xa0\x96\xa1\"\xdb\xe9bS\xd4\xa3\x0e\xb6\x97S\xc2\x00f\x8a\xf3rMM\xc0\x08g\x07kY;;\xd6\xb6\xc6tS\xe7\ngF\x14u\xbay]%\xb0\xe5h\x82>Y\xa5\xd8\xcaE\xf6R	\xd8jU<\xfb\xdc\xe8\x86v\xb4K\xab\xe0\xe6\xea:\xc8\xf0\x9db\xb6!\x0c\x80Q\xbfW\x1bt\xee\x91U&\x80Bq\xfb\xb3|\xfaJ\xb7\xc0\x07\xde\x80KS;\xcb\xbb\x9e\xc3\xb5\xb3=\xef\x18\xea\xaer\xbaN\x14\xc0\x8b`jFO\x80\x80\xe2,\xd39\x1d.\x01\x82{<\xdd\x05\xcej\x03\n-\x88\xbc\xc4\x10s]%2|\x8dZ9*\xf2\x97N\x9a\xd3\x1aR\x00\xc0o}&\x9c\xe2s\xe6\xf8\xc7?^m\xa8\xe4%\x04@\x0fz\x08\xc2:G\x86\x07\x80\xe5\x12H\xfa\xcb\x1b\x95\xf4\x12\x18\x19)\xe7\xa89Y\xc7@\xf4\x9c\x87\xba\xe3;'\xf9\x1b~.\xfft\xa1O\xaf\xea\xf1F9\xe6\xec\x16W\xcaEp\x93 \xb9\x08\xd4i\x9eq2\x80\xdd\xeap\xaf,\xc2\xb7\xae\x8d\xae{\xbdzC\xf7D$\xe57\xf6z'=\xe6\xe3m9\x016Y\x02:\xf6\xa8\x80\x15\xd7\xc8\x04(z\xf3<!\xd0\x13I\x8f\xbb\xe4\xef\xbd\xf5\x10<0\xefp\xad:\x1c)'\x81`w\xdbL\x84/<\xa7YEF\x1b3\x9a\x19\xe0n\xbc\"\x1f\xcfz\xbbE\x1e\xb3\xaa\xbf\xa9\xd0\xc9^\xe7\x94C\x92\xf3\x7fWz0\xde\xe9\xad2#\x9e\x1e\xc5\xea\xb4G[/\xfc0\\\xef\x16'\xe7(\xees\x0fx\x17\x8fT~\x9d\xf0=\xe2c\x9f5\xe4;\xd2\xa8&d\xfe\xdf\x11i\xbe^\x97\x12\x85M\xae\xf1\x01\xab\xbfu9B^\xfb\xdbg\xc6\x08\xf4\xee\xfd\xef\x83\xbf\x80\x06\xa4>\xf9y\xa7\xfc\xecS7\xfd\x00\xa0\xc0\xfa\xc3\n\xc3\xe4\x92\x99\xcc\xf17\x7fm\xd0K\xae\"x\x91\x04x\x00\xa0\x01#p\x02`\x07}\xfe\x97\x01\xcc4~\x02\xb8\x06\xff\xef\x83}f\xa7m\xae\x92\x80\x1a@\x01\x0c\x08{Iu\x00\x10\xe8O\x13H\x81\x0c\xb1\x7f\xaf7_\xda@\x01\x1a\xb0\x82\n\xd8\x81(\xc8*\xe1\x84\x01\xcc$\x7f#\x982#\xb0~'(A\x14\xa0\x82+\xc8\x83=\xe8\x82K\x16\x83ME\x835\x18$\xfa\x87\x83\xdb\xe7-;\xc8\x82>\xb8\x817\xe8VB\xc8ODX\x84\xbc\xb3\x83O\xc8\x18\xf9\xc7\x83K\xc8\x84\xfa\xf7\x13\xc9\x15\x85SH\x85u\xb6\x83Vx\x83\xeb\xd7#>H\x86dh\x86^\x18\x85)$\x86[\xa7\x86k\x98\x0c\xf9\xc7\x82\x1b\x88@\x14\xa7\\\x180up\xe8\x06Y\xa8\x86g\xc8*L(|\xe3\x00\x82m\xd7\x87\xab!\x87\x14\xf0\x12\x1aX|\xa8\xc7\x00\x19p\x88\x88\xa8\x06\x7f\xb8\x83^\xe8\x88S\xc5\x00\xe3\x14\x86\x93\xc8*\x8axs\x85(\x85\x9d\x88\x06\x95H\x86\xa0xz\xa3\xc8	\x8ah\x89^p|\xa9h|\x9d\xf0\x89\xaf\xa8\x08\xb1h\x8a\xb3\xb8\x08\x13w\x8b\xba\xb8\x8b\xbc\xd8\x8b\x11\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6\xe8\x07A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9f]\x00\xa2\xa2\xa0\xa5O\xa3\xa8\xa4B\x00\x06\x06\x05\xaa\xa6\xb1D\xa3\x05\xad\xb6\xad\xaf\xa4\xac\xb8\x00\xb2\xb2\xa2\xb5\x03\xc2\xc3\xc4\x03\xae\xa3\xb6\xaf\xbe\xa0\xc0\x06\xc5\xcf\xc4\xc7\xb5\xbc\xcb\x9d\x00\xc1\xd0\xcf\xb7\xae\x05\xd3\xca\xd5\x9a\xac\xd9\xc5\xdc\xdd\xdd\xb8\xe6\xdd\xbd\xe0\x97\xe2\xe3\xc6\xe9\xf1\xe7\xb9\xa3\xec\x96\xee\xd9\xe5\xf2\xe9\xa2\x0c\x13\xff\x0cR\xd5\xb3\xb7\xe8\xda\xbb}\xf2\xfa\xfd[\x08\x90\x81C\x87\xeb\x08&\xc2\xf7,\xde\xb6V\n\x192|\xf80\xa2DC\x06\xb3\x9d\xbb\x88\x11\x80\xbf\x86''\x04\x14\x88\xeac!\x8a\xc4\xa6\x914`\x12%\xc7\x95\x16o\xd1s\x19\x08\x80\xc8\xff\x99\x18\xfd\xdd\x84(\xcaY\xb4s\xc3xy\xe4\x99'$9[\xc6t\xbe\x1a\xba\xd2\xe8\xb8V\xd1\x8e-e*\x87\xa5(aP\xa3\xa23g\xb2#\xccwV\x8d\x81\xdd\xca\x95\xcd(\x08\x0b\xe2\xca]\x00aTV\x84e\xcf\xbe\x83f\x95m\xdb3\xa2\xe0\n\x18L\xb8\xb0\x00\xba\xc8\x10\xaa\xfbJ\xcc\xc3\xde\xc7\x03\xfc\xfe\x15#j\x81\xe1\xcb\x97\x11_\xdbw-\xed\x00\xc7\x90\x1fK\xce\xd4rNe\xcc\xa81/\x00\x16\x0f\xa6\x87\xd7\xc5\x1c\x97\xf3<n\xf4=\x05\xb8+\x0ct\x03\xc0r\xea\xdf\x997\xab+\x10\xfb\xb5qa\xa0\x83q\xa3\xad\xcd6%\x00\xb8\x15T\xc8\xbd;\x0d\x80\xc1\x1b6\x00\xdfNX\xb8\xeba\xc6a\xbb:J|\xaf\xf3\xe7\xa2\xa6\xe7\x96^\x9d\xcc\xf5\xec\xda\xb9\xcb\xdfL\x11tla\xd8B\x0f\xfb\xc6,\xbdz\xea\xb0\x84\xf1^v\xf2\x15(@3\xcc5\x06\x8f~\xe4\x9c\x87\xc9(\xff\x01\x18\xe0\x16\xbd\xc1\x17\x9f\x81\xdc\x19\x94`1\xf9\xe5\xd3\xff\x8d6\xfc\xfd\x92^t\xd1\xe96\xe1\x15\xef	@ \x86\xf3\x81\xf5Xy\x9f\xd9\xf7LH\xe2\xd1d\x0f\x84$J\x88E\x85\xd8]\xc8\"p\xab\xb9x\x15\x8c1\xca\x16\xa30\x8c%e\xa3D8\xe6h\xa2\x83G\\W\xd8\x8a?f\x06\x01\x04\x83\xf9\x04V\x82\x1d\xee\xd7 >\xb6@i\x8d@\x11\xea\x08\x05\x00X\x1aF\xe5\x8fR\x12\x96f]G\xa5\xe5\nmD\x1aC\x8b\x92K\x82#\x8a\x04\x04\x10 \x01\x999\xb2w\"\x12\xbd\x0d\xe6\x1bak\x1a(Ja\xbe\x05\xf9\x94E\x15e\x85\xca\x96\x18\xb1\x03\x80\x04\x98J\x10\xc0\xa6\x01\xf8\x99J\x99\x82\x8e\x86\xa6ei^\x96\xe8a\x97\x95\xbaZ\xa1\x84Y\x86\xd8A]b\x13\xa6\x05vm#f8\x99\x12\xc0)\xa7\x9e\xa2\x02\xea\x93Q\xce\xf5\x1b|\x86\x96Zlw\xa3\x14\x86e\\Z\xfe$\xa7L\xc7X@kQ\xb8\x1cC\xd0\xa5\x98\xee\xaam\xa7\x7f\xfa\xea\xe4n\xa3\xd2e\xa0`+\nv\x18\x00\xd3\x1e:\xffW\xb3 b5\xcc5\x17\\\x90K\x04\x11L\xbb\x0bMy\xde\x88\xa9\xae\xdbj\xdbk\x93%\xa2\"\xac|\xe2\xf6(\x80`\xabYP/\x9a\xad\xc2\xe5(_\xbb$\x85J\xbc\xf4.L\xed+!\xea\xabi\xbf\xfd\xfe;\"\x89\xba\xc95\xee\xa1\xc5\xc6[W\xc5\xa7\x1d\xe6p\xc4\xcd\x85\xf5J\xc5\xf4^@/*Z\xdd\xfa\xc9\xa5\x1c\xe7\xdc\xa9.\x1f\x87\xdbf\\\xbf\x15\\\xd8\xa8\x10P\x8c@\x04\x81\x15\xcb,\xb4\xd1t\xe6\x0cM0W,3*\xca\xd8\xcc\x8c\xce:\x13\xa0\n*p\x15*r\xd0\xc6fy%\x001\x1f\x9dl\\+/w\xd1+\xb7\x90\x1dA\xbc2\xa3\xbc\xc25\xbdX\xcd\x0c\xbfXs\xacuDhV\xf6uju\x85\xbd\xda\xc9\x11 \x80\xc0\xd4~\xd3\x85\x18P4\xdd\xfb\xf2\xdbp\x17\x8e\xf4\xdcv\x9b\x02@\x9fyg\xbd\xce[\x7f\xa3\x86\x18\xc9\x07\x8b\x02\xb7\xccf'\xde\xdb\xbd$\x91\xed\xb4\xdb\xf1\x06\x00\xb7\xe1\x95\xff\xd2'\xde\x99w\x8a\xff\xb7\xd6s\xcf\xdd9jc\x83N\xf6\xe8\xf1\"`/\xda}\xa3n\xab\xf0\xbb\xb0\xae\xeb\xeb\x08\xc4\x1e\xcb\xe5\xb3\xd7\xce\xeb\xec}r\x0e\xf4oc\xb3j(\x00\x87/\x7ft\xf3\xa3h\xb6\xf9L\xdc\xd3Z\x0b\xd9\xdd\x17pA\x00\x857?Y\x11\xd0G/\xbd\xed\xd4k\xbd\xbb\x95\xaa\x93\x8c\xfe\xfa\xae\x1b\x0e~`u\x9b\x05\xb40\"<\xf3\xa1/\x02\x01\x18\xc0\xfa\xfc\xe7<\xcb\xdd\x8ev\x99\xab\x9f\xfd\xaeg\xa8T\xd5eT\xad\xe2\xde\xe1\\w\x01\x06\xce\xad.\xb9\x83\x9f\xd30R8\x0b\x80\xaf}	\xec\xa0\x07\xdf7\x04\x00l\x0b\x82y\xab_\xb2Z\x05:\xba\\\x90\x82u1\xdc\xf2T\xe8\xbe\xad\xb5\x875\xeah\x1f\xec\xfc7\x80\x02\xf8\xcf},\x9c\x1b\x0c;5?\xfa\xcdNJ\xab\"\x99\x0dox(\xf4!\xc0O<D\xe2*\x16\xb2\x92\x81\xd0\xe2\x15G<b7\x8e\xd8\xc0\xab51g2\\\x95\xfdhx%*n\xcf\x7f\x01\x90@\x16\x91V7Q\x00\xff\x04\x00\x18\xc0@\x062\xe0\x15\x0d\x86\xf1\x8fe\xbc\xd9\x19\xb3\xf6\xc4\xf8\xb1\nmm\xac\xcb\xf5\xac\x88\x80\x00\x94\xa0\x04a\x04\x80n\x18\x08\x11\x0c\xec\n\x03\x1a\xbcT\x02\x12\xe0\xc7?\xae\xf0}\x97\x1b$!\xb5F\xbdCN\xb1w\xae\xea\xe4\xe1\xb2\xd8<\x05\x8cbf\x01\xb1$\xa70i8Z\x11 \x01\x7f\x12\xe2\xd1\xa4e\xb8\xc9\xb1\xd0\x85\xa2\x1ce)\x97uJT\xaeF\x97\x9elet\x04\x92\x81<\xe6Q\x83\xb6\xc4%'\xd1U/iY,\x89s\x0b&\xd6$8\xc1b*\x92Y\xc8\xf4$\xc0\x02&\n>*la}J\x00\xeeX\x82\"\xcbis\x9b2\x84K\xe0\x12\xd9\x1b\xc4\xe8\x92\x97G\xa4\xa3\xb7\xbe%\ni]n\x93\xb8\xf3\x82\x0b\x07E\x9a%\xbesz\xc3\xbc\x1c\x9a\xdaXOQ\x08\xb1b&\xec%\x006I\xd1iJ2PO\x02h \xe1\xc7\xab\x8d\xbe\xe4\xa0\xc2\xdc[\xf5\x18\xda\x9b\xba\xecMr0\x9b\xd6\x01V\xca\xd2\x8a\xee\x93\x9c\xff\x1em\xe1\x03hG\xd0{\x80\x14\x9e\xd5{\xa2I\xfbVH\xb7Y\x8c\xa5@miE-Z&`i\x01\x00\x0fH\xea\x03v\x15\xd0M\x84\xf2\xa6!\xcdi\xf5\x0c\xb9RT\x045\xa8C\xcd\xea8C\x15;\xa4*5\xa94\x8d\xe9\x1f\x80	U\xe9\x95\xb2\x94WM\xeb\x01*\nT\x8a\x8a\xa0\x03\x1d\x10\xc1Ka:\x05\xaf~5\xa9L\x15k\x1f\x9eZV\xb3J\x10\x00j\x0d\xacP\xdf\x1a\xd7Q\xc8u\x15\x1f\x03Y{\x96 \x8a\x06\xdc\x15\xac\xbb\xaai$\xf8\xda\xd7\x08\xcaP\xb0\x81M\x80\x08\xde*W\x00\x80\xe0\xb3\x13\xdaj\xa8\x9a\x80\n\xc7>6\xaf\x055he_(\xc3\x0e$\x00\xb3m\x85ka=\xfb\x81\x0f\x80\xa0\xb6\x83\x12\xed\x93\xd8\x89\xd8\xd2>6\xac\x0f\xc2\xdcjq\xda\xa7?q\x80\x03\xae}mf;p\xdc\x0e\x88\x82\x04\xb7\xadmtm+\x19\xddV\xe0\xba\xd7E\x01\n\xbc8\x8aM\x9a\xf6\xab\xa8\xbd\x87\xfc\x86\xab\xb7R2\xf7\xb8\xc8M\xffnV	{\\\x00@\xf7\xb3\xb5\xfd\x00	\xe2\x0bZ\xc6&6:\x0e\xa0\xa8\x03\x1c\xa0\xdd\xee\xb65\x01\xbf\x8d\xac^\xed\x10?\xd5\xaeV\x86\x86\x85+z\xe3\xba\xd9\xcd\x9e\x97\xb9\x9e\xbd\xedt\xe5\x9b\n\x12\x9cg\x9c\x0e\x00\xea~+\xb0]\xe5\xb6\xf5\xbbJ\x0d\xaf$\n,\\\xf2r\x13\x00\x8f,\xc1(\xce\x9b^\xf4\"\xb7\xb9\x11\x96\xaet\xfb)\x903]\x147\xf9]i\x026\xac\x9b\xb4z\xf7\xae\xc0}I\x1fE\xc1\xcd\x12[\xb6\xc8H\x9e\xdd\x9f\x1e\x897T\xbc\xd5\xc5p\xed,|\xa5kajN\xcb\n\xa2(\xd1~w\xccc\xc0\xfa\x18\xc0w\x15\xf1 \x00\x10\x822\x9b\xb9\xcc$\xe6f\x0c\x93\xcc\xe6nI\xa0\x04\x10\xdc\x9c(d\xdb\xd9\xf7\xd2\xb7\x9f(\x83\x08\x8a\xfeS\x81\xfd\xfa\x99=\x99\x05\xf1R\x05\xfc\x92\x10h\xeb\xa9l6h\xa2\x93\xdc\xad>=r[K\x19\xf2(\xce93\x868\xef\xbe\xdf\xcal\x02@L\xbb\xa6\nB\x14\x86\xe6\x14\x91\xff7\x95h\xa6.\x9a\x9b\xdd\x1a\x85\x9f\xe0\x0c\xe9$\xa0KZ\xb0\xa6\x15\xa5\x01\xa0\x91	4\xd0?^\xc9\xb1Z7\x19\xe6\xc8\x82\xc4+\xfc*\xb2\xa9OM\xbdT\xcfb\x14\xfd\xaan\xaca=iZ\xd7: Y\x18\x85\x9f\xa7=m\x0fc\x95\xd3\x84f\xc4\xa8\xd1Hl%/v\x15K\xf4\xb4\x08\xfb\xc9\xecU\xa4D#\x1b\x05\x00\xb5\xd7\xedgk\xebx\xd3\xe0\xed\xa8#\xb6\x8dPb\xa7ZT\xe1\x16\x95Y\xc0\xe5l\x8d@\xbb\xae\xa9\x98\x1b\xbb\x07\xaek\xa1~5\xc8\xda\xa6\xb7\x13\x8b|o\xd2n\xec\xd0\xb6)\xcbM\"]k[S\x01\x00\x0d\xc8x\xc6EA\xf0\x8e\x17\x1c\xde!\x96\xf7#P\x01\xc3\xe2\xd6\xd8	\x94\xdd\x19i\xa9\xb2\x95~/\xc4n\x18\xd7\xb8\xcc5\xee\xf1\x81\xbf\xf6\xc7\x90\x15\xf5\x80\xbb\xd2\xc7L]x\x14|\n\xb6d[(q\x8e\xb0\xa5&w\xbc\xf8\xcc\x97\xce\xf4\x8c\xd7|\xcb`\xc6\xab\xc8G\x8e\xadLY\xfdO(\x1f\xc1\x9eJ\x19\xff%\x96P\xe5\xdfQ\x9ax]\x9bN\xf6\xb27\x80\xe0\xdfExA\x82^l\x9f\x9fb\x04'\xb8\xd4\x13	\xe5L>~]\xcf\x84\x12\xbb\x14\xe2\x87)\xb3\xfb\xbd\xe9~6m\x03\xc4\x8c\x08\xb9\xa3z\xe8\xb3\xa0\x80\x06N\x10wLEz\x14\xcd\xcc\xa3\xdd\xef^]\xbdG!\xcd\x8c\x96\xc0\xdf\xff\xae\xf6\x97\xb0\xbd\xd8\x88\x87\x9f\xe2\x17\x1fwX\x88\xa2\xa2\x91\x7f&\xe5\x19\xbb\xef\xbdw{\xd1}\xdf<\xe1\xc7\nt	v\xeb\xf2\x00\xa0\xc0\xe8I\xafu\xcae5\xf5z,:Q\xec\x0by>\xba\xfe\xf5\xc8/\xb5\xce?\xadu\xc3\x9b\xdcf\x00\xd0\x80\x06t/}\xe97\x7f\xa2-\xdd\xe3\x1e%\xbf\xc7\xa2\x87\x1e\x00\xdb\x9f\xe5\xad$\x0d\xf4\xcf'\x7f\xd1\xa4\xde9\x18\x000\x02\xad_\xddj\xd1\xaf>\xf55\xd0~/\xafT\xf2\xa8\xd7>\x1fKC\xda<^R\xaf\xe4\x17\x80\xa9\xb0/H\x16z]A\x01\xf5\xe7vuU}\xd2\xa7{\xa3\xc0Ry\xb4VC\xa5\x7f\xff\xc6\xb7w\xfe'~{%\x80{\xa5{\x08\xe8~\x95\x13\x7f\x0ch\x12\x9bt\x00\x11(\x81\x16\x05~\xddg\x80\xf0s\x81\x01\x10|\xd8ty\x1c\xd8\x81*\x18%\x0c\xa8\x01\xfdP\x82\x12hz\xdfv\n\x14\xb8I\xea\xb7\x0c\xb9\x17\x832\x88\"!(\x82-5\x83g\xb2I\x19@Q?\xf8\x0bB\xa8{\xbd\xb7#\xf2g\x84UE\x19J\xc8\x84/HZO\x88\x80\x81\x94{\xd5\xd7\x0fUh\x85\x9b\x84\x01k\xd5\x84\x96\xf3\x84H\xc8X_h\x12ah\x85$\xa8cf\xc8\x0cB\x08\x80\xa8\xc0\x00>\xe8\x1e\xcaE\x86\x07\x10\x87r\xc8\x81M\xb8\x83\x02\xf5Zz\xb8\x87Y\xc8\x04AH\x01|\x08\x18+\x15\x00,\x95\x86mq\x88\x88\xd8\x08\xd8\xc7\x88G\x08\x88I\x04\x89\x89h\x06\x80E\x89X\x95\x89\x0f\xe2\x87\xf36\x86W\x05v\x85xl\x8e\xc8\x07\x135\x88\x07\xf0\x0f\x9eX\x8ap0Q	\xb0\x84\xac\xd8\x8a\xae\xf8\x06\xb0\x98\x00+Q\x8b\x9c\x90\x17\xba\xe8	\x1e\xa7\xd8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6x\x8c\xc8\x98\x8c\xca\xb8\x8c\xcc\xd8\x8c\xce\xf8\x8cD\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x8e\x00\x9a\x9b\x00\x98\x9ef\x9a\x05\x06\xa3\xa3\x05\x9b\x9f\xa8^\x00\xa2\x03\xad\xae\xad\x06\xa6\x9d\xa9\xb4V\x00\x06\xaf\xb9\xae\xb1\x9aD\xb3\xb5\xc0I\x00\xba\xc4\xbb\xa6B\xb7\xbf\xc1\xcb+\x9a\xc5\xcf\x03\xbc\xb7\xbc\xcc\xc1\x9a\xb8\xb9\xa4\x05\xdb\xdb\xd8\xc6\xdd\x06\xca\xd5\x9e\xd7\xba\xa4\xe7\xdc\xdc\xde\xb1\xdd\xc7\xe3\xe4\xde\xbb\xe7\xf3\xec\xe9\xd8\xec\xa3\xe2\xef\x93\xc3\xd9\xf4\xff\xf5\xd4\x95\x12\xe5n\x1f?s\xa3\x10\xfeK\xd7\x8e\xa0>\x83\x8d\xfa\xbd\x8aG\x0c C\x82\xdb\x1eBT$\x11Z\xb1\x85\x173n|t\xcb\xe3G\x80\xf58\xf5j\xc6i\xe4\xa1\x8e&a\xa1\xcc\xa7\x89\xc1\x84\x9b\x0c61\xd8\x99\xd3e\xa1\xff\x921\xe5\x85\x94\x05\xc0\xe6\xcd\xa38y\xf2\xd4\xe8s\x0fL\x8f\x01\x19\xd6DJ5\xa9\xd2\x9eM\x01%S\xb9)\xdbPYF'\\=\x9a\x93k\xcb\xacN7AX\xc0\xb6\xed\x02\x08]\x05F-*\xf6\xea\xd2P\xf6\xd0\x9dBKG\xed\x02\x01\x80\x03\x0b\x06\xfcv\xd3@\xa9v\xef\xb2\x9a\xd8M\xe8J\xben4\xad\x1dL\xb9r\xe1U!\x8b^-\x07-\xa1c\xa6\x90\xc5h\xfa[\xb9t\xe9\x05x\xd3i\xde\xc99f\xbc{\xa0Q\x9d}\x03\x80\xf4\x86\x0d\xa6s\x0fF\x8d\x99[\xd1\xd6A\xcd\xb9z\x0c\x0c\x80\x82\xe3\x15\x88\xab\xa9-\xe06n\xdd\xd0\x03\xa3V\x0d\xd4\x95\x87\xe0\xcf\xc2-3\x8e\\A\xf2\xd8`\x98;\x8fN>0\xe6U\x14\xafc\x87\x06\xbe\x92\xa6\n\x15\x8e{\xdf;De\x17\xf1\xb7\xcb\xeb\x17\xb0\xaaz+\x0f\x00\xe6r]=\x14\xb1\xc7\xcc{\xdd\xcd\xc7	\x01\x014H\x00}\xb6@\x00\xd8x\xfb\x95\xb7\x8a\x80\x00f\xf8_+\x05\xc0\xd2\xff\x98I\xda\x1d\x88\xa0|\n\x00 \xc1\x89\x124\xa8\xe2\x83\xcaE!^s\xf9UH\x1eL\xea\x0d\x90a\x80\xb10\xd6\xa1I\xed\x91\x83\xe0&(\xa6\xa8\xe2\x8a\x10:\x01\x80\x84\x82\xc5(#t\xa8YG\x8cz\x8b\xad7@A\xefl\x12\x9f&'28\xe4\x90,B\xc1\xdc`J.)\xd8[\x93\xf1\xe7\xd1\x80;J\x19M\x8f\xb4\xfcx\xe2\x96pv\xc9\xc4\x91\xa4\x81\xf9\xdc\x92\x00\xec\x86$\\&-\x16\xa09\xdbTD%D*i	'\x97-\x16Q[\x9d\x94\x85\xc9\xa4yy\n6Y\x93\x9d\xa5i\xe3\x9f\xf1\\\xf8\xdf\x80!6\xb5\x89\xa1\x87\x12\xc9\xd4\xa2\xd2U\xe6\xa8\x00HJ*\xddhcJX\x184Q\xe6\xb2c\x80\xc0E\xd3iV\x00\x10\x00j\xa8\x0e\xb6H\xe7_o\xe5F\xe1d1\x06\x0b\x18'\x8c\xba\x8a\x9ag\xba\xc4\xea\x8a\xa5kj*\x135|\xe5\xca\xeb\xa1\x0f*\xeaV\xaaL\xa6\x9a\xdf\x97\xa8E\xb0\xc9\x98m\xf5\x13\xcbk\xd0r\xf8\xff\x1a'\xeb\x90\xc2\xe6\x81\xba^{\xe8c\x8b\xa2\xa6_\x99\xab\x02\x0b@\x04\x11X\xc0j`k\xbd*\x93=\xcd\xceCT(\x1e\xba\x0bY\xae\xf1\xca\xbbe\xb6\xcd\xb0\x15)y\xc6\nv\xe4\x05\x17\xec\x1b\x01\x02\xe2\x1e)i\xb9\x82Rtn)\x9aX\xe0oWk*\\\xad\xae\xbb:\x1c@\xb6\xb5yL\x18[\xb9\xe1K\xd8\x91pa\x8c\x00\xc7\x9b\x90\xf6\x16\xc8\x80\xaeK2\x00\x16\xf4{\xb23\xf98\xb4\xf0\xcb\x0d\xbb,\xeahnAg3\x7fpi\x8c\xc0\x05<Kv3\xd0^\xb5\xbb\n\xc6E\xf3\xdb/\xbb\xd2\xdc\x8a\xab\x83-\xbb\xcc\xa2[\x8c\x9e\xc6\xad\x00\xbc\x85\xbd\xf3\xd5\x1dk\x027jk\xf1i\x0ePC\x13\x8d\xb1\xd8GO\xd3I\x7f\xa1\x01\xb0b\xda\xd8\"\x1a5t_B\xca/\xc6\x7fg\x0du\xd5\xcbV4\x8d\xadD\x8b\xfd8\xbf\xbd\\sL\xa2>\x19\xbe\xa2\xd3L\xabX\xef\x8c\x8c\x86{5\xe4:\x07.\xb1)p\x9d3\xd14$k.\xff\xf6\x05\x1d#\xf3\xf9\xbb\xd5X;:\xe9,\x07@'yp%\x0b\xc0\xce\x170\x88\xf5\xc6\x9d\xd7V\xb5\xe7\xa2\x18\x8c^>aC\xae\xf9/\xa0\xa3%:\xe9\x0f\xb3\xfc\xaftm\xa3:\xbcy\x1b_\xdd \xd6;\xaft\xe4\xe0\xb4/tM>\xb7[\xcf32\xa1\x19\xe1;\xf7\xdd\xeb:\xf1\xdd\xe1CP|\x9d\xa8\x99\xdb\x05\x02\xa0\xb3\xf4\xd5\x07{3\xd9\x17zL\xf1\xb8\x01B\xce\x80\xf5;\xc2\xf6\xf0\x97\xbfH\xf1f\x7f\x80\xf1\xdf\xff\xcc37\x04(\x0f}\x08\x98\x85>\xda7\x8f\xe3\xf9+\x1cE\xbb\xda\x07\xd1\xc7\xbb\x91L\x90\x82\x87{\x10o\xf4\xa7'\xff\x91\nn\xc7\x9b\x1b\x01$\x00\xc2\x10*\x81\x84\xeeB\x80\xbf\x18\x88<\x02\x14`\x80\xe5k\xe1F^\x08C\xb4i\xc2{\xa9\xd3\xe0\x06\xc5\xd7\xc1\x00\xf0\xb0\x83\xe2\xfaa\xf4\xcea\xc2\x93\x95/\x02\x01\x18\xc0\x00\xe7\xa6DB51N,\x83\xa2t\xa4\xb8\xc1\x00V\xb1\x04%\xe8\xa0\x0f\x7f8=\xf8	\xff1\x84\xe5C@\x18{XF\x83\xdc\xef\x8chK#\xb8\xc8\xa4\xc1z\xc1E\x8e\xab\x93c{RC\xc4\xf4upJ\x1d\xec\xa3\x1f\x11\x87\xbf4\xea\x0fX\x84,d\xb9\x10\xc9\xc99\x1e\xb0H\x9b\xc8H'\xb7\x11\xc9\x08\"\x81a\x94\x04\x9e \x03\xc6F\x1bn\xb2\x93\x8a\xf4\x05Y\xcc\xc2\x15X\x96\xd2\x94\x12L# \x99\x06E\xb8\xb4rQ\x87\x94\xa3\xc9\xe4X7\x96\x00\x00'\x00\xc0\x8023\xb0\xaf\xf4I \x01	\xc8\xa1-%9IK\x02r\x95\xb9\xc2\x99\x0d\xe9\xb4	,\xf2\xcb\x02s\x13\x17r\xa4\xa9\x99d\xaa\x08\x039\xf4\x17\x01\x12 \x81f\x86s\x98\xf3\xc3\xa5\xfd,\xd94\x18\n\xd2{U\xd3\x1f\\\xf4\x97C\xdb\x9d,>\xc79\xde\xd8~\x83\x81s\xa63W\xec\x8cf\xe6LV\xb4\x93\xc9S\x82\\\xd2\xe5\x19\xe9\xf9 A\xa2\x92E\x1a\x1b\xa8J\xae\xc4\x95\x0cx\x94\x99aS\xe7:a\xc6\x95\x87\xe6\x12[\xc1\x9b(E\xbd'Hdl\x02\x9a\xff0\x85\xe6\x88\xe4\xf3\x9d\x921\x14\xa1	\x80\x98I\x9b\xc0\xc4\xfc\xed2\xa2\x14}i\x02\x0e@\xd4\xa2\x12\x15\xa6V\"\x91\x82\x80\x04M\x9d\xeetNN\xab\xe7.\x83:T\xa3Z\xb5\xa81M*\x89j\xcaNj\x16.\x95?\xbd\x16=\x01p\xd5\xb2\xc64\xab3\x1dg\xf6\xb4\xb0\xd6L\x98\xc5\x17am\xe2X\xcbJ\xd7\xa3\x9eU\xa1\x00\x00(M\xdbZ\x05\xd1\xf1u\x11\x00\x08\x81`\x07\xbb\xa0\xb8\xda\xd3\x92d\xad\xabQc*\x02\x11t\xe0\xb1\x8e\xed\x80\x08\xd2\xbaT.L\xd0\xa9\xfc\x08\x81\x8a\x06\x1b\x82\x9er\xcf\x9a\x9f\xb5d;\xa1IW\x986\xf6\xb1\x90\x9d\xacJ\x1a\xab\xd5\xbd\xfeu	\x00x\x00\x9c\xbc\xfa\x07Mh\xd6t`\xe5\xd5JS\xea0\xd1\x02 \xb5\x8d\x0dnd9@\xdc\x0e\x14w\x13$\x00\x81rI@\x82\xbd\xb4V\xade\x8c\xed\x03\x1e\xb0\xab\xd7&\x82\x96\x7f\x14\xebnW\xaa[\x96\xb5\x13\x00\xc4\x0d/\x07\x8c\x1b^\xf2\x8e\xb7\x03\xc8\x05\xff\xc1\x07H\xf0\x81\xf6\xb2\x17\x04\xcdU\xc6s\x15\xe4\xa2\xe9\xdaW\xb6\x88J\x05W\xd2\xb6\xdd\xfe\xf6\xf7\xbb\x00(\x81&\xccK`\xc8r\"\xb9\xeauo{\xd7\xdb\xde\xe5\x8e0\xafJ\xad\xa9\x914q_\xean\xc9\xba\x91\xf8\x14\x1a\xfd\xeb\xdf\x13-\x88\x00%\xe0'v\x01\x80`\xe5&\xb8\xc1\x0bN0r\x1f2_	\xd3\x91\xc2\x15~\x18m\xaf\xab\xe1\x18r8\x8d\x1e\xde\x04\xe7\x18\x04b\"\xd9\x8f\xc4&V\xee\x82?pb\"\xc7w6\x12\xa4lMKj\xccMT\xb8\xba3\xe6H\x8dmL\xd1\x1ck\"\x8f<k\x10\x1c\xf3\xfbc\x04\x0f\x99\xc8).Y\xdd\x16\x99V\xf8\x98\xb9\x02(@\x81\xfa4\x01\xd3\x18s\xb9J\x85=\x9c\x95\xe9\xa3cq\xf18\xc4\x17\x16\x06\x90\x83\xec\xe0\xccq\xae\x85\xadu\x00L\x1d\xe0\x804\xb3\xd9\xaa	xr\x9e]HK\x86\xfa+\xc9,\xdb\xf2\xa2\xf5l\x96\xb0q\xee&\xd1\xe5\x8e\x02\x1c`TB\xa39\xb1\x8bM\xf4}\xff/\x1c\xe5\x0c\xb7\xd3\xd1&\x03\xcd&\xf0<\xe9\x1f:\xda\xcf\x97>J\x1f5-\xe8\xa3z:9fU\xb4\x8f](\x81\x07\xa1\x9a\xcc\xb3\xe5\xe9\xaf\xc3vL\xaa\xccZ>\x84\x86&\xa1\x1d0\x1f\xb3\x8a\xda\xbe\xa4^\xa2	\xf8\x99\xea\x1e1\xd1\xda\xc3.vUf\x0d\xd0\n,{\xd9\xcdv\xf6}A\x85\xd9\xde\x99`\xda;D\xb20\xa0\xacnE\xa1\xfadU\xc1\xb4- \xac\xd4\xbd\xd6\xf5\xd9\xd3\x8d\xf68L$\x81s\xf7z\x87\xed\x9c\x93\x90\x88\x84U\xbc\x8ex\x13\xd5\x8e7V\xfaz\xf0\xaa\x8a\xdb\xbe\xe4.\xf5K\xd2xn\x13x\x98\xa7\xd5\xc5\x80\xb3\xa1\xa9L\x0c0s5e\x19\\\xbc\xa3\x0c\x80o\x9b\xdc\xe1W\xc57~MW\x9c'\x8a\xf6\xdc\xd6\x8e\xf8\x01:\x8e\x81\xbb&\x80\xe6\xcc\xb4\x8b\x08\xb7\xbd\x85\x92\x9b\xfc\xe7(/\xf8\xb8\x87\x84a)\x9bh\xb7\x01\xe7i\xaf	\xbe\xd8\x8f:\x1d\xe7 g\x0d2\xc2\"\x96R\xfb\xfc\xe7@O9\xbe\xdf\x0c\xff\x89S`\xc9\xbfl\xba\xe8\x83\nn\xf3\x04d\x80\xe6Q\x97z3t~\x05\xae`\xfd\xed\xc9F\xf4\xd0M'\xf19\x00\x80\x02#\xf8:\xd8\xbd\x84c\xb2\xd22\xa6\x1e]f\xda{\x92v\xaf\x02\xa0\x01\x88O<\xe2\xe1\x8eu\x94Cs\xd4\xbbf\xc4\xdd\xf1\xce\xef e\xa9\xefO\x00\x92\xf7\xa2yJ\x00\x98\xdd\xe9\x83g\x1f\xdb\xdb\xae\xf8\xd2\x9b\x9e\xf1q?\xea\xdc\x1bT\xf76h\x82\x02\xb0\xcf\xbb\xe5g_$\xd8\x8e \xef\xfcn+\x00\x9c\x9es\xb6\xef^'e\xc1\xc2\xe1MO\xfc\xe2\xbf}\xd0\x90g}\xeb\xcb\xa0\x12\xd8;_\xf6\x96\xb7\x8f\x14\xee>\x82\x13\x9c\xa0\xdd\x8a\xd2\xc4Gk\xa2\x94\xc1\x9d\xdd\xe3\x1f/\xba\x04\x8bO\xfe\xf2/~\xd9\xca\x86x\xe4\xb5\xe2\xfc\xf6\xc7\xbe\xf2\xb5\x9f>\x054\xa0\x81\xea\x9f \xef\x13\xa6%2\x94\xb9%t\n_\xb4\x12`~\x02xz\x0d\x00mt\x17\x08\x93\xe7~\xb1\x87{$7\x7f\xf4\xa7\x01\x94\xc7V\xfc7$\xff\xcc\xf4\x7f7\x86c\x018\x80\xa6ga\xbd\xc2~\n\xf8|\xf8gY\x0f\x08\x81!(|g\xd7j}u\x81*\xa8+'B~D\xb7|_\x90\x80\xce'}\x96\xe5\x80\xf5Gr\x9fWP\xac\x97\x05#vy+\xa8\x82\xeb\xa7\x072(~.\xd2~V\x07M\x19\xf0y\x15(\x1a\x07\xc7\x15>\x08\x84;\xc8\x07	\x08\x83\xf5\xe1\x80\xb0w\x84f\x87Tv\xd0\x84N\xf8oZB\x84h0yTX\x1f#\x08\x81`h?H(S4\xa6\x7fy\x10\x7f1X\x86\xf4G\x01gX\x1f7\xa7\x85O%\x846X\x86\x86ws\x07\xc0yw(\x84\x9c\x90\x87{\x18\x00}8\x86\x7fhY\xd87}	@\x88~x\x88\xe4\x00M\x8ch\x88\x8e\xb8\x85\x90HT\x928\x89}Q\x89\x96\x88\x89\x97\xe0y|XT\x97\xc8\x89\xb4\xc1qV5\x87\xa2(\x85J\xe1p\x8dx\x8an\xb5	H\xb1p\xac(	L\x16\x8b\xb4X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x06\xbe\xf8\x8b\x87\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95V\x00\x98\x99\x99D\x9b\x96\x9e`\x98\x05\x06\x03\xa4\xa4\x06\x05\x9d\x00\xa8\x00\x9f\xadZ\x00\xa3\xa5\xb2\xa5\xa7\x98+\xaa\xa8\xae\xbaP\x9a\xb0\xb3\xbf\xb4\xab\xb0\x06\xac\xbb\xc6F\x9a\xa2\x06\xcb\xcb\xc0\xce\xb5\xcc\xc5\xc7\xbb\x99\xa2\xce\xb4\xcc\x05\xda\xda\xb1\xb4\xc3\xc4\xd3\xae\xa1\xdd\xd7\xb2\xcc\xd9\xdb\xdd\xa7\xcb\xb9\xe1\x95\x98\xe4\xe5\xc0\xe7\xec\xe9\xa6\xca\xd2\xee\x91\xbe\xf2\xfd\x03\xf4\xa7\xec\xadk\xa7\xef\x11?\x7f\xfd\x00\x06\xe4\xc6-_\xc1E\x07\x11\xd6K\xa7\x0e\xe06Q\xdb\x1c><t\xb0\xd9\xac\x85\xca\xe8]\x0c\xa9p\xa46\x8d\x1b	\xf1\xf3\x18\x8c\xa2Bt\x17_\x9aD\x992\x90\xaaQ,\xff\xc5|Y\xd2\xa4\xc5\xff\x914k\xfa\xf9\xa6n\xa7H\x93\xdc&\x8e<\x9aQ\xa8\xca\x90\xf7\\\x82DJ\xb5j/MCz9\xd5\xf3M\xa7\xd1\xaa`\xa9fb0\xa1\xac\xd9	\x0c\xc62X\x1bt\xeb\x9b\x9b0\x19\x86\x9d{\x11\x13\xd9\xb3x\xcb\xae\xdd\x9b\xd6\xed\x1d\xa2t\x03#\x05p\x97o^\xbd|\xfb\xfa\xa5\x03w\xaa`\xc1\x84\x13\xef5\xcb\xf6*\xd6\xc5p\xbe=\xde\\W\xf2\xdedF\xd9u\xc2\xac\x06.]\xcb\xa1 K\xae\x16\xcfk\xc5U\xa4\xb1\xb4E&\n\xd7LM\x10\x16\xe8\xde\xbd\x00B\xb5\xd3\x9fo&$'zv\xec%\xb6\x9a\xa8J\x9d\x11Sn\x01\xd0\xa3K\x8f\xde\xfb\xb7\xd5L\xad\xe5\x117e\xfc8\x12\x00\x12\xba[_\xf5|\xba\xf9\xf3\xd5m\x0f\xce\x8e\xd0\\\xa9\xe4\xdey\x110\xce\n\x17\xa6\x05\xe7\xf3\xeb\x17\xb0\x80y\xdd\xd6\x1e\xb4w\x0d8\xf1=\x01\xde|\xca\xa1\x82\x1ft\x1bl\xb0\xdf\x83\xd4\xf9\x17\x11)\x01\nXNw\x05\n\x01@\x00\xe2\x01\x10\xff]\x83\x0eB(\"\x7f\xb6\x01\xf0\x8b\x07(\xceR\xa1=\x08aX\xe0\x86\x08\"\xc7 \x88#\xd6(\xc0r\x05\xa8\x88\xe2\x8e\x14\x92\x92\xa3k\xfe\x10\x98\xa1\x12\x1br(\xa3\x00 \x86h\xe3\x88\xf0\xa8(\xcb\x8e\x01\xfe\xd8\x92?\x04\x0d\x89\x0c\x01\x01\x04\x10\xe3\x11\x00\xe0\x17b\x83K\xda\x18Q\x85NZc\xa17\xd4\x8c\xc6E\x91Y\xd2\x04\x00\x04\xd3\x81\x19f\x8d\x13\x9ex\n\x85d\xb6W\x8b8\n\xf4Y\x81\x9a\xb2e\xd9\xe6w\x0bJ'\xe7\x9c\x10\xf6\xd7\x8f\x99\x03\xf0\xf8\x916\xf3\x08\xe9	\x00}*P\x81\x9f\x97U\x01\x00\x96\x82n\x99\x15\x9c\xe7\x1d\x8a\xe8t\xbd\x81\xea[9w\x0e(\x8b*\xa5\xa4\xb8\x8c\x8b\x8c`R\xc1\xa5~b\n_\x14l\n\xaaQ\x97\xfa\x89\x1a\xa6\x87\xd3\x81\xda\x1f{\x030j\x0e\xa4\x8d\x06\xd8\xa49{\xea\xa2\xc9\xac\x95\xda\nk\xae\x9d:\xc4+t\x85\x1a\xaa\xa4\x8d\xfd\x01K\x1d~\xd5\xe5t\xcf/\x01\xc5C\x0c\xab\xccJ\x9a\xff&\x00\xb4F\xfb'\xa0E`\"\xe8\xbc\x83~\xba`\xb6\xe6\xf9\xca\x9fy\xa0\xde\xe8\\\xb0\xfc)\xaaS7\xc6\xfa(\xee\xb9\x98\xa4\xab\xee1\x99\xb4\xeb\xae\x9a\x99pJo\xb5Y\xed\xd6\xef~4\n\x00\xaa\x9c\xe5\xf5\x17\xc1\xbf\xd1\xe5\xa6\x9b\x89\xd8l\xf3\x8b2\xc1\\U\xd1\xab)=\x1b\xad\xb4\x9bN,\xb3\x91\x1a\xeav\xf1\x88\x177\xd8\x1b\xb8\x00D\xf0\xf1}\xdf\xdaL\xf2\xb1\xe6\x00\x96\x89\x05HWc\xca9\xb0\x8a#\xeb\xcb1\xcf<s1]\x96\x87m\xa27\xdf(r\xcf\x11 `\x01\xc8\xd0\x89,\xb0,fF\xd3\x0b\xd2>G\xf05v\x03\xb3\xecW\xc3\nD-\xf5\xd4\xb7\xf8\xf6\xad\x88Vc\xfb\xe6\x05\x17\xf8\x8c\x00\x02?{\xab\xf1nC\xdf\x83S6\x00 \xcd\xb7\x05i\xabm\xcb77U\xf9\xb6\xbcs\xcf\x9d	\xb8\xba\x89\xd8\x9byob\x12\x01\xdf\x7f\x07^\xa8\xd8\x85\xb7\xcdt\xe3\x9f\xf7\xed\xf3\xda\xb7\xe0\xb3\x9cwrW\xdei\xb5]\xee\x86\xff3\xbe\xdd2\xde5\x02\x17\x00\x9e\x89\xb0\xa4?J\x0f\xd7\xa8\x7f\xees*{\xde\x8aY\xec\xb2g\xc9)\x01\xf3\xd9Nj~Y{\xbe\xfb\xdf\xbc\x07\xce\xeb\xce#3\xc3\xdd\xe9\xc5\xa7\xdd7|\xaf\xdf\x12\x1f\xf3\xcdk\xa9e\xf4\xf8\x0e\x8e\xde\xcd\x1e\x03\xce7\xdf]\xff|\x0b\xe1\xb9\xf9F\x121f\xfb-?\xfdi\xbb\x95\xf2\x8e\x83\xbe\xe6A\x0fz@\x93\xce\xe6\xces\xad\xe8\xf4\xeco\xf3\x9b\x9f\xd7\x1e\xb7\xb5\xce)d\x18\x99\xc0\xde\xff\xe8\xe7\xbbxY\xe9\x16\x12K\x9f\xf3\x0e(\xb8n\xb5\x8fD\xd9\xea\x0f\xf6.\x80\xa5\xdeu-\x15\xe9\x81\x07@`\x81\x8a\x8fi\x90o\x01\x90 \x02\x9a\xb6\xbc\x10\x8a\x90\x84\x0e\xdc\x94\xe0\xa4\xe3\x9bl=\x10\x82Y\xea\xdd\xdf\xc8\xe7\x1b\x0d\xbd\x04\x16\x89\xf3\x1f\x04[\x08\xba\x1d~\xf0;>4 \x02G\x06D~\xbd\xa9P*\\a\x0e\x95\xb8\xc3|\x90\xef\x82\xa8@\x9a\x06yG\x80\x02\\ \x00\xbb\xe3!i\nX9\x04b\xff\x82\x84\xed\x83@\x11\x0bu\xc4\xbfQ\x11{\xd6\x8a\xd7\xfej\xa8F\x0dF \x00\x03x# \xaf\x98\x04:J\xed\x80\x90\x9c\x0f\xa9\xf4\xb8G\xbd\xad\x91\x00\x12 \xa3\x15\x91\xd3\x98\x1azm\x8d\x08@\xa4&\xe58G\xe8\x89p\x84\x91\xf4\x96\xcd(Y;\xe8\xf4\xf1o\x01\xc8\xa4!;T\x97\xeb\xad\xb1X\x1a$e\x0fM\xf9\xc3T\xc2\xc9b\x94\xacd\x185\x18\x80\x12\x94`\x8d.\xfa\x0d\x00@\xf97m\xe4\x92\x91X<\xe0)S\xc9\x9b`\xea\xb1v\xf1\x03e\x15\x17\xd9H\xd4d\x90\x99\xc8\x84\xe6\x95\"9M<\xae\xd2\x9a\xd8|%8\x97\xf8\x1d\x00d\x00\x03\x18\xc8\xc0\x11\xc1\x93\x80\x04,s\x9d\xec\x14''\"\xc9\xcb\xf4\x91\xf0\x9a\xce	f\xed|cK|\xee*\x13\x18\x10\x14\x06\xee\xf95\x02$ <\xb6T\x9b\x05B\xa7K\xb7\x14\x89\x9fY\x9c\x9b\x1d75\x1ft\xe6O\x9d^\x9b\xe8,\xcdx\x97	\x00 \xa1\x01X\xe8\xdf\xbe\x16\x80\x87\xda3\x8a\xffHc\x1c\xeb\xf4\xa9!z\xf1\xf3\x94\x1c\x82\xe4\x9b|\xa3\x89\xfe\xcc\xa7\x8fi\x13\xa9\xef,e\x19\xbd\xb83\x03\xf2\\\x1d\x8c\x12\x80 \xcb\xd04+3#'N\x9d\x17\xb1\x7fr\xf4\xa7\xf5k\xdc\xda^F\xd4\xe3U\x06\x131\xddP==\x95!x!\x83Z6\x85\xe4T\xe7\xc5\xcf\xabn4\xa8Wi\xd7=}\x87		\x84'\x13-ejE\x95c\x89\x0d\x85\x00P\x9a\xc8\xe8\xc4\xd4\xbaV\xb6b\xf4\xa7/\xc5D\x02D\xd0\x81\xc6\x8a\xa0aq\xd5\x84]\x1d\xfa\xd0\xbd*g\x80\x06	@\x086{\x15\xc1j\xb4\x9f\x85E\xa5N\x0f@\xda\x04t\x80\x03\xa8m,jS\x0b7\xae\xbe\x0b\x00\xf5\xb4,\x13.*[>\x14i\xb3\xb8\xfd\xabg\xeb\x08\xda\xc2\xb6\xf5\xb4\xa9\xed\x80e\x80\xcb\x01\xe1\xb6\xf6a\x98\xedB\xae\x92\x1b+jq6\xb4\xeb\xdb\xad\xec~\xdb\x81\xc7*\xb6\x9e\xd8M,c\x1b[\xdd\xe3V\xea\xb5\xb5\x8d\xd7\x03BHVHd\"\x04\xf3B\xff+N\xa5\xb9\xd6H\xde\x15\x13\"\x88o|\xb1\x8b	\x12\xd8W\x13\xdb\xed\xee\xd3\\kVM=\xe0\xbf\xf4bn#z\xf1W\xe8\x1a\x18\x92\xef\x95\xaf\x82\xadk\xdf\x06;\xb8\x17\xf2u\xd9\xcb^+\x9b\xffZ\x98\xbc\x026\xc8\x1d\x0d\x1c\xdd\xdeF\x15zw\xcd\n\x00\x16\x0c\x00\x12\x80\xe0\xc4(6q\x8aAp\xdf\xfa6\xd8\xbb0\xa3\x02\x00,Lc\x0c;\xcb\x91\xbc=,{E\x1bbd\x90\x18\xc5,&\xc1\x07\x86<d!\x17\x99\xc8)\xee\x85\xc3\xbe\xdb\xdfv\xce\x98\xc6\x16\x0epx\x01\xb1a\x7f\xea\xf8\xca \x160|#\x1cd\x10\x10y\xc8^&\xb2\x91?\xc0b\x88\xc1\x98\xa8\x19\xd6\x90&\xa0\xfc_\x1b\xdfXb\xa0\xc5\xb2\x9c1\x99f5s\xf9\xcbHFr\x98A\x90\xe13\x83\x97H\x9aH\x00\x9b\x1f \xe54\xc1y\xcer\xbe\xab	L\x90\xcc^\x84\x19\xcc_>1	\xc0\xfa\xb5\xcb\xb2\xcb\xb5\xe0\xd5\xca-0QZAC\xd9\xcd7\x96\x1b\xa2\xff\xf9\xf9^\x13\x18\xd3\x98\xa4\xcc\x84\xa4\x93L\xe9\xb55z\xbf\xdf\x9d\x95\xacg\x95\x89\x04\x90\xf6\xd6\x9e\xfetz\xa7,\x88\x88\x19\x16\xcb\xef\xe5%\x01N]\xde\xb3\n\xd0\xd5\x9a\x88\xe9\xda\xd82\x85\xe3:\xc0\xd6\xa5u\x00\xad\x01pk\\\xe7\x9a\xc6l\xad\xb3$\x02\x9b\xd6\x03\xbe\xd72\xcfK3ab\x9b\xec\xe2}-i\x89\xd1\xe5~\x9f\x8dk\x07H\x1b\x05\xd4\xaev\xa7\xd9\x0c\xeaix\xf3hI\xd3*\xb2\x9d0\xee\xec\xc6\x16u\xc8\x8e\x0c_*\x8a\x89>\xb9;\xbb\xee\xa6\xb5\xbc\xad\xcd\xe6B\xd7$q\xca\xfeZA\xed\xc7o\x06 \x15\xa9\xf4\xd5\xdd\xb2\xd3\x82T\x813\xfb\n\x97\xae\x80\xbbG\xeen\xa2.\x9c\xe15\xce\xf6\xc3#\x9eo\xc7\xdd\x11C\xfd\xc6\xee\xc5\xe59\x16\xb3\x1c\xd5\xe3\x1f\xbf\x04\xa5\xb8\xca\xe4\x93\xa3\xfc\xc2\xbbnY\xc4{!1\x983\xc0\xdf\xd9%L^n\xbe\x9aW\xdc\x1b\xb6>\xc7u\xc3)\xf6\x90\xab\x84\xa7\x9f\xc3.\x01}\x8e\x8e\xff\xf4\xa4\xe7%-8\xcf\xf9\x16\x00@\xf2\xb2C\xdb\xe7\xf5\xd4\xb5\xaex\xbd\x87Lq\xc2\xaep\xb7\xebU\x89\xbd\xf5\xa4\x07\xda\x9e%\xb5y\xd8\x15\xb3\xa6\xb2\xfb\xfd\xe0h\xbf6\xa1i7\xe0\xcb`\x82\x02\x88\x1f\x01J\xc0\x03\xf7Ec\x82\xd8\xf5\"\x12\xd7\x95\xa7\x89\xa5\xdbe\xe0\xa0\xf8\xbb\xe6\xcf^\xed\xb4\x03\x9d\xea\x8a\x00\x00\xe2\x11\x7f\xf8\xd1+\xfe*\x1aZ\xb4\xea\x19\x8d\xd6\x0e\xb5\x05\x00KWs\x93e\xa3\xf9\xda\xb3[\xde\x9eos\xd0\x13!\xfa\xd1\xfb>\xf1\x12po&\xe2.\xf7\xd6\xbf\"\xef|\x07\xc5Ul\xcf|\xce\x0b^\xe5\x88\xe8\xfd\xefM\x1f|\x8c\x12\xbf\xf8\x13\x93\xad\xc0mN\x06\x004\xe0\xfb\xe0\x0f\xbf\xf8\xc3\xdf\xfch\xa7|\xed\x86\x90\xfe\xf4\x81_}R\xc3\xbd\xca\x01\xd6\xf9\xde\xd9\x8e\x8c\xf1\xdb\xff\xfe\xf8'y=\x1d\x80m\xd0\xf7z\xfd\xbe7\x02pg}r\xe7Y\xea&O\x9eA\x7f\xf5\x87\x7f\x0c\xd8\x80\xf8\xf7_\x0d\xb0{6\xe1{\xff\x99\x10\x80\x92\xe5^\xe1a9\x9a\x02O\x08\x88ye\xe0V\xa3\x86I\x12\xe0\x80\x0c\x98m\n\x18\x06\xbdG>\x9a\xb6i\x8cW\x80Q\xa5n\xf0\x04O\x1ew\x82\xe3\x14\x826(g\xfeg[\xda&bw\x94E?\xa5)\xef\x84R\x08\xb8\x83Y\x00\x827x\x84WF\x84\xbdvzq\xa6\x84\xc8\xf0N\xf3\"O\xf2\x84\x06OW\x85Vx\x85\xa8g^#p\x02 \xe8\x84\xc8PO\x19@/\x1d\xf7Tr\x00\x00\x1ap\x02\\\xf8~\xaf\x00\x86a\xa8P4H\x86\x06B\x01\x1ap\x86\\8{\x06\x02\x86`\x98%\x0b\x05\x87q`\x86sH\x87\xa7W\x84\x07\x80\x01v\xf7\x86|\xc8o\x7f\xa8\x01\x89\xa7}\xa4\x85\x01\x07\x10[\x87X\x86r\xf8\x87\x14\x10\x88 gk\x01PZ\x86\x18\x89\xfc6zsX\x89\x8cx\x00\x99hO\x9c(\x89\x14\xe8\x85\xf1\x82\x89\xa4\xb5\x89\xa58[\x93Hzc\xa7\x8a\x07\xc0\x8a\xadH$\x89\xa8\x88\xa8\xc8	\xf5\xe4\x88\xb7F\x8bE\xb5\xc8%\xb7\xf8\x89\xb9x\x0b\xbb(o\xbe\xf8\x8b\x9c0\x89\xc1Hp|qv\xa4\x88\x8ci\xd0\x0b\xca\xa8\x01\x04Wy\x94q\x8c\xd0\x18/n'\x1b+\x98\x8d\xde\xf8\x8d\xe0\x18\x8e\xe28\x8e\xe4X\x8e\xe6x\x8e\xe8\x98\x8eK\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92m\x00\x93\x96x\x00\x95\x97\x9b]\x99\x9e\x9f\x9aI\x00\x05\x99\x9c\xa6U\x9e\x05\x06\x03\xac\xad\x03\x06\x9eH\xa3\x06\xa4\xa1\xb2\xb1\xa7\x9c\x99\xaa\x06\xbd\xbe\xab\xae\xb4\xb8D\x00\xbe\xb5\xb6B\xbb\xbd\xb5\xb9\x93\xca\xbf\xd0\xbe\xae\xaf\xc7C\xb3\xbf\xc7\x9e\xc0\xad\xc2\xc8\xcd\x8a\xd7\xd1\xd3\xaf\xbd\xe3\xdd\x95\xe1\xd1\xdb\xe6\xa4\xdf\x8c\xd7\x05\xf1\xf2\xe5\xe6\xeb\xc1\xd9\xbc\xd0\xe3\xe3\xed\xee\x88\xb3\xf2\x02\n\xb4\xb7o\x1a\xad\x80\xea\x0e\xc6['\xcc\xdf\xa1b\xa9\x04JTU\xb0\"\xab\x84\xd8\x16r\xeb\xe7\x90P\xa8]\x13\x07Z\x0c\x86Q\xdd<V\xf1\xbcu\xf48q\x19\xc2\x91\x17K\xba\x94\xa8P\xe5JA\xa3Z\x1a\xd38rf\xc8\xff\x9f)\x89\x95\xba	(g\xc8\x8c\x05`^\x04*\x10T\xa9L\x0c\x18\xd8$z\xc7\xe8Oh\xf1\x94\xde\x93\xf8\x89\xc1\x84\xaf_\xa5F\x8d:\x95*\x1d\xab@\xa3%\xd5\xea\n\x94W\xb0p\xc1\x8e%kV\x0fZ\xa6\xf9\x96\x11\x1c	\xe0m\xdc\xbfa\xe7\x96\xad\xeb\xe6.\xde\x85\xfa\xd8\xf6\x9d\xcb\x18\xaeT\xa7\x9f\x08\xcf1|\x18\xb1^\xad\xb0\x183\xfe4\x10\xdb0\xc9k(W\x9e\xe7r\xef\xbd\xc5\x9a\x95\xb1\xa3\x18\x93\x19h5\xa2G\x93VHP!\xa9\xcd\xc5`\xd2k=\xf4\xb5\x99Q\xa0d\x0b\xe7:6\x93\xe9\x82\x0c/\x0e\xf6\xcd\x05Y\xc4\xe1\xc2\xb5\xb1\xad\xb8\xad7s\xd8\xa2!\x83\x1c\x9d{\x9a\x87\xe9\xc8\x97_\x0f\xb3\xfd\x13\x84\x05\xe8\xd3/\x80\xf0\x1chwW\xdf\xc1\x87\x1fO)\xd3y\x01\xf8\xf3\xeb\xc7\xbf\xbe=\xd7q\x1e\x04\xe8\x1dJ<\xe9&\x1e}\xcd\x01p\xdf~\x0c2\xd8\x9fa\xa3x\x17\xe0\x84\xac\xc4G\x0b5\xac\x8d\xc4\x11\x82bd\xb2\xff@\x83 \x86\xb8\xc0v)\xd9\x13_\x85\x14\x0e\x90\xe1A\x06r8\x06\x00\x1f\n\xb0\xc1\x06!\xd6\xb8\xdf\x889\x19\x07\xdf>\x16\xae%\xdf\x86.6\x87\xdf\x8c4\xdahd~8^3R|\x19\x82\x07K\x90^\x00 #\x91GV\x99\xdf.\xaf(u\xe1\x00\x02\xae\xd6J\x97\x0dA\xa9\x85\x94E\xceh\xe5\x99\xdd\x1d\xd7J\x86)N\x13!IO\x8a\x89\x85\x94\xfa\x99y\xa6\x95\x00p\xa3aAyr\xf9\x9d\x8e\xdc\xf4r h\xd6AA\xe7~v\xdei\xe4\x88\x81\"\xe7c[\x06a\xb9\xe62\x83\xd6\x05@\x00\x95Zsc~E*j${\xf7\xd8\xd3$+}r\xf3\xc96\xf9d\x9aHd\xa8\x04\x80i\x14\x1e\"\x19#\xa7\x9e\x82\xb8\x1e\x04\x020j\x90m\x8f\x92\xba\xcd9\xc6\x01S\x0c\xa5\xa6\x00\xa0\xc0\xb1\x15\xb0\xfa\xc4\xa5\xae\x12p\xa0}\xea\xad\xd7`\xa2w\x1e\x9a\x1f\xae\x02\x80\xea\xe8\xa3\xc1R\n\x80\x05\x16\xa4RN\xb7\xaa\x1ab,\xb2\xc7*\x90\xff\xecg\xb2\xb8\xea\xee\xb3\xe7\xa9\x87-\x88\xd4\x1e\x89\xe3\xa6\xb9\x96j\xce{\xaf\x80\x94\x89\x05\x11D\x10\xae6\x82\xae\x00P\xb9\x85xRA\xba\x0b#\xab,1\x04\xb8\xeb\xea\xa0\xd0\x8ex$\x95\xf8\xcd{\xed\x95\xf6m\xda_xp\x02p\xc1\x05\x00\x07,0g\x05\x1b\x0c\\3\n7\x9c\xae\xc3\xc30+\xb1\xb3\x86f\xe2\xa9\xb4vJ\x9bo\x04\x1d_\x9b\x9e\xbe\xae\x90\x12(,&\x8fl\xf2\xc0\xe2\xdaR\xe8)\x9f\xb8\xfc\xb2\xba\x9eD,\xf1\xc4\xb0\xd6\xba\xa0\x99\n\xe2\xf7-\xcf\xb1\xf2\xf7\xf3^\x11\xfe\x02\x80\xc9E\x07\x0c\x8a\xc1@\x12\xd5\xf4\xd3\xc7\xca<\xf5\xabM\xd8|\xb3\xc6Z\x7f8b\xc0\x03k\x1c/{\xd2(\x07\xcd\xd8d\x97\xad\xec\xd2\x96*\xdc\xb6\xd4oS\xcd\x04\x8cV\xcf\xca\x9f\xc8\x17l\x8d\x00\xd7\x8cg\xfcs^\xb0\xe4\x05x\xc0#_@6:J#\xf8I\xe2S\x13NLz\xfa9\x1e\"\xdd\xd9\xc2\x08\x81\xd1\x93{\x82^\xdd\xe8\xf1m\xff\xcc\xb0\xc2 \xa0\xfb\xe4\x9d{\x1e\xbb\xc1r&\x838\xe9\xcd>|z\xb4\xf6\xb2\xae {\x9c\xc7^\xb1\x00\x1e\xa2\x87{\xe6\xe7\xec\x8e\xc0\xc8\x01t\xae;\xc2\x96\x12\x9f\xb8\xb3\xc6\x1b\xac^\x95\x95#\xa9 \xe0\xba{\xfe\xc9\xcf\x06\x9fg{\x8e[G`\xfd\x05\x11k\x8f\x00\xf7f\xb9\xed\xfd\xcc\x90Y\xa3z\x8d\x16\xdb\x8f'z'?\xca)Hz\xc98\x1f,>!0\x0bX\xefz\x04(\xc0\x05\x02 \xbf\xfb\x05\xcf\x1a\xfb#\x1e\x01$\xa00\xd9\xd9\xaaA\xec\xb9\xd1\xd8z72\x04 \x0dZ\x1f	\xd61\x1a\xf8\xc0\x08\x04`\x00\x13\xdc\x1d\xfe\xa8\xa2\xbf\x0c\xce\x8c\x00\x1b\xf4 \x08\x1b4\"\xd5\x01\x8e~\xd9\x9b\xdc\xfd\"s>\xa1\xe4\x83\x14\xf2s\xe0\x03_x\x01\xeb\xcdPm6\x9c\xd9\x0dq\xc8\xc1\x05\x99\x0fD!L\xdd\xd8\xae\xe7\xaa&n/f\xde \xd1\x16\x1f\xa8;\x159\xf1\x82\xc9\x88\xe2\x0d\xdd\x85\xc36V\xac?X\x94\xdb\x95*8\xc1\x12n\xffOe\xc4\xb8E\x8e\xc8\xa8\xbbx\x9c\x11\x8d\x00\x18^\x14\xdb\xd8\xac6\xe2\x10\x14Xd\x8f\xc6F\xb4\xbb\xfay\xd1\x82\xcb\xd1\x0e\x00\xf8H\xc6'BQ\x8dl4\xa4&\xdd\x98H\xf6\xcc\xean\x8d\x94\xc0#-\xb88\x0c``\x8b\x00\x90@\x02\x120IJ\xca\x10\x8d\xc2\xc3d&7\xc9I\x07A\xe0\x96\x9e\xe4\x0f{\xac\x17\x00QZ\x8fgJ\xc8\x04\x06\\uJ\x13\x062\x01\x1c\xac\xa0\xeeX\xf8;X\x1aL\x90\x83\xa4\xa5\xb3TW;\\\x1e0W\xa0\xdc]\x00JP\x82\x07Net\x01(f\xb8\x02\x80LVn\x0d\\\x00\x1b\x983\xad\x01M\x1b\xd2\x12F\xd1\xaa\xdd\xf2<\x99\x9e]>\xd0\x8e\xaf<\x02\x00\xe4\x02\x00\x0cd`l\xe3$@\x02h\xd6\xbfuBL\x96\xb3tc\xc5\xd83\xcf\x03\xca\xd3\x95\xde\xd4#Y\xfe	\xaeK\xad\x92f\x06]\x1c\x0e\x11\x9aP\x85\xda\x07\x97\xf4\xec\x8f2!:\xc4\x98\xc9\xf01\x9e \xe7@-\x19\xa4@\xb6S\x8d\xff\xef\x9c\xa7\"\xe59F\xeb\x81\xab\x85\xe7\x82\x9a'&70	\xf8\x14\x87\xab\xe4`F\x97EH\x8eN\xd1\xa3\x1f\xcd\xc4!kz\xb4\xdd\xf1\xcci\x0c\xfb\xc4*\xa7:U\x96\xc6\xcdt\xdfp\xe9F\x8dzT\xa4n2\x13\x05$\xdb\xc0\xcc	\x00\xa8F\xd5\x13UE\xc3\xa5\xc0w\x13\xad\x16\x95\xab\x1d\x95\xe6R77V\xaa\x92\xb5\xacl\x83\x19V\xa3\x848\xb6\xfa\xc3\xad\x9a\x84\xeb\xdb\xe4z\xc8\x0ep\xe0\x13\x1dHlbWy\x80\xc6\xaermy]\xd7^\xb3\x00\x80\x07\x0c\xcf\xaf\xb9p\xe9\xd4\x02+\xd8\xcd\xbe\x93\x03\xa0U\xacaA\x1b\xda\x0e$\xa0\xb1\x8e}\xac\xe1\"\x1b\xbe9=\xe0\xb5\x96\xe5\x9fU\xfd\xa0Y\xd2\x19\xb2\xb3]\x9d+dD \x82\xc4\x86\xf6\xb4\xa8\xb5+d\xd9&Y\x96V\x16\xb6\xaf\xbd\xecd\xdf\xf1R\xcf6\x17\xa1_\xcd\x04o\xa7\xcb[O\x8c\xf6\xb7T\xe5mbE\xe0\x14\xb3\xea\x95{\x99@.r\x95k.I\x9e-\x90\x98|\xff+W\xbf\x9a@\xear\x97\x04$\x90\xaebI\xab\xd8\xea\x02 \xbe\x15E)^Y\xbb\\#|B\xbc\xb0-\xddl\xd3\xc0\xac\x10\x18\xf8\xc0\x08\x0e\x81Rq\x0b]C:\x07\x00\xd5\x05\x81\x84\xe1\xeb\x89\xde\xd6\xd7\x13\x14\xce/n2\xe1\xdd\xe2>\xeb\xbf\x00V\xee\x80\xcf\x00\x80\x10\x90.\xc15d\xf0\xfe\x1c|\x0b	\x83\x80\x04.\xa60(\xe0;\xe1o\x85K3\xc5\x19\xee\xd3<\\P\x95\x01`\xaa\x00\x8e-\x1b\xfbk\x87L\x18x\x7f)V\xf1\xf7\xa8X\x96L\xb8X\xc2\x1f\x88\xf2\x84i\x0c\x02)\xdbx`8\x96\x8aP8\x1c\xd9\nx\xf9\xcb_~\npS\x1bd\xf2\x0e\xc2\xbc\xa0\x10\xec&m\xdbF\x0e\x8a\x07\x00U\x8e\xb2\x94\xe3,e9\xc7\xd7\x13\x19\xf8'\x8em\xa2\xe3c9\x80\xaa\x0ep\x80\x9731\xe6\xe0&\xa0\xcc\x12#\xf2\x99\xd7K\xd8M\xba\xd9Pq\x86\xb2\x9c?@\x029\x83@\x13\x00\xc8s\x9eQC\x97\xc5q9]\x7f\x0en\xa0\xff+\x80\x02\x00\xa0\xf6\xd4\x07Xe\x90e\x0b\x89$g\xb0\xd1\x86|\xb4\x14\x9c<\xe9(\xc3\xd8\xca\xd6\xc8\xb3)\xf5\xdc\xe9ee\x02\xd4cN\xc0\xa8\x93\x85\xeaS\xabZ\xbc\"v\x04ze	k&\xb3\xcb	\xdf\xf2D\xa4\xe1{\xe7\\\xebz\xd7}\x01o\xc3\x02\x0dhA\x13\xbb\xd8\x86\x0e1\xff\x94\xdd`\xc2\xba\xb9d\xe5\x8a\xdf	\xd9\x95\xe9\x0c\x98\xf2\xdd\xff\xbc\x02\x97+\x10\xe8z\x07\x1aj\xe06\xf6\xa1\x91\x9d\xe8\x11\x17\xe6\xb9\x8357X\x8f\xa6hk\xc4\x0fo*\xf9q\x02\xdc\xfdn\x7fZ\xf2\xd7yuX\xa1\xc1}\xec\xf1\x0e\xd9\xdflpu\xc0\xa5\xe9f\xb1fB\xa8\x86\"\xcb\xd9\xfc;\xd5k\x9br\xb6h\x06@\xbd'\x8e\xea\x8a\xc3\xb6\xaf\x18\x87M4i)k\x1b\x7f\xdc\x8d\x86\xfa\x8b\x96I\xbe\xf0Uj:\xe6*\xb7\xb7\xd0\xed\xcdr2\x8b\xb7\xdf\xffp'g1\xebc	\xb4\xd9\x04\x95\xdag\\vN\x0c\x9f\x0b\x17\xe8C\xcf\xba\xd0Y\xee\xffr!\x07\x80\xe98Y\xf1\xd2\x97\x96\xca\xa7\x9b\x00\xea9\x8fK\x18\xadN\xd5\x98[C\xebp'\xba\xbe\xf9]<\xb7\x7f\x01\x9cK\xbe\xed\xd7\x9d\x92@O\x9c\xfd\xef>\xa5\xd8_\x9c\xc3v\xd5\x92\x07\x14qO\xfc\xca\x8d\xfe\xf2!\xef\x01\x14>\xf5i\xde\xb7\xbaw\x00\x94\x00\x00\x14\xa0\xc0\x08<\x11\xf9\xc0\xb37n~	\x8b\xd2\n\xcf\xca\x175\xe0\xf4\xa8O\xbd\xeaW\xafx [|bv\xb7B*;\xff\xd3W\xa7\xd4Y\x99\xd7\xfc\xeci\xffS\x16/\x01*\xa1\x9f\x80\xd2\x1a\x8b\x81\xb6\x93\x01\x00\xabO\xbe\xf2\x97\xbf|\xd86\xe0\xe2U\xe1\xbd\x04\xbc\x07>\xb7a>\xf7\x9b\x97~\xefq.\nN\xfb\x85\xea?>\x00\x06R}\xda\xd8\x1b\x8c\xf9\xe8O\x7f\xf3\x1b\x00s\xf3\xc3\x8a\xf6\xd0\x9c\xeb\xdb\xae\x8f\xfd\xddK\xdf\xf7\xfa\xe4uj\xac\xc1\x80\x04\x8c\x1f\xb5\xee\x87|\xea7\x80\x04\x88z0Wd\xbd\x97Ix\xc7?\xb9W\x7f\xdaG{\xdftr{F\x0c\xff\x0c\x90\x01\xc6\x16\x80\xcd\x96\x81\x1aHK\xef\xe2~\xbe\xd6?\xcb&`\x0d\x88}\x9b\x97r\xa2\x00o\xde\xe7\x1c\xfdwj\x1e\x08X\x1b\xf8\x82\xcdVw}\x90b\xf4G\x01\x9e\x90y#\xb0yT\x90i\x0d\x87\x1a\x84\xd3\x17\xc1\xd5\x82\x19\xd8y)W\x84F\xf8x\xd3W:\xb9\xd7\x1b#7k\x0cGL\xd9&\n\x0c\x00\x80je\x84\xad\xa5\x0b\x9f b\x997`\n7L\xeerJ\xdf\xb4\x82\x07\xe0\x81.\x02\x00%\xe8t,\xf6ls\xe2sSsJ\xc1\xd4\x7f\xa57T\x945\x02'Pv\xdc\x07\x06\n\x97\x01\x0b\xe7\x85p\xd3}\x05'\x87\xc1\xa4\x01'P\x87\x91\xe7v\xe1\xa7\x87Sur\x80\xa8V\x14\xa0\x01\x82X\x87j8&\xa75~U\xf5\x87\x8b(o\x8e\xe8\x88\x83\x98\x83@G|\xe4G\x86\x97\x98\x0c\x99\xe8\x88\xba\x87q\xa6v\x00\x01\xe0X\xa0\x18\x8a\x063\x8a\x1a\xa0{x\x08\\\xa98\x86\xacH`\xb9G\x8a\x91(o\x8c5\x8bqX\x8bb\xbf\xf1\x8aK\xd8!\xbb\xd8X\xab\xc8\x8a\x00\x90\x89[(\x8c\xfeG\x85\xbeHb\xaeh\x83\xa6\x18\x15\xff\x97j\xc5x\x89\xc7\xe8\x8a\xafh\x89\x9e&ui\xd5\x8ce\x80y\xd8\xe8\x88@\xc7\x8d\xbd\xe8\x8d\x87w\x83\xa3hw\xc0W\x8d\xb5\xd8\x84/\xa2\x8d\xe6\x18\x8f\xf28\x8f\xf4X\x8f\xf6x\x8f\xf8\x98\x8f\xfa\xb8\x8f\xfc\x18\x06A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fT\x00\xa2\xa3\xa4\xa0\xa6Q\xa4\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1G\xa3\xaa\x03\xb6\xb7\xb8\x03\xac\xaf\xa2\xb2\xb1\xa2\xb5\xb9\xb9\xad\xc4\xbc\xbe\x9e\xc0\x06\xc2\xb8\x06\x05\xce\xcf\xcf\xad\xaf\xc7\x9b\x00\xc1\xcb\xcd\xd0\xda\xd0\xa4\x00D\xb0\xd4\x90\x00\xca\xcb\xba\xdb\xe7\xbc\x0c\x13\xeb\x0c\xa4\x0c\xed\xe1\x8e\xa2\xe5\xba\xd9\xd2\xdb\xa2\xea\xeb\xfb\x13\xef\xfe\xf0\xf1\x12\x89\"\xc7L\x95\xabb\xda\xf2\xf1[\xf8\xef\x9d\xb7\x80\x87\xc6\x11sepX\xb6g\n\x17\xeek\xd7\xad\x1bD9\x0f\xa1H\xbc\xe8\x8c`A\x8c\x00\xf45d\x07\x0cZ1p\x1f\xd9\xf4B\xa5\xcd\xe4\xc9\x92)\xfb5t8nX\xc9\xff[\xaef\xc6L3.\xe4\x93d\xf4H\xaeJ\xb9\x93\xa3M\x8b\xcc\x9a	\x1dZF\xa2\xd1&=\x93F\xb3%ugVz@\x99q\xbdJ5\x8c\xb5\xa5dg\x01\x00{\xd1dW\x7f\xf3\xc0\xca%8\xb5\xec\x97\xb3A\xeb\n\x19\xf5\x94k\xabe\x18\x9d\xe2\xf2 \xb7\xb0\x81\xb4v\xb5X3H\xb1\xa35\xc7q\xe7:\xe3\x9b\x8bpa\xc3\x88\x13_Y\xcc\xf8\xe9(\x08\x0bB\x8b^\x00a\x14X\x8cO=\xa8\xae<`\xeb\xe5i\x9a\xbbp\xae\xb7j\xc0\xe7\x05\x02r\xeb\xde-\x80\xb4\xe9\\\x18\x0b\x08SM\xdc2\xe1f\xe6\xae%\xcd\x1c;\x14\xb4\xb1\x00@\xf3\x9e>\xdd\xf7Z[\xdcR\x0f&\xde\x9a`\xb6\xc2\xb0\x9bg\xe1<\x19\x00n\xea\xe8\xa9/hY\xde\xa6e\xd6\xad/\x0b;,~\x0bg\xf3\xe9\xf3\xa3_\xbf\x18o\xe1\xe3\xc2\xc9\x07\x1cs\xf5\x1d5\x99n\x1bl\xa0\xdf\x82\xba\xad\xf7\x8ap}\x01\xa6\xccj\xf39s\x0b\x85\xf4\x15\x88\x85(\xb9%\xa8\xff \x83 \n0\x10W\xaf]H!3\xd6\xccG\xa0\x86K\x8c\xd2a\x82!\xc6\xf8U\x84@\x058\xcc\x00\xab\x1dv]A+\xb2\x88\x04\x87\x02x\xf8a\x8c\x0c\xae\x17\x15=\xcaa\x17\xd5\x88@-\x85\x0cLDu\xf8\"\x91!\x96\xe6\xd3S6\xd6\xd8$/n9\xc9	\x00\n\x84YA)f\x000\x1d\x8cT\x16\xb9cT\xdb\x08\x13\x0c+\xb4\xd4\x18\xd4\x97a*PA\x9dcB\xe9\x85\x99\xd4\xa1\x99\xa6z\x10@ \x80\x95lYX\x101\x00X\x80\xd4Rs~	@\x05w\x8a\x89'\x99\xf6\xf1v\xde\x94\x7f\x8a8\x9d\xa0F\x16j\xd3HRY\xa0\xe8@A\xa9\x12\x9e\xa3\x00\xa0\x00i\x9d\xac\xe6\xa9\x97\x14\xf8\xe5FZ\x9fC\x12	d\x83\xb8\x11ZN3&Ys\xc1\x05\xa3X\x10\xc1\xa8#y\xd3\xdf)\xa4D\xca\xea\xa4z:a\xdeh|\xa2\xe7\xa7n\x82\xf2Vmo\xa2\\\x9b\x1b\xa7k\xaa\xe8\x1d)\xbf\n;,-R\xedu*\xb2\xa3(\xbb\xff\xac\x9d\x94\xb68\xdaz\x0czH\xedt\xd1\x92\xa2-\xb7\xb5a\x93\xd5a\xe2F\xe0\xef\x05\xfev\x93\xe1^=v\x92\xec\xba\xcc\xbe\xfa\x0d)\x97\x868+\x9a\xf0\xe6\x96\xa8\x8b\xb8\xfa\xf6\x17.\xaf(#\x95\xbf\x1c\xff\x1bA7\xe7\x16\x0c\xca\xc1\x08\xb3\xdbl\x11\xd1\xc6(]\x90\nF'+\x00\xe3\xc6\xda\x1bh\x0ev\x06'c\x89\xfe\xfbk\xc7\xc6\xf6\\`7\xea\xb6\xda\xee^\x7fj+\xf1\xaf\xeb\x89+\xca\xa5\xa4\x85\x06*\xa2\xbb\xc0\x8c@\x04\xbf\xee<\xf5CX\xfbH\xb0(A'l\xec\x9f\xb3\xee\xb6\x1eh;\x07\xec\xb2\xac4\x976\x11\xa3KE\x80\x00\x02\xbf\x06P\xf5\xdb\"\xff\x9cn\xc9c6,+\x832K\x1c]iT_\x1d\x1dnN\x87\xa6\xf6D\x19K\xf56\xdc\x04\xc8\xfd+\xddZ\x1fuw\x9d\xcf6\xac\xf7~}\x8b\x18\x1d\xccn\x03<\x8ah\xa5\x81v8\xa2\xa6\x02\xb08\xe3\x05\\\x10\x80\xdb\x08\xd4\x1d\xf9\xd6\x8f*P\xb9h \x96f\xff\xa9(U\x03,x\xe5\xc6\x06\x8aW+\xfd\x9d>u\x00\x03\xa8\xbe\xb8\xeb\xaf/\xfcyh\xb5\xdb\xbe\x1b\xcc\xb9?N\xac\xe8X\x97\x96\xcc\xcd\xa6\x0b\x8f\x00\xf1\x17\x9c\x8e|\xf2F<[\x9dz\xd9\xf2\x06}\xe3\xdd\xbb\xdd\xcdz\x0b\x13\x8c\x12\xeb\xda\xb7\xe6}}\n[\x91yo\xe8\xc1\xdc\xf7zp\x07 \xf7\xf1\xf6\xba\x8aQh\xf1\n\xed\xbd\xcd\x19\xf3k\x0e\x00\xfc\xf7\xbd$\x9c\xadA+\xbb\xdd\xc7\xcc\xd79\xc7\x1do\x05\xbdP\x18d\xb2g\xc0\x04j\x06\x00\x8dc`\x03Q68\xc3\x95\x8f:\xd6K\x19\xff\x10\x80\xbe\xeeA\x0e\x83{	\x1f\x000\x10\x00\x0cLL\x02	H\x00\x07;\xd8:\x05\xfa\xef\x87\x048Y\x8bF!\x81\x8e\x04\x11=\xbe{\xa0\x88XG\x00	\xb8\xf0\x85\x0e\x1c\x05\x0dm\xf81\x02$\xa0\x88\xf0\x9b\x9a\xa8\xde\xf6\xb1\xd8\x80\xf0\x87`\x0c@\x10\x85\x88ARH@\x02\x04H\xa3\x1a\xd5x?\xd2$\x91i\x1c\x0c\x80\x13O\xf71\xc4\x00\xff\x80%\x0b\xa4\xe2\x02\xaf\xa8\xc3\x9c\x89JX\xa3\xf2a\x18\x07\x99\xc6\x0d\x9eq\x8d\x88Dd\xcaz\xe3\xc67\xbe\x0c~\x01(A	\x84\xf7\xa8v\xb9\x03\x00\x19\xc8\x80\xfe\xc4\x98\x80 \x96\xd1#\xe2Y\xe0 GI\xca0&R\x91L3\\\xa0\x1c\xe9\x1b\xe1=\xce{B#\x05\x00E\xa1\xa8\x00\xe4\xd0\x93\xc9\x13e)w\xb9\xcbD\xce\x0et\xab\xf4\x1d\xcd\xd6\x93\xc5\x0e\x82	ac\xd2b7\xac\xd8\xc9\x11\x86\xc2\x99\x80\xd0%/\xa7Y\xcaB\x96\xd0z\xc1\xf4\x1d\xe8v\xc8C\x92Q\xce18\xbc\"4\xa7\x00\xc2\xfa-B\x9a\xd4L\xa7\xff|9\x8a f\xb34&,\xe6\x16\xe9\x08\x80\x1c\xf6\x91kx\x1bZU\x80hND|Q\x9d`L\xe3(Oy\xca\xbf%\x91z\xa2\x80\x1f\xc7,\xb0\xb8\x8f\x1d\xe0\xa1\x10\xcd\xa17\x97\xe5\xaaq:\xcb\x94\x16\xcd\x03:\x01J\xd0\x8e*\x92\x14\xee,\x0d\x1b\xa5\xd6\xb1\x98A\xf4\xa4\x0f\xb5\xe7=c\x87L}\xda\xe7\x01\xff\x0f\x08\xe1\x0f\xfbY\x88\x7f\x024\xa0\x1e\xcd\xe9\x1a7\xf7Q\xce\x99\x14\xa5\x11U\xa9P'\x1aK\x9a\xa2\x02\xa60\x95\xa9\x18\x8d*\x88\x8d\xde\x14\xa7:-(\x08\x15)\xd1Q\x005\xa8\xf6<\xa9J\xb16\xb9uUtCH\x0d\xabR\xc7\xd8\x08\x9b>\xf5\xa6Q\xdd)\x07\xd6\xda\x81\x1c^\xf5\xad)M\x80\x08:\xd0\x01\x11\x08\xb0\xab\x14u)V\x00\x10\xd6\xb0b\xb4\xacJ=\xab`\xd7IP\x00\xb0u\xad\x1ch+\\Q*\xd7\xba\x8e\xc2\xae?\xc2kQE\xd6\x8d\xbe&5\x8cL\xf5\x83Y\x07;\xd8D\x16\x91\xae\x88El]\x13\xf0\xd6\x1cv\x80\xad\x00\x00\x81jiJT\xaf\x0d\x91\x14	\xb0lL\x03\x9aY>\x00\x00\x8d\x9c\xcdmO\x1f;\xd7\xd3\xb2U\x04B\xf5\xedi;\x90\xda\x0f\x18\xf7\x03 \xa0\xacd\xbd\xe6\x18\x82\x91\xf6\x009\x94\xedX3\x1a\x07Q\x046\xb7\x1cUc\x11E\xc0]\xee\x8e\xc2\xb7\x87\x15\xadh\xed\xaa\xda\xe3\x1a7\xb9\xb0Z\xff\xae\x9d \xc5\xde\n\xa0\x00\x05\xa2x\xaeVckY\xccR\x17\x0eS\xc5\xeeSw\xfa\xc9\xeezW\x14\xbd\x0d\xadpE\x01\x02\xe4\x16\x18\xb9d\xdc+>Y\xe5\x00\x07\xc87\x01\x0e\x80/\x00\xae\x1a\xdd\xbeN\xf7\xben\x98\xeau\xf5[\xcd4\x16qa\xdd\x05\x00	H\xf0]\xb6:V\xc4\xe6]-\x86\xbb\xda\xe0\x077\x18\xbe\xa5\xa5\xafXi\x8ba\x99\xac\x91\xc3\xbc\xe4\xaf\x0c\xbdK\x02\xd5\x92\xb8\x1b\x05&\xc1q\x93\x9b(E\xdd\x85\xa5\x15h\xb1=\x1b<&\xb8Vx\xc6\xfc\xf4'\x1ao\x8c\xe3\x81zX\x83\x00\xb0\xab\x88U\xcbe!\x9f\xd7\xb8\xb4$V\x8d	\x16\xa9$7\xf8\xcc\x0eh\xb2\x93e\x8cT\xfb\xeea\x83 Md\x95\xa1\x1aD\x13|8\xb2\xa3\xe81\x08\xbc|^\x12\x8b*\x1f\x0e\x11\xc3\x82[*_\n\xb3y\xb6\xfc\x1c\xb3H6\x18\x82F;\xfa\xd1\x8d\xbe-A\xe7\xbcS\x13\x98\xa0\x90J(\xb2(\xf4\xec\xe303\xe5\x1f\x8a\x1e\x02\x9c\xffEq\xe6B3\xf6\xd0\xd3}\xc3\x02!\xcd\xeaRn\x96\xb0\x1bFk\xa5/\xcd\xc6\xc8\x8aK\xcc\x19TTSB\x1d>4\xfb\xda\xd7\xa6\x8e\xab\x85\xc1X\xdb=\xa93\xd2\xed\x9c3\x10\x15ii_\xce\x82#9\x1b\xd77\x9a\x12\xe82\xfd\xfa\xdah6\xf5\x93aJ\xe36\x8c\xba#\xa2\x96@g\xd3:F;;;|\x1b\xd1\xe7\xa7\x1b\xc2k\x94a\xfb\xdd\xc0\x166RS\x1dM\xc1\x92\x1b\x97\x92V\xe4,4\x02\x10\x94Q\xbb\xdd\xdd\x80\xb7\xc0\xb3\x0da(\x8b\xf0\x0f\xaf\x9e&\xb9\xef\x8c\xc1\x82\x86O\x1f\xfc\xe8\xb7\xbf\xbd2\x06\x004\xe0\xe2\x18\xcf\xb8\xc63>\xf03#\xb5\x012%\xabm\xc5\x9d]\x9d\x161$\x1a>d\x13\xc9rG\x8d0g\xdd\xd56\xcb\xc6gN\xf3\x9ao\x1c\xa6\x17\x0fy\xb1\xd7\xe0\xd4\x0e\xe7\xf4\xe4\xdf\xf0\xf0\x19\x87\x8e\xf2Q@<\xdd\x99\xde\xb5\xa0m\xce\xf4\xa6\xd7\x9c\xb6o\x8e5!\x7f^\x17I\x0f\x9d\xe8\x9f\xd4\xa4?\\\xce\xff\x04\x98K|O5W\xf9\xbd\xef=\xd3v\xdb\x8f\xe49\xee\xe8\xc9\xd3r\xdb\xab\x9fq\x14\x99\xd4\xfa?\"\xbe\"\xaf\xf3Z\xc3c\xcf{G\xd7\xb9\xf3\xaa\xe0\xb6\x97T^j\xfd\xda\xeevQd\x00\x03\x88\x97\xfb\xdc\xfbQ0\x98\xdf]\xefy\x7f\xfb\xb7\xcd\x0e\xab3\xfa\\\xe7B4c\xe1\x0d\x8fx\xc4{=\xe6]\x87\x0c\x18\xf0~J\xc9O~\xd4\x84h\xfb\xd4\x05\xcaw\xeb\xe2r\x16#\x10\xc5\xe6sx\xf8\x1f*\x9e'\x92\xcbd\xe7=?\xfa\xc9\x1bl\xe8\xbdl\xe7\x953=\x82\xd8_=\xbe\xb4\xa7\xa1\xedAy\x94\xb8\xef\xde\x86\xe0k\x11\xf0	)\xfc+\xbf\x9c\x02\x14(~\xdb\xebi\xcf\xb8\x83\x11\x03\x9a\x0c\x85\xf3wOy_\x10\x1e\xed+\x17\x05F\xeb\x8e\xfd\xec\xc7>\xbeq\xef~\xe2\xfb.j\xdd'>\xfc\xd1\xff\xd1\xd5\xd3\x9f\xdfD\x87\xbe\xfd\xd8W|\xb1gKB\x95\x00\x9a4N\x98\x14\x7f\x12\x95\x7f\xb3\xb0\x7f\xd5\xe7\x7f]\x07\x80\x00\xf8~\xff\xd0eOz\x05+\x19@{\x16\xc8\x80(s|\x00\x90}'\x80wL\xf5\x81\x12\x18\x80\xb1W\x81	V\x05\xdc\x97\x81\x0b\xc8\x81\xca#\n\x14\xa0\x01\x1ap\x02!\xf8v\"Q\x82\x14`U}$h\x1a\xd8\x82.\x18>1(\x834\x98\x82(S\x82\x13\x06Q\x94\xb7\x82\xa4U~\xc7@\x822(\x83\x02\xe8:$\xd8~Gx\x00\xe5\xa7\x84\xd0\xc5\x84\xe8\"\x83A\xc8\x85\xef\x07+\x00x\x84ZXO\x19\xf8PZ82]\x88}^8BS\x98\x83g\xc8}\x18\x80\x84gh0O\xa8\x01\x12\xe8Lm8\x87\x18\x94\x00q\x18Q\x17\xa8!\x00P\x87vH\x84\x11\x88}z\x08\x87\x14\xa6\x87\x97\x10\x88Ox_\x7f\xc8\x83}\x88R_\x179\x1f\xd8\x88\x8c\xb0\x82'\xb5\x0e\x8a\x88	\x8c(\x83\x9b\xb8'=\xa8\x89\x9f\xb8\x88]8\x8a\\\xc0}	\x80{?\x88A\x96xNp\xb1\x8a\xa2\xf6\x88ME\x7f\xb0X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x0c\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8cU\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fV\x00\xa2\xa3\xa3\xa0\xa6T\xa2\x05\x06\xab\xac\x06\x05\xa5\xa7\xb1J\xa9\xad\xb5\xac\xaf\x00\xb2\xbaC\xb4\x03\xbe\xbf\xbe\xae\xaa\xb7\xb9\xbb\xb1\x00\xaa\xc0\xca\xbf\xc2\xc3\xaf\xc6\xa6\x00\x06\xcb\xd4\xc1\x05\xd7\xaa\xcf\xd0\x9d\x00\xd5\xca\xcd\xd8\xb8\xb0\xdb\x99\xdd\xde\x03\xe0\xe1\xaf\x0c\x13\xed\x0c\xa4\xe3\xe4\x8f\xe6\xd5\xe9\xea\x00\xec\xed\xfa\x0c\xfc\xfd\xc5\xf2\x8d\xbaM\xa3\xa6\xae >}\x08\xfb)\xfc\x070\x91\xc0j\x05\xef\xb1\xe3\xb7\x0f\x9e\xc5\x86\x88\xba\x15\x80\x18\x11\xdbA\x85\xef\x90\x85\xab%\x0e\xe3 i\x1b	^\xbb\x15\xee\xe3\xc2\x81\xccV2s%\xca\xe4\x1f\x940\x81\xadd\xe9q\xe2K\xffo\xab\x80\xad\xc2e\xf3\x8e\xc5Q\xcb\x92Yk\xe9\xd3_\xcezB\x831,\xeaf\x14\x84\x05X\xb3.\x80\x80t@\xca\xa5=A\xf2\xa3wn\x19\xcc\xa9T\xd3\x88\xba*\xa0\xad\xdb\xb7\x02\xb6vE\x17\x11\x1f\xc8n\x1e\xca\xeaE\x0b*\x1e\x1cQ\x0b\xe0\n\x16,\x17\xa5\xc1u\xfe\x06\xe4\xd5\xbbW\x16\x00\x05\x90k\xfe\x0d<\xb82\xe1T\xf7V\xd9\xc5\xeba\xf1\xaf\xbc\xd8\x9e\xd6\xe3\xcb\xe91\xe4\n\x15\xfc\xa2\x01`\xb9\xf5\xe0\x05\"\xaf!c%Jq\xe7\xdb\xbe\x16o\x14&:)\xe9M\xa2PCV\x90Z\xf2\x19\xd6\x1b6\xb8^\xee6\xb6\xb4e\x9emw\xa6\x1b\xf3\xebh]\xc1O\x9fV\xfd\x05\xb9r\xe6\xe0E>\x07\x16\xfd\xb3/\xa5\x8c\x7fis\x9c}8q\xeeZ\xbc\x83\x9f/\x80Voe\xba\xd3\x7f\xfb\xdd\xb7\xfd\xf0\xe2\xfcM\xe1\xddw\xf41\x87\x0c:\xfam4\x9dY\xd7\xe4\xb6\x98\x01\x01F\xe3\xdfv\xc6\x85\xd2Vr\x04\x16\xe8\x1al\xc10\xe6\xff\x8a\x83\x0f2C\x965\x11\x9e2J\x05\xee\xbdWa\x14\xac	\xa0\\r\x1a\x82\xc7U\x87e\xa1\xa7\x9e\x83\x10>\xa5YQ\x13RX\"\x11-\xbe\x05c\x8c\xafA\x00A\\\xf4\x04\xe5\x8du\xd6\x08u\xe0L\x10\xa6ub\x8a*\x96\x18d\\n\x0dI\xe4\x95\x02\x1c\xd9%Y\xae\xf4\xc6\xa4W0\xd1d\x1dmi\xf1\xd2c\x95I\x04\x89\x95`Z\x16(\xca[\x94q\xf8\x8d=L~\x18\x0c\x84\xb5\xed\xb9c\x9a@\xae	`\x11\x00h\xe5\xe5`qZvh}\x00\x1c\xfa\xa6\x9d\xe7@\xc8d2\xb4Y \xca4a\xfe	(\xa1\x82\x96RhV\x8bV\x86\xa1[\xa1\xd6\xe7\x16ls\x92\x1a\xd7\x8c\x91>\xa9\x1e1\x16X*\x8dfC\xfd\xc8c\xa7\xa0r)\xa3\x8b\x04\x06\xc9\x15`\xaab5\xa2Y\xe3\x05s\xc1\x05\xb8X\x10\x81\xac\xb5j\xba\xe9,\xfe5j$\xb0\x05nu\xa1r\xb05\x17k\xa3\xa4f5\xec~9I\x03\xc0\xb1\xca.{)\x9f\xaa\xd8\xfa\xff\xec\x94\xa3(\xa0+sl\xd1y,\x92\x11D\xc0m[W	\xab$59\x8a\x08@\xb9\x17\xd4;\nM+ \xa3\xee\xb3BL\xe9n\x8c\xa5\x1a)\x00\xb9\x02\xfb\xea\xed0\xfb\xaa7\x1e\x9f\xe5\xd6\x1b\xf0\xc0\xc5\xac\x880\x14\xa4hh-\\\x8d\xca\x15\xb0\xbd\x85\xae\xea-o\xb6\xf0\x89)\x84\x16\x1c{\xb2\xc0\x05\xafw\xf0\xc7\x84:*c\xa8\xb0M\xab\xec\xc6\x9f\xc2\x86\x15W-\xd36[\xad1\xcb\x1c\x01\x02\xf6\x16|3\xceH\xa4\x8c%\xbcR\x93:\xae\xd2L\x03&\x97\xbeECho+\xff\x1e\x1b\x80\xcc\x08 \xd01\xd4Y\xa4\xfc\xe6r\xd3R\xd6\x9c\xccd\xcb*l\xc9\xe7\xb6\x0c\x80\xd9\xc4Dp\x01\x01c\x1f[\xf6\xd3h\x1fQ\xf2\xdat^Fm[\xb0\xed=6\xd3f\x03\xc6U\xc1\x8f\xcfj\xcb\xdd\x92\x03\xb0\xf4\xde\x05\\\x10\xc0\xd2f\x07\x9evV\x84\x0df\xf9\xe1\xf5\xe9\x1d\xc0\xe2\x7f\x03\xd6\xf1?G\x83\x8d7MeG\x10\xc0\x00\x9a\x97\xdd\xff\xb9\xe7R\xc0\x93\xaf`\xf1\xbe\xc5U\xe3n\xc3\x86\x80\xe6~\xff\x0d9\xeb\x81R\xec5Jw\xdb>\xfb\x05\xb6\xdf\x8e;\xb4\xa4H@\xc0\xf5\xa3\xb8\xdd\xd6\xbdt\xfe^\xb2\xd5\x08\xf0]|\xe7\x1es\xda\x92\xc1\xd1\x97\xedU\xf4\x80K9\x8a\xf5\xa7\xc7\x1f\xc0\xf5\xd8\x07O:\xbe\\\xd9K\xb8\xe5\xe1K\x00\xbd\xedgc\xc2(d\xc3\xbf\xf4]\x83}\xd3\x0b\x14\x00\xf8&\xbf\x06\xca\x8f\x00A\x13\x05\x04	\xe3\xb3O!\xaey\x01\xf0_\xf4PF\xbd\xa3\x140}\xe9k\x1f@F\xc1@\x07\x9a0~\xa4\xa8\x1f\\\xb6b\xa4_\xad\xedw\x08\x08@	J\x10\xc2\xe2pJ\x14\x18\x08\x00\x06,g/	$ \x01\xcd\x03!\x02='\xc1\x13\x1a\x91~H\xa4\x1f\x97\x86\xd6B\xae\xac\xed\x83\xe3\xfb\x9b\x8f\x9c\x06\x80v\x00\xe0t;\xcc\x1a\x01\x12 \x81\x0f2-V\xb1\x13\xa11\x8ahD\x07&\xf1\x8c\xd8;\x15\x13\x9bhA\xe1	\x11\x80)J\x0d\x00\xc7\xa2\xc3\xbb\xd9\xff+\x00\\\x04\xe2\xbf\x96\x15+s\x89q\x17\x0b,\xa3\x19\xd1x\xc6\xc1\x81\xaa\x89m\x0b\x0c\x0c\xdf\xd88\x00\xa0(2\xb1\x8b\xc0X2\x90\x01\xcbYj~	\x80 \x15IADA60\x89G\x84\xa0\xa1\xd6\xc2\xc6|\xc1\x86s\x08\x00\xe3\x06W\xe7Hwyp[[\xcc\xe4\x1f1rEO\xc6\xefz\xb6<\x9dE \xc8\xc6\xc1\xe5/\x92\xcb\xb2]\xd3\xd4\xa4\xb0\xff\xbc\xef\x87]L \xa1ry\xba\x122\xf3\x96\xd8\x9b\xd6\x02K\xc6\x15\x08.\xad^\xd8\x94\x15<~\xc8\xcdb\xfah\x96\x0d\xa9\xe53\xc7iB\x12F\xb3\x9a\x10\x14E6E\xf1\xc3\x03\xb8\xf3\x9d\x07\xe0f7{4\xa82\x94\x8f\x12\xe2$\xa7>\x07\xc9\xcb\x05b\xcf\"\xf0\x0c\xa8@\xe5\xa9\xc7V\xba\xa7\x9e`\xb8b:1\x91\xcf}:\xf4\x81\x12Tb\x02\x04J\xd1\x80\x12\x94\xa0\xded\x93\x17\xc4\xa9\xc9J4\xf4\xa1 \x9d_\x12\x01P\xd1\x92^t\xa2\xee\xd4c\xc2\xe8	\x1f\x01=\xc0\x99\xff\xf7\x0c\x883C\xea\xd0B\x96t\xa0\xdc\xa4\xe8\x0f\xd1\xd2\xa9\x98>\x01\x00\x0f\x08*D=\x1aH\x9a\xd6\x14\x89]\xbc\xa9R\xdf\x99\x00\x11t@\x04\xa4\xc9\xa8Fs\x17\xd4\xaa\xc2\x14\x9c{ #9\xe9wT\xfa%u\xa97mj\x07: \n\xa8R\xef\x91\x07\xf5i\xd4\xaa\xca\xd6\xa1\x12\xf5\x99\\}(R\xcb\xdaN\xb0Z4\x01c%+\x08@\xa0\xd6\x95\x1a\xd4\x98}\xf5+[\xad*\xbf\xc0:\xc4\x93H\x04\xe9\\\xcb\x9aW\x11\xd4U\xa9?t*\x078\xa0\xd7\x0fX\x96\xaf?e\xa9Z\xe11X\xa1\xde\x12\xabY=!(\xe5\xeaU\xe3\x88\xa2\x03\x93Mm\x07N\xcaM\xd4Rv\xb2z\x05\x81e/{\xb0\x8c\x02\xe8(\x9b\x1cEg\xaf\xeaQg\x8ev\x9fI\xec\xa2\xc7N\x0b\xdb\xb1\xa6\x96\xb2\xae}-e\xa1\n\x00\xd9\xeeu\xb6\x1f\xc0l\xee\xe8\x89\x9a\xeaV\x00\x05\x92a'7;\xeb\xd6I\xf8\xf3\xb7\xb6$di\x01`\x82\xf2\x0e\x97\xb8\xc7M\xff\xaer\xc9\xda\\\x12\xccV\xb6\xd1mi\x13\xbc\xe9\x80\xfa\xda\x17\x05\xd8\x05\x00J\x99\xba\xdb\xc2\x82\xd6(\xd6K,3\xc5K\x00\xe1\x9a`\x86\x086ATG!Y\xd52\xb7\xb9\x96u/m\x0d\xeb\x84vA\xc6\x01\xfbM\x80\x03\xf2\x8bS\xee~\xd6\xbb\x02\xce\xa5x\x0d\x8c\xe0\x12\x94\xb0\xa3m:\n	\xe0{\xd9\xf8\xfe\xcbR\xdd\x19\x0e\x86\x99\xbaa\xec\xea4\x01\x83\xe5\xadQ<\xc8c\x0f\xc6u\xc0g4py\xcb\xcb\xb7t\x8a\"\x99\xd0\"\x81\x92\x9f\x0b]\x17o\x0b\x1f\xffM\xd8#\xeb+O\x07\xa0\x86\xa4:\xedo3\xa3\x1c\x9f\x10x\xf9\xcb`\x0e\xb3\x98\xbd\xec\xcfq\x06Y\x14C&\xf2\xfc\x8e,\xdc\xc0\x8aB\xc9K\xde+	Da)K-\xa4;\xa6\xa9\x80}\xed{\xe5\x8a\xfe\xd0\xc3\xba\xe42\x16\x00\x10\x82}\x02\x00~fF\"\x9b%\xc0h%\x1e\xfaz\xe6\xa5\xf0+\xfd(\x16A\x9b\x86J\xdb\xd9\xef]s\xfca;\x88\xc2\xcbe$3<\xffp	WE\xbfo\xb1\xf4+/\xa3\x9f\xb6\xc7z\xc9\xea\x1dbyGB{\x0c\x00*\xfb\x19\xd0\x01\x10\xf4\xa0i]\xa1GoU\x89\xb1z2<\x02Ld%\n\x88\x14\xf9\xb0\xcb]\xc8P\xeb=;\xdb\xd6\x9bfk	)\x9c\x07_\x97\x9ao/\xce\x98\xb9V*\x81b\x976w\xf9H\x88\xb2\xef,\x86f?\xfb\xd9\x9aN)\x8e\xdb\xba\xe5CX\x1b\xc8\xb9~16]\xcd\x90\x88\x8e\xb4\xc2\xf0@\x88>\xc6\xed\x0ff\x9f\xfb\xdf3\x86\xe7\xba	\x1bhC\x14U\xc4\xd8\x86G\xb0al\x84\xef~\x1bZ\x19\xc0\x80\xc4+\xa9ow\xf0[\xd6]8\n\xc07\x9e\xe1\x81{v\xcd\x8584\\s]0S\xc3\xc7\xe1\xd8\x8b\x9a(\".q\x0cT\x92\"\xfa\x86\xf5\xb23\xde\x80\x9a\xdb\xfc\xe6\x1b\xff7J\xffL\xf0x\x13\xe2\xa3G$y\xc1f\xe8\xdb\xa8\x16\xd2\x83\xdcd\xb9\xc4\x95]q\x99\x93\x9b\x0b\x00\xb8\xb9\xd4\xa7\x8es\x8e\xc7\xd3\x01\x83\xed\xb4 \x80~\xffB\xd6\x95\x98\x81\xbfAy\x17Y\x9b\xf4\xc2*D\xdc\x95\xee\x0e\xd5\xd7\xcev\x9b\xa3[\xc3\xd2F\xa1\xae\xcb\x8d\xd8z_\xaf\xc4%\x88j\x80\x19\xdd\xc5	\xf8\xf9\x87\x190{\xacE\x91\xf6\x8d\x06Y\x02mO<\xdb\xab\xda\x00\xad\x03\x82\xeb(\xf4\xeb\xc1A\x1e\xb5\xeb\xf1\xbd\xef\x02m9%\x03\x8f\xc2J\x03\xa0\x92\xe3\xb64\x81	\xcch\xc5O=\xa8\x8d\x97\xfb\xd6g\xfaY\xad~R\xef\x05\xe6\xbb(\xfc\xee\xce\xf8\x11\x94\xf3\xa7\x03=<6\x0f\xfa\xb1$t\xf4\xc0\x1f}\xe9\xa9\xde\xdd\x9bL>\xd0\xac/l\x9b,/\xfb	\xe4\xf0t)\xdd&%'\xde\xeb\xcdS\x9f\xda \xe3q\xa3\x83\xcf}4\xfaw\xebd\x84\xa0\xf7\x1dO\xa8\xd8\xaf\xba\x8a\x02_\xd1E\x80\xc4\xfb\x96\xbb|\xee*\xe7\xf5\xb0\x03\xdc}R\xa3\xd8\xf8)$d\xbb\xa3f\xbd\xcb\xcf\xfe\x9d\xd8W0\xd6\xe7~\x95\xd4\x07\xf27\x7f#\x05\x7fW\x00\x0b\x00@\x01\x140\x02(\x87F>uh\xff\xfeW0\xb4\x078\xfa\x95\x00J\xb7t\x90\xd0cx\xd0\x80\x0e(\n\x14\xa0\x01\x0f\x18\x81\x8e\x16!\x14({\x05\xc3\x00@T\x05\x19\x98\x01\x1aH}\xca\x042#\xa8\x01$h\x83\x14p\x02b'_\xc4T\x81T\x14\n\x807O\nHK5x\x83$x\x02\x10X`<H(\x10\x98\x82\x01\x185A\xb8S3H\x83EX\x83\x0e\xa8\x83O\x08\x00#\xb0\x85\xa4\x00\x81i\xa3\x81\xdd4\x854h\x83$h\x85[\xe8\x85\x02\xe2\x80\x0fx\x86h\xb8\x80`(\x85b8_EX\x86\x0e\xd8\x84OX0j\x98\x87m\xe8\x82Q8\x84&\x01\x00dH\x82\xf00hy\xa8\x86{\x88\n}\x18\x87\x15\x86\x83K\xc8\"\x85\xd8\x84\x83\x96\x00\x18\x00\x87\x8a(@\x8d\x98\x86z\xf8G\x19\x88\x01\xf1\xe4\x87\x95\xb8\x04 \x18\x82w\x08$?\xc4\x89\x07\xe0\x89\x9f\xd8&\x858K\x9b\x08\x80\xa9X\x15\x85H\x01\xa3\x980\xa5\x08O\xa8\xf8\x8a@\x12\x8b\xa2\xc8\x87\x92\xa8i2\xb3\x88\x8b\x98\xa8\x8b\xed3n\x19v\x8b\xb8\x08\x0f\xab\xe8\x82\xc8V\x11\xc0\xd8\x06\x83\x18\x1f\xcf\xd8\x8c\xd28\x8d\xd4X\x8d\xd6x\x8d\xd8\x98\x8d\xda\xb8\x8d\xdc\x188A\x00\x00!\xf9\x04\x05	\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fS\x00\xa2\xa3\xa4\xa0\xa6R\xa3\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1I\xa2\xaa\xad\xb6\xad\xaf\x00\xb2\xbbB\x00\xaa\x03\xc0\xc1\xc1\xae\xb5\xae\xa2\xbc\xa7\x00\x06\xc2\xcc\xcc\x06\x05\xd0\xac\xaf\xc8\x9f\xca\xcd\xd7\xc3\xd0\xd1\xcf\xba\xd4\x9b\xd6\xd8\xc2\xc4\xda\xe4\xd0\xdd\xde\x97\xbe\xe1\xd9\xe5\xe5\xa4\xe7\xe8\x94\xd6\xcb\xd7\xcf\xed\xe4\xa2\x0c\x13\xfb\x0c\xc7+\xb0\xf1\x1a\xf9Z\x85\xed\x9e;}\xfb\x12N\xe8\x07\x80\x81C\x7f\x01\x13)#\xd8L\x9b\xbdv\x0d\x15*t\xc8\x91#\xbc\x88\x84\x06\xfeb\xb6\xcd`F\x8e	\x19\xbe+\x05\xb2\xd0\xc4\x02\x15\xa5\xdd;\xd9\x91a9[\xb9>\xb6\xec\xa3\x0c\x1aI\xff`\x171.\xac	\x8e\x1d\xbd\x01\xc6t\xee\xc4#\xf2(Rv\x18\xf5\x11u\xea\xcci\xd2\xa5vV\x8e\xa2\x1a\xcc\xe0\xab\xa1\x1e\xb96\xb3\nT)V5\xa3 ,X\xcbv\x01\x84Q\xceLJ\x0d\xbb.\xdcQ\xb3\x9eX\xd2\x11\xa5V\x80\xdf\xbf\x80\x05\xb8\x85;\xc0kC\xa2\x03<\xd4]\x8c\xf7\x9b\x02\x05\x15*\x00|#jA\xe0\xcb\x97\x07\xfb\x9a9*\xac\xe2\xc5u\x0d4\xce\x04\xe0q\x85\xc7\x90'\xa3\xb5\x8c\xb9ufZ\xeej\x91\xf2@\x9b\x99b\x8b\xa0G\x93\x16u\x1a\xb2i\xd5e\x00\xb8\x1e\x8ey\xc1fs0m\xd3^\x0eL\xf13\xa4\xd1\xeaN\xdb5\xaa7j\xc9\x10\xc5\x08\xdf\xb0\x81\xb8\xf7\xbf\xc7\x8b\x06\xfb\xdc\x9c\xf9H\xe8\xc9\xb1\x89\xf6V\x1d\xf5\xef\xec]\xb6w\xffN\x7f\xb3\xf8\xc4\xd7>\xa7\x07\x0dl:{\xde\xee\xa5\x06\x1f\x16\xf2\xd1g\xa0\x00\xb4P\xb4\x8es\xfb\xf1\xb7^D\xed\xb9\x87\x9dnM\x00`\x19w\x07\xd2g\x1cLb]\xf3\xffKmc\xf9\x94\x98s\x14\xca\x12\xe1u\xc0E!\xdf|\x19z\x07N\x87?\x95G\x9e0\x00\xc4U\"u\x00J\x98\"\x13\xc2	\xc0\x1d\x8b-f\x06\x01\x04\x02\xbc5Lh\x0d\x1eY[\x8d\xe2<x\xd6?9\xa28 \x12\x16\xfa\xe8\x17\x86A\xb6F\xa4qM\x86s\x9e0\xfb\x89v\x14+7\xa2s\xe2{\xa3\xf5\x08\x18\x96Y\xaa)\x98_F\x8a\xf3%t\\\xbdB\xa3:@\xadRf@g\n\xa8T\x95\x7f\xb1\xe6#\x90\x07\x8a\x02\x98e\\\x8e\x15Tt`\x8a2\x0ca\xad\xec	R\x9f\x13\x0e\x01\xa8_\x82\xfe\xc5\xe6pD\x82\x07@\xa7o&\x8a\xa4U\x90\x02`\x81\xa3\xb8H\xba\x13\xa5i	\xda\x17f?\xfe\x05\xaa\xac\x81\x8eB\xeb`\x8b=\xe3\x94\xa9\xa7Bg\xaa(\xae\xe8\xf9$*Q>v\xa9[\x1a\x12\x89%\xb2~\xfd\xaa\xe6\x96\xa2\x863\xd1\xa3\x17\\0\x11\xaf\xc0\x8a\xe9\xdf\xb0*\x02h!\xa2ne\xca)k\x18\xbej\\\x04\xa7\xb2\xa6\xff\xd6Z\xf793-R\xa6F\x10A\xb5\xaf\xa0+\x92/\xaarKDem1\xfb\xdd\xac\xcdVk\x9c\x05\xf2~\n'[\x03u(&=\xa2\xc9\x1b\x81/\x17\x14\xdc\x13\xbe\xfa^\xf1m[n\x12\xe7/\xa6\xdfV\xeb\xb0\xa1E\"\xbc\x8d\x82e\x11\xc4\xcd\xc7\x00\xcc\xfbpN\xf9Vl\xe9\xa7k\xbd\x95\xf1p\x06\x03\xf6\x96Z\xa6F\xfc\xb0p\xe1\x0eF\xcc-z\x9a\x1co\xb5:K\xdc\xb2\xcbF\xacd\xe0\xa5\xcd\xf2\x95\xb2\xca\xfc\xae\x9b0\xd0zN\xa4\xa7\xbcDG\x8c\xc0\xc3H\x831\xb3ko\x89+J\xd6\x11l\xcd\xaf\x852S}\xb5\xd5\x0dW\x1b\x00\xd1\x08 pt\xd7\xb3\xd07$\xd3\xc6e]-\x02\xa7\xaaus7l\xdb\x02\x80\xdc\xd2\x0c~\x01\x01o\xef-7\xdd^|}\x99\xcc\xaf6;/\xe2\x17l-7\xda\x9f\xc2\x138\x99r\xcbV\xf6\xe1\x05\\\x10@\xd9\x8b3\xfe\x84VJ\x7f\x97\xf2\xb7\x80\xa5,\xfa\xdbq\xcb-\xf3\x80Ss~\xff\xea\xd5[\x070\x80\xe8\xb1\xcf\xbd\xea;\x12\x10 \xfc\xf0\xc4\x13\xe0x`o\xc9\xbdV\xad\x08\x88\xae\xf8\xe5\x14\x1f\x91 \x99e\x0f4x\xdc\xbaW\xde\xbb\xe9E\x90\x12|\x00\xe0\x87\x0f~\xf1\xc3\x1fo\xf3\xe0\xac\xc398\xe5\xda_\xbe\xad\xf4\xc7\xd5\xbb\xf2\xf5q\x17\x16{\xe9\xa6\x8f\xf2\xbd\xf8\xfc\xf7_\xbe\xdd\xab[\x9e\xe4\x10@\x00	\xb4On\x95z\x19KRQ/Q\x90.n\xd0\xb8\x9f\xefp\x04\x00\xc4\xf5\xef\x82\x184\xde\xbf\xde\x92.\xd6\x18\x07{\x06\xbc\xdf\xc3\xd04\x8a}\x00 \x03\xa8[\xdd\xfdV\x18\xb7	&\xa3\x82\x18\x8c\xa1\xf8\x8ag+N\xdd-}\xc9C@\x00JP\x82\x15\x96\xc6=\xf4;\x0c\x000\x80B\x82\x01@\x02	H@\xcaX\xe8C\x97\x89\xc2\x822\xcc \xf9\xfe\x07\x1e\xe4\x0d\xe9n\x02\\b\xdc\x9e\xd7\xc2\x1c]\xaf`\xfd\xc8\x00\x11\x07w*\x02$@\x02Z\x8c\x1b\xba,\xa0F\x17\xe6\x05\x8aQ\xf4\xdf\x14i\xd84+^\xff\x91u\x1fd\xe2\xf6\xa0\x94BQ\xa0\x90o\x00\x08\xc0\x19\x95\x18/\x0b\x18\xd2^n\xecD \xe3(E\xe1]\xf0\x7f\x15t\xd3\x15\xef\xb8\xbc\x1c\xea\x11\x7f\xbd(\x96\x9f\xcahF\xe3\xf1Q/\xdcZ$#\xe5\x18G\xe1UP\x83p\x9a$\x16-\x93FC\xae\x90k\xf0\x03\x80u\xd0\x94\x00O\x8eaJ\xf2\x18\xe5#u9>T\xf2e\x92|a\x17\xe9\x1c\xc6\xc66\xc2/\x89\x84\x94e\x80\x12\xa8\x1d\xe3%\x92\x0f0\xe4\xa54/8\nS\xde\xd0\x94\xecZ\xa2\xc3\xe4u*\x9d\x88\"\x01\x078\x002\x93\xc8*\\\x12(|\xb6\xac\x04)\xe08Mi\x12\xaf\x9a\xe5\xd3\x8c\x03\xb9	\x9fQ\x803\x9c\xf8\x14\xe78\xcb\xf9LQ\x82\xcf\x9c\x90\x10E;\x07\xca?\x1a\xbe\xc5\x96}\x04@\x12\xf3\x99\xcfq\xee\x93\x9fY\x00\xc0\x03\x1e\x00\xc7t\xa6\x83\xa0\x18\xed\xa5)#i\xcf{\xe2\x13\x99\x0cm(H\xf5\xa99M23\x14\x13\x9d(\xff\x00\xfa\x08\x7fft\x9a\xff\xe53\xe5BC:\xd3\x90\x86s\xa475\x0bDU\x94\xd2\x94V\xf4\x99\xd0|\xe9@\xc9\x07\x00\x9b\x1a\x95\xa18\xcd\xe9\x12vZ!\x89\xf6T\xa5\xe2c)#\xa2\xc9HG\n\xb5xh<\xeaQ\x93zS\x11\x94\x88\xa9\xb3\x18\xc5S):C\xa0\xe6\x81\xaa\x8f\x1c\x9eP\xd19\xbc\xacj\x95\xa6\x1eE\xaa\x08\xbc\xda-e\xea(\x85\x99\x14\xebXWj\xd6\xacX\x95\xad\xc5[\xabF\xd1\xa8\xd0\xb7\x1a\x16\x9f\"\xe8\xc0\x8e\x9aj\xd7\xebD\xe6\xb1\x15@\x81?::\xd6\x9f\xaas\x8ej]+\xf1\x08\xdb\x81\x0e\xd4\xf4\xb0F\xed,\x00@ \xd5\xa5F\xc9\x01\xa8M\xad\x03P \xd9\xa2\x8at\xafQ\xed\xeb\x1cN9\xc5\x8cN\x91\xb0\xba\x00@g; \x82\xb8\x82\xf6\xa3\xa2\x05\xc1\x07H\xab\x85\x1c9\xc0\xa3	@mk\xe1\xfaT\xcbF\xe2\x88\x01\x08,L1[@\xd5\x00\x80\x03\xd8\xe5\x80g}k\xd8\x0e`w\xb4\xc2\xfd@\"E\xf1\x98\xe3~T\xb9\x92\xb5\xffi\x12\x9f\xca\xd7\xe7\"\xee\xaf\xee\xc4,a%\x80\xc6\xee%v\xb7\xbc\xfd\xacQ\x13\xd0Y\x0e\x8c\xf6\x03$\xf8\xc0p\xfbY\xde\xe3\"\x13\xb5\x91q-M\x9b[\xd6\x80J \xbaC\x9d\xe3|q\xfb\x11Qx7\xbb\xd8\xdd\xaez/\xac\xd8\xf0\x92\x00\x94\xc5=M\x05T\x9b\xda\x04\xef7\x01\x0c\x0e_i\x05\x81V\x19\xb2\x13\xb3+Q\x01\x00L\xf0'\xddf\xb7\xbf\xdau\xa8w/\x0c\xde\x0fc\xcb\xac\x9a\xd4\x11wo\x8a\xe2\x9e6\xd8\x11-\xdeeA\xa7H\n\x15\xa4\xe0\xc9)\x98\xb1N\x01p_\x0ck\x17\xc3<&\x81\x8f\xe9)\xdbO\xa2\x0e\xb5C\x16g\x8a\xff\xd9R\x97\xce\x90\x9d\xd1e\xb2	\xd6L\xe3w\xac\x19/\x16\xc62\x96yK\n\x82\xed\xec!d\x00\x00\x89\xf7\x0cf\xe6\x1aY\xc5]n\x83\x99\xd3\x9cV\x1a\xb2\x99\x87\x88N\xb4	\xea\x1bV*_8\xc3^\xc5\x16\xc1N\x85\x90	\x04\xba\x17|\xce\xb4o\xd7\xebSt^z\x0d.\x85/`\xff\xdfI\xdfR\x97\x1a\xbe\xd5\xe5\x91\x85E\x9b\xdb:\x03@#\xfd\x08N\xa6g\x1d\xd7\"\xa74\xb6\x83H\xa8\x05\x89W\xe8\xf2y/x\xe4\xa3\xefbc\xc9\xc7\x0c\x18[\x14\x1a1\xe1\x17\xb42\xebf#\xd7\xd6d\x1d\xdf\xa7\x19\x9bPQ\x84\xe0\xda\xd8\xcev\x08h+j\x8d\xfa\xda\x90\xdd\xecc(\xbe\x99\x001b\xe0\xdc(L6\x90\x1b\xc0\xeev\xbb\x9b\xdd\xcd\xde\xf3=9\x0d\xd5\x7fN{\xa9\xda\xce\xb7\xb6c\xc8\xedF\".\xb7\xe0\x8e\xd76\xedeZ\xd49\xd4\xdc\xe8\xce\xc8F\x80\n\x80w;\xfc\xe1\xf0v\xb68\x1d\xd0ii\xc7\x01\x00!\xc8\xe8\x113+\xc7\x7f+p\x9e\\\x06hC\xf4\xfb\xd1$f@|\x188\xcc\xc2\x1b\x07\xf1\x96\xbb\xbc\x01\x99\xfe\xb3\xbd\xe1`\xed\x8c\xf7\xef\xda\xd5>e#\x03\xa0\xb9R\xff8\x9a\x8di\x88Q\xcfMDc\xa3\\\xe5`a\xf8\xcb\x97\xcetvW<\xba\xf7VBBy\xd4\xed^\xf2\xbc{l\xae\xe6J\xa5>\xff\x81|\x8a\x8f\xc8\xe5>zMb\xdd8\xf9J\xa0\xe9h\x9fh\x03p\xed\x124[\xfdOo&^\xa2\x83\xde\xf5\xf0\x89\x14\x99'\x0f\x1f\n\x89\xe25\xea\xfa\x9d\xba\xf4}\xf9\x91s\xedv\x08\xeb\xd4\xe7\x89.A\xf9\xa84\x81s\x7f\x14u\xc6N\xf8J\xc2\xc0\xed\xbf[\xfe\xf2\x1c\xe7y\xd4\xb7\x00]\xff]\x9dJ\x88G\xb3N\xbb\x8e\xcf)\xad\xa4#\x81\xce\xb9(\xe8\x8b\xf9\xd6[\xd5\xa2~\xe8|\xff*\x0c\x8b\x95\x88\x9e\xf1\xa5\xa7\xd2\xb1\xc7\xbey\xa9\xab>\xe7\xac'\x1f\xd4{o1jvo\x04#X\xa7\xa7g\x8f\xfb\x03\xe8\xd4\xd8\xbbG}\x99\xab\x0d\x88\x16/\x90\x02#8\x81\xce\xe3\xa8S\x06(Q\xf7\xd0\x1f\xa3G\xb8\xb7\xec\x07\x03\x9a\x02\xe8\x17\x05\xfa)\xa0}\xe9>r\xcap\x0e\x7f\xc2\xc9N\xfe\xb2K\x1b\x00\x14\xd0\x80\xfe\xf3\xaf\x01\xf6W\xbe\xdb\xb0w:&Gt	W\x7f^c~\xb9\xc5\x7f\xfd\xb7\x7f'\x90|\xd45l<br\x08wn\xd2\xc4\xa7/\x1b\xa7~\n\xc8\x7f\xd8\xd7~\x1b\xf5\x0e\x16cr\x03H\x81\x06\xa8\x1d	\xa8\x7f\xfd\xa7\x81\xfe\x07\x81\xa1\x00\x82x\x97r#xK\xf8g\x82'\x88}\xc8\x87|\x0c\xc7\x82\xe4\xa4\x82/H 2H\x01x\xc5\x05\nUn\xfb\xb4\x83f\x00\x00\xfb'\n'\xd0\x80\xb2\x15\x84\x180\x84D\x18\x1c\xa3\xb0\x7f6\xe85I\x84\x01\x8f\xf7\x84\xc1\xb1~Z\x98|TX\x85J\x85\x85y\xa6\x85\xe8\xc7\x85]h\x85\xe1T\x81\xf5\x87\x7fb\xe8\x83\x94\x87Lf\xe8|`\x18\x86k\xb8\x84U\xe8QhH~j8\x87^\xe3\x10M\x98Ow\x98?\x18(\x86@\x96\x0f\xdew\x85q\x08\x83\x82\xa8\x1d\x0e\x91\x12\x7f\x98\x86 \xe65\x1ex\x88\x928\x89\x94X\x89\x96x\x89\x98\x98\x89\x9a\xb8\x89\x9c\xd8\x89Z\x10\x04\x00;\x03\x00PK\x07\x08]12\x95\x96^\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00migrations/001_init_main.sqlUT\x05\x00\x01\xda\"\xee^\x8c\x94\xdfo\xdb6\x10\xc7\xdf\xfbW\x1c\xce\x0fi;\xc6Q~\xcd\x05\xf7R'K\xbc\x0d\xe9\x96\xb9\x1b6d\xdaX\x9a<\x8b\x84)R \xa98n\xd1\xfe\xed\x03\xe5$\x9e\x87=\x0c\x02\xc8\xef}\xee\x8e\xe4\x9dDY\x9f(f\xb0>\x07h\xa5\xf5\xf0rE\x1b\x06\xf7\xd2\xbdzq/]O	^\x1e\xa8\xe0\x97\xb69`p\xf0	\x95\xec\xb22\x12\xf9R\xbaD\x0c[\x99\xfbH\xcff\x17{O\"\x9bHR\xa7\xe7\xa0\xad-\xe8\xa1\xb3q\x83|R\xd2\x1eD\xb2\x1f	\xf99CMK\xd9\xbb,\x9c\xf4\x0dr$/f\x17\xb8\xc3\xd9PK\xc8\xb1\x0d\x84\x0cm+\x1b\x121\x84,\xc2=\xc5hu\xf1!Cg\xfd*!\xff\x84g\xcaH\x8f\x1cM\xce\x1d?:Z\xaf\xd7\xe3\x01\x8dCl\x8e\xf03Cj\xa5u\x82b\x0cqw\xc6\x1fn\xaff\"\x9b\xbe]xi\xdd\x8ek\x9b\xe4\xc2\x95\x1d\x17!\xefp+\x1f\xc4\xda\xeal\x90\x7f]U\xd5\xb6\"C\xb61\xf9\x89\xfcs\x1b\x91(\xdeS\x14]\x88\x19\xf9\xf9\x9b	Ced\x14I\x85H\xc8\x8f'\x15\xc3.\xa4,T$\x99m\xf0\xcf\x9e\xf3a\xadm\xd5\xfbl\xe8\xc1\xaf\xf3\x9b]\xa9\xc7'\x93q5\xae\xc6\xc7\xc80I\x97\x91\xe3\xcd\xf4\xff=\xb8\xdf\x16!\xb5\x8e\x94\xd2\xb6\xb5{\x9eN\xa6\xb4\x0eQ\xff\x87\xeb\xb1\xc8\xbd\xdc%\x91^H\xb5\x12C\xe46\xe9z\xfa3r|\xdfw\xa5\x1d\xa4\xa1\xef\\\x90\x1a\x96\xd6\x11\xe4MG	d$(o\x84\xc1\xed\x8f3\x06\xd3a\xfc\xed\xea\xe2\x1d\x83w\xb7\xa7\x0c\xaeo\xa6\x97E\x9e1\xf8iV\xa2\xbe\xbdfp\xf7\xfd-\x83\xc9\x1d\x83_\xa6\xf3\xf1\xecq\xfe\xfd\x8e\xc1|:gpyqW\x86\xf9\xb8\xf6u_U\xa7\xca\xc4a\xa6+\xafd\"\xc8\xf4P.\x02\xaf=\xc0\xeb\xd7\xb0\x0c\x11R\x17\xac\xa3\x98\nz\xfbv@\x8b\xe0t1\xbf|\x19L\x9b\xa5\xb3j\x08\xf8+\x0e$\x92\x1e\x96\x1a\xd0b@\x0b\xd7\xd33\xfb\xf0a`]\x0cM\x94\xad\xf5\x0d\xa8\xa0	\x8cm\x8c+_\x8f\xf5\xcd\xbf\x0f\xf8\x9dL\x06Th[\xe9u\xe2\xb5\x1f\xe9\xe3\xaa\x82\xd1\xc90\x1d\xc2<8\x07\xda*\xaa\xfdh\xe9l\x07\x87p\x19\xac\x87\xa2k?z\xb3\x90\xce\xc1!L=\x0c\xb2\xf6\xa3\xb4>9\xe3\xa7\x15\x14\xc1w\xb2\x88\xaf\x1eq\xd1\x87\xa7e\xf9\x1a\xdfo\xbcZ\xcb\xacL\x8d\x906^\x99\x18\xbc\xfdX\xea\xb4-\x81\n\xbd\xcf\x14\x91=\xfd\x1bD\x96MB\xfe\x07v%'\xf4\xce\x8a\x95\x0fkG\xba)\x17X\xd9\xe8\x0324r\xd5G\xb2\"\x92m{\xfc\xf3\xf3\xc1\xabo^\xfc=\x00PK\x07\x08\x04\xd7\xecx\xa6\x02\x00\x00\x8f\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00migrations/002_images.sqlUT\x05\x00\x01\xda\"\xee^t\x91O\x8f\x9b0\x10\xc5\xcf\xf6\xa7\x98\x1b q\x80m\xd3\x1eV\xbb\xd2\xee6A\xa9\xf6\x0fJWm\x95Kd`\x8a\xad\xd8&\x82!\x15\xfd\xf4\x156\xf9\xa369\xbd\xd1{\xf3\xb353e\x8b\x82\x10h\xd8!\xfcR\x1a7\xae\x12\x1d\xa0\xed\x0d\x84\x9c\x05_\xf3y\x16\xc4\x9c\x05\xf9\xab\xd7l\xb9p\xfac\xfe\xf8\xe2\x8a\xfc\x8b7\xbe}\xf7\x0d/\xf9\xc7I?8}\xcb\xbc\xbf^\xe6N?\xaf\x9d\xbcg\x93\xfe\xf4\xbax~xr\xc5\xeb\xdbf\xb1|\x9eO\xe1\xfb\xe13\x0f\xaf\x1eVN\x9f\x1e\xd7\x93\xae\x02\x1e\xddr>MR5F(\x0b\xbd\xb2\x94\xce\xc6A:#\xb4V\x96\xa0\x94Xn!\xdc\x0b\xdd#\xdc\xdfArF\x91(4\x822\xa2\xc6n\x9c\xba\x93\"\x85b \x14\xb0k\x95\x11\xed\x00[\x1c\x0eO4%!m4\xda\x9ad8\xb6Fp\x077I\x14sf\xaa\xd9\xc4\xd9\x86\xc0\xf6Z_\x84L5\x1b\x99\xf4S\x14s\xceD_\xa9\x06\x8a\xa6\xd1G*\xe6l\xaf*\xfc\xcf\xe5\xect\xa5SuF\x91\xecMq=\xe7\xec\xb7\xaaH\x1e\xf6s\x06JT\xb5\xa4\x0b\x81\x7f\xf1\x1a\xe6\xd3k0g\x9d\xfa\x83P\xa8z\xbc\xc0\xbf+q\xd9=\xb8\xc5U}+H5\x16.5\x1eCw\xb6q\nR\xa4\x11\xf6\xa2-\xa5h\xc3\x9bdt\x99hIutt\xd3$\x89xt\xcb\xff\x0e\x00PK\x07\x08\xd5\xc6\x033d\x01\x00\x00\xe4\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00migrations/003_threads.sqlUT\x05\x00\x01\xda\"\xee^\x8cV\xdd\x8e\xe36\x0f\xbd\x96\x9e\x82\x17\x01&\xfe\x90\x9d\xcf\x99\xf6j\xdd\xecE_\xa3(\x0cY\xa2\x1d\xed\xc8\x92\xa3\x9f\xcd\xa4O_\xd0\x96cO\xe2]\xf4f\x80\xa1x\xa8\xc3\xc3#:\xd2\xa3\x88\x08\xca\xf5B[\x88\xba\xc7\x10E?\xc4\x7fj\x91\xa2\xab\xad\xbb\x82\x08\xeb8X\x17\xc1&c@a+\x92\x89`\xddu_T\x9c\xe7R\x01/	\xadD\x18\\\x88\xb5Vu\xc0\x0b\xd5ht\xa7m\\\xf2\xa2hLN\x92\xae\xef\x9d\x85=gZq\xc6\xa6L\xce\xd8|\x15g\xec~\x19~\xc4\x1f\xc2\xec_V\xd5_\xbe~\xf5\xd8I#B(8c\xf2\x8c\xf2\x1d\xf6Z\xc17(\x8b\x03g\xd3\x85\xaav\xdb\x0d\xf2\x15\xf9\x89T<{\x14*\x10\xa1\xc1\xeb^\xf8\x1b\xbc\xe3\x8dJR\xb9\x90\x9a\xef(#\xfc\x10^\x9e\x85\xdf\x1f\xcb\xb2\xb8\xabr\xe0\xacI\xfd\xf0\xf3\xdb\x0e\x9cE\xd1\x85;\xfa\xad,\xfe\xfa{\x115\x93\x17\xde\x8b[m\xd0v\xf1\xbc\xa7\xfc\x03\x1c\x0bh0^\x11-\x1cAX\x05\xbf\x15\xbc\xe0\xda\x9e\xd1\xeb\x18`\xbfRr\xd5\x90\xb6\n?\xe6\x86j\xaaTk\xf5\x01\xce\xde\x9bLA\xdb\x0e:ma\xbc\xe8I\x8c!5F\xcb\xfa\x1do\xa3 Z\xd1$\x03z-\x0c\xac\xd49p\x963\xb5\x82\x94\xe8\x8f\xd5\x97\x84ke\x96R\xd0\xdc\"\x8a\xc7\x94\xb9{'#\xc6\xb9\xfb\x05T\xc0\x1f'8\x96o\xbf\x17\xcf#\xa3\xee\x7f6\xb0\xa9\xd3l\xc0Ej\x8f-zrj\xb8\x0f\xdcYPh0\"H\x11\xa4PH\xa4E\x87O\xd8\xd9\x8e\xe5Ly\xcc\xfav\x1a\x0d\xc7\x99\x1b\xd0B\xe3\x9cyFD\x9f\x90R\x02\x01\xb6SZa\x02\xddlE\x8f\x9f\\F\xbdx=<\xc6Z#:\x18\xad\xf8VP\xe9\xb5\xcc\xe3SZ\xb7\xba\x1c\x06\xcam\x9c\xba\xc1\xf7\xe0ls\xa7Ja\xdd\x8f\xf4\xc6!\xad\xc0c8\x1c\xf2y\xfd\x89\xe0\xdb\xfa\x19\xdc\x05zy\xb9g\x87\xc1i\x83\x1e\xd5\xaf\xda\xfe\xcf\x96\x1e\x07^O\x83\x9b-\x9dM0\x05\x8bj\x0b@szH\xa7\xd0v2\x8d\xf1!\x99B\xdb\xc9\xcb\x96y\x80,\x07\xdb\xc0e\"\x0f\xc0\xe5`\x1b8\xc9\xfa\x193\xc6V\xaf\xc3y\xf08\x18!\x11\xdade\xd4\xb9|-]\xb21K\x95\xed]p\x8f1y;\xefjn\x84\xed\x12\x19!\\\x0c\x84\xbc\x0e\x84\x17\xc6\xa0\x81 Z\x84\x10\xbd\x96\x91\x8b\x00\xbb\x1dg\x01\x0d-\xc6\xa9\xf4\xff\n\xceZ\xef\xfa\x89\x19g\xd73\xfai\xdf\x87\xd7|\xediE%\xc7*\xbe\xdb\xfd\x9a}\x83\xad\xf3X\x132\xd4\xda\x06\xf4q\xbf0\x8f^w\x1d\xfa\x85\xfa`\x86.\\L\xa6\xa8P\x1a\xe1\x91\xb3\x19\x9e\x1d\x94;\xaex\x83\x9d\xb6\xcf\xc7k\xa6{\x8b\xd7\xcc\xb6\xa88\xa3\xff\xc8Bp\xa2\xa5\x81\x9cQ\xa7\x16\x1e/8A	\xf1\x8c\x16J\xce\x18\x9a\x80O\x19\xff\x87cYr\x86VU\xf4\x02\xdb\xe91\xe1\xf5u\\\x15\x84\xa5\x8f\x9b0\x06\xe8\x0b\x93\xeb>\x90A\xab@\xb7\x84\x9fF	\x16\xaf\x15G\xfb\xa0kViKK>\xc5`R\xf6\xee,\xde:\x0f(\xe4\x19\xbc\xbb\x02~\xa0L\x11a\xf0N\xa2J\x1e\xb7\xa7Rq\xfe\xe5\x0b\xfc\x99\xfa\x01D\xde\xb1\x10\x1dD7\x80k'+oLzUt\xd5\xa7\x9egT|\xb2\xe5l\xbd4(2\xcc\xbc\xc8\xe97\x0b\xf91\xc2\xf25>M\xbfTf'\xc6W\xad\xe0\xb4\xd6\xf2U\xab\x8a\xefv\x15\xe7\xff\x0e\x00PK\x07\x08\x07\xe1\x0b\xcel\x03\x00\x00\x16	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00migrations/004_antispam.sqlUT\x05\x00\x01\xda\"\xee^\xac\x90=N\xc4@\x0c\x85k\xe6\x14.\xd9\"'\xa0\xa7\xa2@\xe2\x00#g\xe2\xecZ8\x9e\x91\xed\xa0,\xa7G\xd9\x84\xf0So7z\xef\xe9\x9bO\xee:xE#\x0d\x08\xec\x85`\xac\x06(\x02\xb446\xd6\xf3\x16{*F\x18\xb4\x8f\xb6\x92\x1c\x1e\xd3\xc3\xedM\x0e\xc1\x13y\xe0\xd4\xe2\x13\xb4\x06\xe8,\x92NO)u\x1d<W\x83\x11=\xc8\xa0\x08\xa1\xce\x0d\n\x8a\x1cT\xd6\x81\x96\x83\x9awd\xe6a\x81\xaa\xbf~\xdb\x8b\x9d\xfa&<\xac\x86\xa8\xc1\xdep\x02/\xd5\xfe\xab\xaeE\xde\x8a\xd5\xb6\xcd\xbdp\xc9\xeft\x85\x9e\xcf\xac\x01\xcdxB\xbb\xc2\x1a\x19\x8dd\xa4\x85\x1c~\x86\x9eN\x89\xf5B\xc6\xf1\xad\xc0\x87\xc3\x0bz\x80W\xf9\xa0\x01\n\xb6(\x17\xbc\x9dpv\xb2\xbf\"\x82\x1ey[\xe6}yw\xa3\xaf\x01\x00PK\x07\x08\xd1\xe6\xed\xc5\xd6\x00\x00\x00\xcd\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00migrations/005_feeds.sqlUT\x05\x00\x01\xda\"\xee^\x00\x9b\x00d\xffcreate or replace function to_unix(t timestamptz)\nreturns bigint\nlanguage sql stable parallel safe strict\nas $$\n	select extract(epoch from t)::bigint;\n$$;\n\x03\x00PK\x07\x08\x1d\xd4\x12-\xa2\x00\x00\x00\x9b\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00migrations/006_image_insertion.sqlUT\x05\x00\x01\xda\"\xee^|T\xc1n\xdc \x10=\xc3W\xcca%\xefV\xceJ9\xe4d\xe5\xd8\xef\xb0\xb0\x19\xe3\x890P\xc0\xdd\xa4\xca\xc7W\x8cM\xd6\xab*=\xf9\xf1\xe6\xbd1<F\x8c\x11UF\xf0\x11\"\x06\xabF\x84iuc&\xef@M\x19c\x1f|\xca\xfd\x1a\xb4\xcax\xbe\xc8\x88y\x8d.A\x8ed\x0cFi\x953\xab2\x08\xc1\x06\x93~Y\xa9\x12\x9cNr@CN\n\x9a\xc0\xf9\x0c\x0eo\xd7\xa4\x0cJ!\x94\xd3p\x96B\x88\xb3\xb7\xfaJ\x8b2\x08\x94\xc0\xad\xd6\x82r\xfaK\xfeP\xb9\x14\x83\x8f\xc0\x1e\x1f\xd0=H\x0bQ\x14\x17)\xf2\x8cN\n1*kaX\x97\xd0\xe79\xa2\xd2\xe7\"\xdb\xe0\xa5\x93\x02\x9d\x06\x9a:\x89Nw\xf2t\xea\xa4\x94OO\xf0\xd3\x8d^#\x94\xe3B\xf47\xc8\x1e\xde\x92w\xf2?\x01![\xce\x81M\xe9\x1eN\xf1\x0d\xffD\x03)\xab\xc1\"\x04\x15\x95\xb5h!\xa9	!\xe5Hc\xdec\xd38Z\x15Q\n\xad\xb2\xda\xdatR\xd0b\x80\xf3H]\x0d\x96\xeb\xaf\x9b\xa2\x1fV\xb2\xba\xf7\xc3\x1b\x8e\xf9,\x85hH7-\x84+\xe9\xb6\xac\xb6\x833\xb3Af\x832\xc8\\\x01\xad,\xd4vR\xdd{\xd7\xb4\x90}\xbf:z?\x87\xeb\x9d\xbe\xb0\xb3\xe4\xcd\xce\x026g\xaa\xcd\n`\x91S\xcb\xd6\xbe\x00fr\xa4\xc0L\x01\xccLV\x19f\n\xd8\x1a\x0d^\x7f0U\x00\x8b\xf8\xe0M\xcbs \xc5\xa5\x93<U\xe10 e\x0cVka\xbf\xfc\x84\x16\xc7\x0ct\xfd\x01\xe4\xb2\x07ZL\x19\x9f)\xfaeO\x11\xa8\x10\xb7\x19#\x02]\xd3\xac\x9e\xe1\xb5v,\xfdk\xba\xfc\xf9\xfc\xfc&\xe5\xfb\xd6\xbe\xa9\x1fB\xe0\xd6}\x8d\xa2\x04\x16<Y\x8c\xb8_\x14\x97\xbf8\x8e\xa2\x88f\xf5\xdc\xb4u\xcah1\xbc\xd7\x16\x9a\x19\xdf\x1b\xbe\x0b!\x9aE\xbf<j\x16\xfdr\x97\x94\x83\x8aF\xad\x9a|\xd3\x96(\xae\x8cw\xefo\xd2Xy\xc6\xd50\x91\xc5>\x7f\x04\xdc\x8b_\xeb\xdd\x98\xe7u\x19\x8e\x82;Q[\xdcH\xe7y\xaf2\xde\xad3\x92\x99\xf3^\xd8\x16\x0fM\x8f\xbe\x03\xf3\xa0y\xe8q\xa4\xea\xcf\x13\xfd\xa9[/p7\xeb5\xaa\xf2\xb0\xed\x95\xba\xac\xa6L\xd9V\x17\xe3\xdd\xa6b\xa6T\xff\xb6-J\x81\x9f\x9c\xc3k\"\xc5\xf66\xf2\xd8t\x12\x9d\xee\xe4\xe9\xd4\xc9\xbf\x03\x00PK\x07\x08\xa6\x97\x9a\xaeO\x02\x00\x00a\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00migrations/007_thread_reading.sqlUT\x05\x00\x01\xda\"\xee^\xacU\xcd\x8e\xdb6\x10>\x93O1\x0d\x1cX\nl!9\xf8\x12\xd5\xbd\x15E{h\x0f}\x00\x81\x12\xc72\x03\x8adI\xaa\xeb \xbb\xef^\x0cI\xc9\xde\xf5v\xdbCN\xf6\xfc\xf3\x9b\xf9f\xb4\xdf\xc3\xcff\xb0\x12!\x9e=\n	\x83\xd5\xf3d@\x99h!D?\x0f\x91\x0f\x1eED\xb0\x1e<:-\x06\x84\xd3l\x86\xa8\xac\x01L\xb1U,\xd1a\x07N\x8c\x08\xbd\x1a\x95\x895\xf7\x18go\x02|	\xd6\xf4\\\x0b3\xcedv\xda\x8d\xe1/\x0d!\x8a^#8\xe1\x85\xd6\xa8!\x88\x13RQ5D.\x02l6\xbc\xc7Q\x19\xcer\x9e\x9c\xa6\xebg\xa5eg\xfb/8\xc4\x8a3\xb6Ur\xbb\x83\xd8(\xb9#\xc9\xd9\x10\xbb\xc1\xce&nwp\x15*r\xa8\x93\x87\x9a\xc4\x88\xab\x0b\xa5`\x015\x0e\x11\x92\xae\xfaP\x93\xea\xe4\xed\x94\xe2\x038\x92\x1f\xce\xe8\x11\\S\xfatL\x15A\x18	\xaeI\x19A\x0506\x82\x99\xb5\xe6\x8c\xe5Z\xd4\x0ez\x87\x181\xc9\xb9\x97\xb2\xb3\x86\xdel\xbb\xd9\xa8K\x15\x9b\xab:\x87\xf5\xf3\xe4\xee\xbdVmv\ns\xea\x01%j\xca\xff\xa4\x8fb\x0cII\x7f8\xab[\x8eF\xb6|\xb3i9\xdf\xef\xe1\x17\\\xc6\x05\xbf\xfd\xf9\xc7\xef\xa4rb\xc4\xcf\x8b\x92\x04\x88\x16N\x18\x87sCf\xf6\xeb	\xf6\x9fvY\x83\x01\xb4\x081\xb9]\xad\x87\x17\xd6Cn]\xf3\x16yF\x8c]\xaeY)Y8\xf3\xbd	$q\xd0\xc2#g\x93\xb8t7\xa9[\xce\n\xdc\xc2\xdc\xf7\xde>\xc4\xaf\x0e[\xce\x99\x14Qd\xb2\xb5\x9c%\x1c\x8bT\xf8X\xe82\x89K\xe5\x1a\xcaZ\xe7\x85Y\x8a\xf0;\xfa\xdc\xb1\xe7\n\xbeQ\xb2\xe5L\x9d\xd6\xe8D\xa4Yk\xda8J\x0e?]M\xf1\x8c\x86\xb3e!\xc8\xab\xe5\x0c\x8d\x04u\xcaIR\xc0\x11\xf6\x9f\x16\xd7\xa2X2\xdc\xb8\xaf8\x96-\xde\xc1\x0d\x16j\xc2\x82\xa3\xf4\x08\xe2\x8a$\x91\xffU\x14\x14\xb7\"x\xf3\xb9\x9c\x0d\"\xd0\xf4\xa8a\x0fg4\xb0?,\x11)\xcb1'{|\x84\xed\xb7w\x84\xe3\xdd\xe7\x8fO\xdb\x96\xaf\xfb\x9a\x1e\x9a\x06D\x1b\x9af\xd4\x89q\xac\n \xe7j\xb0^\xa2\x87\xfe+8G\x07`\xdd\xec\xb4\xf6K\x9e\x0fIx\xb9\xf1\xeb\xd0\xee\xa1\xf2d\x9f\x0d\xd1Xh\xcd\xff%Y.\xf2\xbc\xca}\x99\xff G\x8eb\xe5\xd2H\xf8\xe1E\xdb\xb3\xfd\x8a\x93\x9e+1\x0cY\xaf\xd5\xa4\"\x1c\x92PCG\xbf58Gc\xd0\x81\x88\xbap\xe6G\xf8\xb84\x9fy\xa1\x02\x02^\x06t\xe9\xcco\x95\xf9[hUn\x83\x99\xa7\x1e=\xbc/w\x8d\xe6\xb10\xf0\xff\x8f\xe6v2\xcf\x06swr\xab\x9b\x9b\xfb\x0cx9\xbe\x85\xe07&\xd2\xa4\x84\xcc\xfaW\xc7W\x96\x80\xe8\xd7\x96u?\x96\xafK\xc0X\x11\xebv\xb0\xfd\x96\x9e\xf2T>#\xa1&xe\xf1\xc8\xa3\xe5hd\xcb7\x9b\x96\xff3\x00PK\x07\x08>\xe6\xe0+\xe0\x02\x00\x00F\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00migrations/008_thread_add_last_page.sqlUT\x05\x00\x01\xda\"\xee^\xacU\xcd\x8e\xdb6\x10>\x93O1\x0d\x1cX\nl!9\xec%\xea\xf6V\x14\xed\xa1=\xf4\x01\x04J\x1c\xcb\x0c(\x92%\xa9\xae\x83$\xef^\x0c\x7fd\xef:M{\xc8\xc9\xe6\xfc|\xe47\xf3\xcd\x88\x1f\x8f\xf0\xb3\x99\xacD\x88g\x8fB\xc2d\xf5\xba\x18P&Z\x08\xd1\xafS\xe4\x93G\x11\x11\xac\x07\x8fN\x8b	\xe1\xb4\x9a)*k\x00Sn\x13Kv8\x80\x133\xc2\xa8fe\xe2\x01\xb4\x08q\xb8\xb1\xb4\xdcc\\\xbd	\xf0!X3r-\xcc\xbc\x92\xdbi7\x87\xbf4\x84(F\x8d\xe0\x84\x17Z\xa3\x86 NH\xcfPS\xe4\"\xc0n\xc7G\x9c\x95\xe1,\xe3d\x98a\\\x95\x96\x83\x1d?\xe0\x14\x1b\xce\xd8^\xc9\xfd\x01b\xa7\xe4\x81N\xce\x868Lv5q\x7f\x80\xeb\xa1\xa1\x806E\xa8E\xcc\xb8\x85\x10\x04\x0b\xa8q\x8a\x90l\xcd\x9b\x96L'o\x97\x94\x1f\xc0\xd1\xf9\xe9\x8c\x1e\xc1u\xa5r\x8f\xe9F\x10F\x82\xeb\x12\"\xa8\x00\xc6F0\xab\xd6\x9c\xb1|\x17\x95\x83\xde!fL\xe7\xadF\xfb\x9bz%O\xae\xbb\x1c\xac!6vX\x8d\xba4\xb1\xbb\x9a3\xe0\xb8.\xee>j\xb3\xe6\xa0\xb0\xa6\xea\x10PW\xfe'{\x14sHF\xfa\xc3Y\xdbs4\xb2\xe7\xbb]\xcf\xb9\xf4\xd6\xdd\xb7\xba6\xbat\xb4\xe7\xa4\xa1_\xb0J\x00~\xfb\xf3\x8f\xdf\xc9\xe4\xc4\x8c\xef\xab\x91\x0e\x10-\x9c0N\xe7\x8e\xdc\xec\xd7\x13\x1c\xdf\x1d\xb2\x05C\xa2\x9e\xc2\xae\xde\x87\x17\xde\x87\\\xfc\xee[\x82\x9c1\x0e\xf9\xceF\xc9M\x87\xdfW\x82\x12'-<r\xb6\x88\xcb\xad\xba{\xce\n\xddR\xa4\xd7\xde>\xc5\x8f\x0e{\xce\x99\x14Qd\xb9\xf6\x9c%\x1e\xf5T\x14]\x04\xb7\x88K\xe3:Bm\xf3\x10\xd6K\xf8\x9d\x00\xef\xf4w%\xdf)\xd9s\xa6N[v\x92\xe2\xaa5M1\x81\xc3OWW<\xa3\xe1\xac\x8e\x14E\xf5\x9c\xa1\x91\xa0N\x19$%<\xc2\xf1]\x0d-\x86\x8ap\x13\xbe\xf1\xa8\x9b\xa1(}\x8b-\xac\xa8\x1c\x95Q\xa9\x16\xc4\x8dS\x1a\xa4\xaf\xf2\xa1\xbc\x8d\xcb7\x1f\xce\xd9$\x02\xad\x12*\xdd\xd3\x19\x0d\x1c\x1fjFBy\xcc`\x9f?\xc3\xfe\xd3+b\xf4\xea\xfd\xdb/\xfb\x9eo\xb3\x9f\xca\x9fZE\xd3\x9e\xba5\x88yn\n5\xe7Z\xb0^\xa2\x87\xf1#8G\xcbd\xdb\x12i\x85T\x9c7\xe9\xf0r{l\xed\xbb\xa7\xca\x93\x7f5$h\xa15\xff\x17\xb0|\xc9\xf3[\xee\xaf\xf9\x0f\x99\xe4,V\xb6\x96\x84\x1f^\x94=\xfb\xaf<\xe9\xb9\x12\xc3\x94\xedZ-*\xc2C:\xb40\xd0o\x0b\xceQ\x1bt \xc9V\xf5\xfc\x08ok\xf1\x99\x17* \xe0eB\x97>\"{e\xfe\x16Z\x95-a\xd6eD\x0f\xaf\xcb\x8e\xa4~T-\xfe\xff\xd6\xdcv\xe6Yc\xee\xd6ws\xb3\xbf\x9f\x11/\x8b\xbcH\xfd\xc6E\x96\x04\xc8\xac\xffj\xfb\xca8\x90\xfc\xfa2\xf8\x8f\xe5K\x1506\xa4\xba\x03\xec?\xa5\xa7|)\x9f\xa4@\x8b\xb4\x8e E\xf4\x1c\x8d\xec\xf9n\xd7\xf3\x7f\x06\x00PK\x07\x08\x81!\xcb\x17\xfb\x02\x00\x00\xa5\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00migrations/009_validate_tags.sqlUT\x05\x00\x01\xda\"\xee^|\x93\xc1n\xd4@\x0c\x86\xcf\xe3\xa7p\xa5E\xd9\xa0\x1e(\xdc\x88\xf6I\x10Zyg\x9cd\x84\xd7\x13<N[\xde\x1eM\xa2\x15Ee\xb9D\x89=\xff\xef\xefO\x9chL\xceX\x0c\x8d\x17\xa1\xc88\xae\x1a=\x17\xc5g\x92\x9c\xc8\xf9\xec4\xd5c\xbb\xe03Y\x9c\xc9\x8e\x9f?\xf5\xdf\xbe\xf7`\xec\xabi\xc5K)\x02B:\xad41.\xb2L\xf5\xa7`\xbe^W\xa7\x8b0.d$\xc2\x82\x95F\xc6\xea\x96\xa3\x03U<\x1c q\x142\x86\xe04\xbd\xb5\x1f \x08+\xd6+\x89d\xf5\x01.<e\x850\x16c\x8a3\xb6\xe3Y\x91\xcc\xe8W{\xa8\x10\xa4\x94\x05B\xc8#Jyak\xc4=>\x9cZ\x17}f\x85\x10\x82Q\xae\x8c\xfc\x1ay\xd92v\xad\xa9\xc5wI\xa4\xca_\xf1C\xf7\xd84\x03\x84\xc0\x9a0\x8f\xed.\x8f\xad\x86'\xec\xba\xfbf|]|\xa3\xe9\xfe\x127\x97\x067\xc0\x1e\xea\xb4c\x9f\x85u\xf2\xb9q\xd6G|j\x91\xf3\xb8\xc3\xb0\xe2\x85\xfd\x85Y\xf1	I\x13~\xb9\x0d}\x17 \xeb\xf6\x9d\xdaT\xac\xbci'\x9f\xf7\x14\xc2:\xc0\x9f\x10y\x84\x10\x8e\xed5T\x16\x8e\x8e\xb1\xac\xea\xc7\x8f}+\x8dV\xae\xb85o\xdd\x94\xabg\x8d\x8e\xaf[u;\xb0\xaar\xf5\x0d\xb9\xdf\xeb=\x9e!\x84f\xf1pj\x03!\xdc#\xbd\x11\xc6\xa2NY+\xa6u\x91\x1c\xc9\xb9vo0!\xeck\x85n+\x0f\xc0\x9a\x068\x1c\x06\x00\x12g\xc3}\xa3|6\xa6T1YY\x9aau\xa3\xac~\xabo+{\x8e3\xc7\x1f\xff\x16\x02\xa5\xf4\x7f\x1dlj<\xbe\xff\x0b\xfa~\x80\xdf\x03\x00PK\x07\x08\x16\xbb\xee\xd9\x9f\x01\x00\x007\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x17\x0dQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00migrations/010_tag_stats.sqlUT\x05\x00\x01\x1f\xd2\xd2jdS[\x8e\xe36\x10\xfc&OQ\x1f\x03H\n,\x01\xbbX`?\x06\xc9\x19\x82\xbd\x80\xd0&\xdb2'\x1cR\xc3nZ\xe3\x9c>\x90<~e\xbf\x04\xb0\xaa\x1b\xd5U\xa5\xbe\xc7\xdf\\z\xa5	\xe44\x9c\x82\x9e!J\x1aD\x83\x13\xe4\x13\x17\x08\x9f\xb8P\x84\x86w\xc6\x12\x92\xcf\x8b\x0c\xf8\xc5\x87\xc2rdo\xfb\x1e3\x97\x90}p\x14\xe3y\x07\xc9\xd0#Ci\x12\xe4\x03(F\xe8\xb10yAb\xf6HY\xb1g\xd4\x94X\x94=r\x02\x9f\xb8\x9cm\xdf\xa3\xf0Ge\xd1\xc1\xba\xc2\xa4\x8cwR.\x81b\xf8\x97=N\x81\x17(M\xa3(\xa9\x80\xc4\x1a\xe1\xc8N\xb1\x0c3\x97\x90\xfd\xce\x1a\xa34\xed\xac1.\xd7\xa4\xad_/IN1\x0f\x17\x0d\x1d.\xdf\xd1\xe5\x9a\xf4N\xfc\xa3\xc3\x9cE\xff\xff\xfc0?\xd7}\x0cn\xfc\x87\xcf\x1dj\n\x1f\x95\xc7u\x82\x8b\xac\xf4w\xfal\xe7\xe1\xa2\xda\x8f9u\x88$:^]\xb5\xe6P\xf2;Zk\xcc\x89be\xb1\xc6\x98\xb6\xf9vlv\x08I\xb9\x9c(\xa2\xf9\x86c\xae\xa5\xe9\xd6\x85\xa6m\xbe\xffx\x86\xbf\xff\xd8p\xb9\x11~\xfa'\xfc'<\x9d\xa5\xe9\xac\xe9@\x82\xa5\xfd2\x052S\xea\xacy\xcb!mW\nf\xe4\x84G\xbd\xf8\x0b)/m\x87\x1e\xcb\xb0\xf2\xbf\xe8\xd7\xe4t\x1d\xd0!x\xfcy\xf3\xd2\x1aW\xb2\x08\xb6\xbd\x978[\x1d\xd6\xdc;(M\xd6L%\xd7\x19\xfb3\x96\xe1*Eiz\xb5\xb6\xef\xf1\x8b?j(\xecq\xc8\x05.'WK\xe1\xa4(_\xc5\x12{1\xf3\xcbk\x84\xe4\xf9\xf3\x9e\xfexY8\xae\x0f\xc1\x7fn\xea\xae\x18n\x87+M\xdd\xab\xbdn\xca\x05\x85\xe7H\x8eq\xa8\xc9i\xc8	\x9c\\\xf6\xdc\xca}sg\x0bk-I\xf0&9\xedm\xa44U\x9a\x18\xf2\x11\xd7\x9fc\x1f\x193\x15\x8a\x91#\x84\x0e\x0c\xd1\x12\x9cZ\x12\xbc\xbc\xdc*\xb9\x0d\x8f\xfb\x1a\xa2\x1f\xf3\xfe\x8d\x9d\xae\xe17JS\xb3\x83\xac.\xad)7\x8fm\xbc\x00\x0f\x0f\x1b\xe3^\xcb\x0d\x7fni\xf3\xdc\xc4\x8d\xf1{9\x9b\xa7.6;h\x1ek\n\x9f\xad\x0cOHgM\xf7j_^^\xed\x7f\x03\x00PK\x07\x08\xd7\xc6\x9bJ(\x02\x00\x00\x19\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x009\x0dQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00migrations/011_post_search.sqlUT\x05\x00\x01^\xd2\xd2j\x9c\x94\xcdn\xdb8\x14\x85\xd7\xe2S\\`\x0c\xc8\x1a\xc4\xf3\x02B\xbc\x98\xa2\x8b\x02\xdd4i\xd7\x02M\x1e)L\xaeI\x95\xbc\xf2\x0f\x9a\xbe{AY\xb2]\x04I\x83nLZ\xa2\xcew\xee\x1fW+\xfax\x90\xa8\x8d\x90f\xa6!!\xaev.\xb9\x0d\x83\x04\x07\xa16\x86-i\xeaC\x12\xda\x04{$\x89\x802\x11Z@!RD\xcf\xda\x80\xda\xc1\x1bq\xc1\x8f\x87\x9a\xfc\xe9\xd2\x07\x0bzL\xc1o*\x15!C\xf4i\xd4T\xac}7\xe8\x0e\xd4s\xdf\xa5\xefLn\xbb\x1dDgf\xaf\xa3f\x06S\xd2-(ItF\x94N\xb4X(\x0b\xc3:B\x15O8\x8e:\xb5*v\x9aO\x84Z\x15a\x90\xf11\xddRY\xd6j\x83\xceyU\x18\x9d&\x13\x8d\x1c{\x84v\xb4U\xa9b\xff\x00Oe\xd8<\xc2HI\xf2\x00\xaf\x8a\xa2\x0d\x91\x9ep\xbc\xa1,\xec<%0\x8c\xd0\xbf\xa74\x8c\xa4\x06\xda<\xcc\"\x05\x87\xd0\xab\xa28a\x9ep\xcc\xfb\x93\xf2W\x1c\xa4\xbc\xa1\xf2C\xb0\xc8\xeb\xb7\xbb\xcfgL1\x9a\xbd\xa5\xfc\xfb\xfcL%\x95yYf\xe8?\xeb5\x95?~\x96U}\x91\xbaC\x8b\x08o\xf0\x07\x01\x134#\x19d\xa1\xd5z]\xb2\xde\x803\xfb7\xb5{\xb7a\xe7\xbb\x94_\xdc\xf7\xc11b\xde\xfe\x1f\xd8\xe6\xf5\x93hv&\xef\xbe\x0cA`\xdf\xcb\xbc\xd4}\xa7\xb9\xba@\xc1	y-\xfc\xc0<\x06\x05o)\xe7+\xff\xc9\xfb\x9c\xc3z.\x88\x8eQ\x1f\xcf\xcc\\\x8f7J1\x1en\xc0\xd8\xc2KzQ\x94\xbf\xf1{ehr>\xf9\xber}j\xe6\\\xbdZ\xc1\xdbZ-\x16\xb5R\x9a\x05\x91\xa66\x0eI\x12ik\xc9\x04\x1e\xb6\xf3T\xa4\x1dI\xda\xc1H\x88\xb5zs\x88\xd0\x86\x88f\x94i\xc6\xcc\xee\xa3\x13,\xaf\x06)\xba\xaeC|1K\xd3\xb0L\xdd\xef\xb1\xff\xef\x8c\xbe%	\xcd\xcc_\x96\xc9m{\xce\xady\xc9\xc4|\xbc\xaa.Qz\xec\xaf\xa3\x9cLO\xf8\xd7\x8c\xaa\xd3sr>!J\xbe'\x86\xde\xe6\xefB;\xe2(\xf8\xf1JI*W8O\x14\xc5\xb0'\x1c`\x06\x01\xf51\x18\xd8!\xe25\xfdeU+5I\x9et\x12d\n\xe4]\x91\xceQ\xce\xf18oq\xa0+\x8c\xa4]\xe3\xec\xe1l\x94\x86\xe4|G\x9d\xf34\xb5z\xdaU\xb5\xfa5\x00PK\x07\x08\x00;S\xc4L\x02\x00\x00@\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00%\x0eQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00migrations/012_bans.sqlUT\x05\x00\x01\x17\xd4\xd2jl\x90\xbfn\xf30\x0c\xc4g\xeb)\xb8\xc5\x06\x9c \xdf\xb7f\xeb\xd6-o \xc82]\x13\xb1)\x81dZ\xbbO_(i\xf3\x07\xc8(\xdd\xeft\xba\xdbn\xe1-\xb0B\x1a\xe0\xfd\xa8-\xe4s7Q\x84\x13\xae\nI\xa0K6\xee\xe0\x882\x07F6\xe8\n\x8bK&A\x08\x06\x1b\xe2\x81\x98l\xdd\xec\\\x14\x0c\x86`\xa1\x9b\xf0\xca\xd5\xae\xa2\x1e:\xfaP\x14\n\x13d\xa19\xc8Z\x1eo]E\x19\x88\xd1ZW]3\xfd	\xd7\x02\x13\x1b\x08\x0e(\xc8\x11\xf5\xf7CETH\x0c=Nh\x081h\x0c=\xb6\xae\x1a\x92\xf8\x9c\xd4^Y\x93\xda\xa3I\xd1\x80\xcf\xd3\xd4\xbaJ0hb\xf8\x0c\x12\xc7 \xf5\xbf\xfd\xbe\x01N7\xb9\x0b\xcc\xd8\xfbn\xbd\x11\xff\x9f\x81k\xd9\xde'\x06\xa3\x19\xd5\xc2\x9c\xed\xdb\x87\xb3%\xcf\xe9\xabuU\x1c1\x9e\xa0.-\xf5\xe6,\x93\xde\x0b=*\x8dk\x1c\xf1\x88B\xa6P_\x16&\xd4\xe6\xe0\xfev%\xeeq\xb9\xec\xea){\xea\x97R\xac\x1cKFsx\x81\xdd\x83\x9e\xf1\xfb\xfdK\xdb%\x1b\xf5\xd9\x83K&Am\x0e\xeeg\x00PK\x07\x08\x0f\n_;\x1f\x01\x00\x001\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00g\x0fQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00migrations/013_ban_ranges.sqlUT\x05\x00\x01r\xd6\xd2j\x94S\xcd\x8e\xdb<\x0c<[O\xc1C\x80$@\x92\x17\x08\xbe\x05\xbe\xf6P\xecm\x81\xf6n\xd0\x12\xed\x08+S\xaaHo\xb2}\xfaB\xb6\xb3\xde?\x04\xdb\x9b,\xd13\xe4\xccp\xbf\x87o\xc8\x02\x16\x19\x14sG\n\xf7\x0f\x90\x91;\x92\x03|\x8f\xac\xe8\xb9'V\xb0'\xb2\x8f\x02L\xe4\x00\xe1\x87\xff\xf9\x0b<;\xba\x1c\x8c\xcb1Mgh\x90\xa5\xf6\xa9\xf6\xeer46\x13*}|\x81\xc8\xe3'\x0c\xe2\xb9\x83\xce\x8b\xc2\xc6'\xf0LZ\xc7$\xdb\xa31\xfb=\xfc\x1f$\x96:\xc0\x10 \x0dM\xf0\x16\x1e\xe9Y\x80\x9e(\x83\x101\xb49\xf6\xa0'*eL\xee\xa5u\x83A)\x83b\x13\xc67\x01t\x0el\x0cC?\xc2\xd5#N\x13c\x00\x8e\n<\x84\x00\x8eZ\x1c\x82B\x8bAh\xea\xe0\xfeA\xde\x10\x9f\xf0\x89\xa0)\xc4\x83\x90\x1b\xd9\xafCNTSqA\xaf}\x12\xd8\x98j\xb9\x81\xc6w\x9eu!\xcc\xd4R&\xb6t%)UR\xc4q\x14H	,\x8aEG;S\xcd\xe2\xbc\xfc\xbb3U@\xd1z\x14A}O\xa2\xd8'\xfdS\xe3\xa0\xb1\xe6x\xde\x99*e\xdfc~.\x92\xc1f!\xd8\x81O[S$\x9e;\x9f\xecY\nJ\xe7\xaf\x8cz\xfbp\xcb\xb2\x19/f\xc8\x94\x02Z\x82v`\xab>r\xe9\xdb\xb7\xcfu1\xbd\x1e\x92C%\xb7\xd9\x9aL:d\x16\xd0\xec\xbb\x8e\xb2	\xc8\xdd\x80\x1dA\n\xa9\x93\xdf\xc1\xa0\xc0je\x1a\xea<\x9b*Qnc\xee!u\xf5\x04\xb7Y\x17\xbc\xc3\x8c\xb7\xde\xc1z\xbd=\x9ajB\x1d==\x1abw4\xab\xd52\xed\xcc\xf5YG\x06\xdb\x12\x1a\xcfBY\xcb\x18\x13r9\xcd\x86\xcc\xb95m\xcc@hO \x8aJ\xe3v\xd0\x85\xec\xa0\x04)GKn\xc8\xf4\xf9\xccS\xae\x1e^ej\xc9\xf15\xbc\x02g\xaf\xa7\x0f\xa9\x9f#\xde\x90\x8d\xfd5\xf0eM4\xc6[\xd2\x8fC\xd5\xef\xed\x1dg\xfcg\x0b|\x0bt\xf1\xa2c\xb2+\xa1@VMU\x95=(\x0d	4\xa6\xaa\xce'\xca\x04\xcd\xe1e\xcd\x90\x1d4\x07\x9f\xe0\xee\xee?`:\x97\xe3tG\x97\xe43	\xdc\x01\xc7\xf3fk\xaamYf6\xd5\x97\xcd&v\xe0\xdb/\xbb~C\x8cw\xee\xbf\x0f\xfe\xe2y\x8e\xe7O\xdc\xbe)\xf3\xd1\xfc\x1d\x00PK\x07\x08>\xfee{F\x02\x00\x00g\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00	\x10Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00migrations/014_mod_log.sqlUT\x05\x00\x01\xb2\xd6\xd2jt\x8fA\x8e\xea0\x10D\xd7\xf1)j\x07\x91\x12\x84\xfe\x96+\xfc\xc5\xdc jp\xc7\xb4p\xdc\x91\xdda\xc8\x9c~D\x18\x86\x91\x80\x95\xd5Uz*\xbf\xb6\xc5\x7f\x0d\xd0\x1e\x14#\x06\xf5\x9c\xc9D\x13\xe8p}\xca\x06\x1fZ\xac\x80\x92\x87\x1d3\x93/\xa0\xccHj\xe85\xb3\x84\x84\x13\xcf\xa5AQ\xd7\xb6\xe0dY\xb8@'\x8brf\x8cS\x0e\xec1j\xb1\xb2q\x87\xccd\x0c\xa3}d\x0c\xea\xbb\xa8\x01kW\x89\xc7^B\xe1,\x141f\x19(\xcf8\xf1\xdc\xb8\xca\xe6\x91Q\x06\x8aQ\x92-\xb3i\x8a\xb1qU1\xea{\x9c)\x1f\x8e\x94\xd7\xff\xb6\xf5\xdf\xf2\xba\x87\xbd\x04I\xd6\xb8\xea\xf6\xf1\xc7\x1d9\x05;\xfe\xdc\xbf\x18<\xf74E\xc3\xb6q\x95'#\x18_^\xd4\xabU\xe3\xaa\x9b\x89\xef4\xc1d\xe0b4\x8c\xf6\xd5\xd1d\xda%\xfdt\xf5\xce\xddm%y\xbe\xdcm;\x9bG\xee\xc4_\xa0\xe9\x9ea}\x0d\xeb\xddk`\xf1|\"\x96\xf4\x1dr\xf3}^Y\xe2w\xd0\xc3\xe8	|T\xf5\xce}\x0f\x00PK\x07\x08)\x0b\xa1\x8e\x10\x01\x00\x002\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00K\x10Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00migrations/015_reports.sqlUT\x05\x00\x01.\xd7\xd2jl\x92A\x8e\xdb0\x0cE\xd7\xd2)\xb8L\x00g\x90v\x9b3\xb4(\xd0\x03\x08\x8c\xc4\x89\x85\x91E\x97\xa4\xc7\xe3\x9e\xbe\xb0\xe38n;+\x03\xa2\xc9\xf7\xf9?\xa3\x10\x1a\x81\xe1\xb5\x10\x08\xf5,\xa6p\xf0.'\xb8\xe6\x9b\x92d,\xd0K\xeeP&x\xa3\xa9\xf1\xcePnds9W\x83\xca\x06u(\x05\x84^I\xa8FR\xe8YM\x81+$*d\x04\x115b\xa2\xc6\xbb~\xb8\x96\x1c\xc3\x1bM\x8f\xfe}\xdbV\xdc7+\xdd\x01\x8dwB\xa8\\\xe1\x1d%\xb6(\x87/\xe7\xf3q\xe37\xde\xddwI\x81+X\xeeH\x0d\xbb\xde~\x07\x1c\x8cC\xe5\xb1\xf1\xde\x9dN\xf0\x93\xac\x81\xb1\xa5\n\xd6>V\x86\xac\x90\xb2vY\x95\x12\xb0\x80\x90ry\xa7\xe4],\xac\x94\xc2u\xda\xb0_\xcf\xc7f{\xff\x9b\xb5\"\xbeq\"A\xcb\\\x01\xe3\xf2\xd9\xa1F\xd4m<\x8c\xd9\xda\x17\xf8>\xfb\xf7\xca\x02\xdcS\x05\xaci\x11\xfa\x14\xb4\xe6\xf22;\xa0\\\x86e\xe4\xff\xfeu\x9cB\xe1\xdb'\xde\xf9\xe3\xc5\xfb5\xea\\\x13}<F\x86\x99\x18\xee\x89\x86\x9c>\xe6\xde\xb5\x04\x87\xfb\xf3\xd1\xbb\xb1%!xn\x9cuI\xe4\xe2\xfd\xe9\x04?\x96\xd4\xe6\xdbP\x88X\x81k\x99\xd6\x19\x80\xcb)\x00\xd7H\x0f\xfcP\xf3\xaf\xe1_\x15\xcf\xe4wZ\xbc\xdb\x8by\xfe\xd2\x80\xa1\xdc\xc8\x8e\x17\xffg\x00PK\x07\x08\xeeB\xc1\x85^\x01\x00\x00\xbe\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x92\x10Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00migrations/016_accounts.sqlUT\x05\x00\x01\xb5\xd7\xd2jt\xd0An\xf2@\x0c\x05\xe0u\xe6\x14^&\x12H\xf0o\xfe\x05\xc7\xe8\x01\"\x93y)\x16\x13O4v \xf4\xf4\x154\xa4*U\xb7\xcf\xf2\x9b\xcf\xb3\xdd\xd2\x9bs\xdf\x13w]\x9e\xd4-t\x05\xec \xe7c\xc2\x9aR\x1d*\x89t\xe1\xd2\x9d\xb8\xd4\xffv\x0d\x8dE\x06.7:\xe3\xb6	\xd5\xc8f\xd7\\\"\x1do\x0e&\xcdN:\xa5\xb4	U\xc2\x05\x89l\xe0\x94D}\x9dPD\xcfSr\xdamB\xf5\xf5fl\xb3\x92\xcb\x00s\x1eF\xffhy\xf2\xdcj\xbe\x86\xe6\x10\xc2*M\xf9]\x94\x0cf\x92\xf5\xc5\xfbL\xef^\xcfg(=\xbc\xfb\xff\xfbW\xf0r\xd9\x8f\x93V[A\x8f\x02\xed`\xdf?\x90\x95\"\x12\x1c\xd4\xb1u\x1c\x11\x9a zB\x117\xaa1\x8fR\x04v\x97.\"\xd1\x88y\x15\xb5KQ+q\xbew=s\xaa\x97As\xf8c\xf1Q\x0d\xfb\xbd\x88y\x94\x02k\x0e\xe1s\x00PK\x07\x08\xe4&\xe3\xa1\xee\x00\x00\x00\xc6\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00 \x11Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00migrations/017_post_moderation.sqlUT\x05\x00\x01\xbc\xd8\xd2j\xccVM\x8f\xdb6\x10=\x93\xbfb\x1a8\xb0]x\x8d\xcda/\xab\xba\xa7\x16Ezh\x8a\xb6w\x81\x12G2\x13\x9aTI*\xdeE6\xff\xbd\x18~HV\x12/\xb6\x87\x02\xbd\xac\x97\xe4p\x86\xf3\xde\x9b\x19	\x1d\xd0A\x10\x8dF\x18\xac\x0f\x9e3!%\xb4V\x8f'\x03\x125\x06\x94\xd0X\xab\xc1\xd8\x00f\xd4\x1a$vb\xd4\x01:\xa1=\xee\x16\x17\xfcQH{\xae\x1be\xcc\xf3\xd7*\xce[\x87\" (#\xf1!\xc5\xae\x8fJJ4\xb5\x92\x0f`M\xda\x83M8:\x14r\xcb\xd9\xf9\x88\x0e\xa77Y\xb7\x8cVq~s\x03\x7f`\x18\x9d\xd9\x81\xea@D\x07\xa0<|T^Q\x82\xc1\x82\x80\x8f\n\xcf\xe8\xf6d\xfcW\xf4\x0c\xef~\xf7 \x1c\x82\xd0g\xf1\xb8\xb0\xfe\x808@8\"\xa47\x00\x99\x13Tt\x9b\x1c$_\xf70\x8c\x8dV-|\xc0Gx\xfb\x13\xd8.\xdeI\x87\xf4\xce\xdb\xf8\x9e\xd1|0\xf6l\xe8\x9e\xf2\xb5\x0f\xa2\xeb\xee\x8b\x91\xf2 @\xdb\xbeG	\xca@<\x84\x13\x9e\x1at\x05'\xeb\xc0\xe1\xa0E\x8b\xd0\x8d\xa6\x0d\xca\x1a\xf2\x93_\xbb\x19b\xb6~W<6\xaaW&\xec\xa6P\x91\xc3-w\x11\x1f\x1fW\\\x0b\xd3\x8f\xa2G\xf0\x7fkP\xa7\xd3\x98u \x9c\xd0\x1a5x\xd1!\xf8\xe0T\x1b\xb8\xf0\xb0Zq\xe6Qc\x1b`\xd8+	\x07\x18\xf6	\x18\xce\x98uS\xa8\xb4\"\xb9l\x86\xfd\x05]\xc3~A\xd86\xd9m\xc8p\xb6\x13\x86\xd4'4\xfa\x167\xc3>![\x13\xb2\x87\x9c\xda.)h\xbb\xad\xf8j\x95X\xff\xd9\xb4V&\x01\x83\xb3g\xa2\xee\xbd\xb7\x06\xce*\x1c\xe1d%:\x11\xf1\xf2!)\xae\xd5\xa3D	\x1di\x88\xa0~\x0ec\x8c\xbeg|\xaf\x00J\xf1\x9a%\xa2\xfe\xc5p\xb6\xc2#g$p3\xfb\x0fG\x9c\xa3o\xe1\xe9)\xc5\xa8\x9bQiY\xdb\xe6=\xb6a\xc3\x19c\xeb\x8c\xddz7\xe3\xb8\x8b\x07\x0b\xbc\xe3\xf1b\x873F$\xa0\xf68\x07\xe2\x0c\x8d\x9c\xa1\xfd\x05CQ\xff\xaf\x7f\xbe\xfb\x0d\x84\x07\x8fh\xa0y\x04\x01~\xc0Vu\xaa\xcd\xcc\x90\xfd z\xbc/7hAdt\x18\xda#\x15\x0d\xb0\xb7\x1d\xdc\xbc\xd9\xa5\x1d\xf4\xa0\x85\x0f\xd1l>\xbd\xfb\xe2\xf4.\xf2\xea\xf7\xff\x87\x82\xeb1\xd4)\xb7\x0dgJ\x96*\xe3\x8cR\x98W\xcb\x1a\xe4l\xe2\x944\xc3\xaf\x8af\xd0C\xff2\xe1Hl\xb5p\xc8\xd9I<\xd4\x17\xb1+\xce2\xf4\xe9\xc7\xbfv\xf6\x1c\x1e\x07\xea\xb7L\x8a R\xc8\x8a\xb3\x88iY5\xd8+3\xa9\xf1$\x1e\xa8\xf2D\x8f[P&X(A8c\x9d\xb3\xa7\xdc\x98\x87\xa4X\x87S\x1b\x80\xc3\x05@{%+\xceT7\xdd\xa6&\x17\x07\x81u\x91q\xf8q>\"\xads\xc6R1E\xab*\xea\x10T\x97\x9c\xc4\x0b\x07\xb8ySL\xf3F\xf1pa>\xe5\x91%\x1dv\xf1\xf6n\xb2\xcdY\x11\x1c%\xa3\x8c\x16\x84)\xa7\xb0WW\xf2\xa1{S.\xcf>\x9c3*\xec\x18=N/\x037w\xe5F\xf4rH\xce\x9e\x9e`\xfd\xe9\x15\x99\xbd\xba\xbf\xfd\xbc\xae8+)D\xf8\xf3\\f,\xb2U\x8b\xbe\xdf\x94j\x1d\xe6~\xb4\x05\xeb$:*\xcc\x81\xda3Uv\xa2+6\x89\xe2\xf1\xfb\xb8\xf8\x82\xc6\x92tn\xeb\x0b\x12y<\x1f\x0d\xf5B\xa15\xbf\xe2,\x05YF\xf9:\xcc\x14'\xab\xf4\xcbXd\xc2\x18\xcd\x80\xf8\x94\xef\xae\x9f_\x8e\xbe2\xf4.\xc0H\x963\"\xe4M\xa2o\xd3\xbeV'\x15\xe0..\xb6P\xd3\xef\x16\x86\x81\xa8\xd3\xb1\x13\x17\xc5\xfd\x00\xb7\x850\xe6\x84\xa2>\xf9\xd0\xe2\x10\x87\xc9Z\x99\x8fB\xab\xdc\xe5\xccH\xc3\x1a^S\x97Mz\xbc\x90\xc1K\xe9\xfc6\x9b\x0b2/HKXf\xe0\xaf\xd5 \x9f\x10K%\xbd<\xa7\xa7\xf2\x7f\x85i\xfak\xdd7\xb5\x92\xab\x90T_\xe5~s\xc8S\xcbc\xd8\x90\xd8w\xb0\xfe\x14s\xf8LH\xd1?[*\x94\\\xf9dQ\xf1\xaf\x06P\xfe\xbe\xca\x19\xd2\x1c\xfa\x8fG\xcd3\xdf\x03s\xc2\x9by\x00\xc0E\x0f\xbe\xda\xde_\xd6\xda\x8bV\x16qJ\x0b\xbb-\x1f?\x15_\xad*\xfe\xcf\x00PK\x07\x08\xbdl\xf7\x05\x17\x04\x00\x00\xbe\x0b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00y\x11Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00migrations/018_thread_flags.sqlUT\x05\x00\x01g\xd9\xd2j|\x92A\x8e\xdb0\x0cE\xd7\xd6)\xb8\x08\x10\xa7\xf0\xf8\x02F\x97=\x87AK\xb4G3\x0c\xa5J\x14:\xbd}!)\xceLQ\xa0;\xf2\xf9\xf3\x93\xf9\x11\xb2R\x02\xc5\x8d	\xf45\x11\xbal\x06t\x0el\xe0r\x17\xe0`\xdf\xc9\xc1\x16\x02\x83\x04\x05)\xcc\xe0h\xc7\xc2\n;r\xa6\xe9/}Vo\xdf\x7f\xffO\xbf\x18c\x13\xa1\x12xq\xf4qn]\xfb\xe4\xea\xdd\x07\x049)\x8c\x1d\xdf\xcc\xf0\xeb\x95\x12=\xfc\x17c^^\xe0\x87\xd8\xe0\xce\xb3\xcf\x03\xbch\x80\xac\xa9X=\xf7\x84\x04\x89\"\xa3%\xd8\x8bX\xf5A\x80\xda\xec\xa8\x8f\xe9<A\xc4\x83`\xf3\x87\x17\x9d\x801\xeb\xfa\x85\xdcL\"-I2\xbc\xe5 \x9ba\x94\xa3\xd4\xcf\x91\xe3\x91\x7f2\xe4\x1ea\xc4\x84\xcc\xc4\x90q\xaf\xc7&o\xd5`\x86\xcb\xc5ltx1C\xf7\xe96\xebV<\xbb5lodu4\xc3p\xf5\xee:\x81\xce\xdeM\xb5\x8b!\xebjC\x11\xbdN\xf0\xd9\x8cUpk\n\x7f\xc7\x83\x9e\x92j1db\xb2\n\x8d\x8d\xdfn\x15\xed)\xdc\xdb|\x86X\xfb\x1ee\x9c\x1f\xc9}o\x1b\x01\xc5A\x9c\x9b#\xf8\xfc\xfc\xf7\xcc0\xf4]5\x8ez\x07\x1e\xd4\xfagF\xd7/y\xb5/=w\xb7\x06\xa9\xbf&\xacE\xfc\xc7\xa8\xf3'\xee\x86[\xb9\xc7\x7fUO\xdaE\xb9\xb4t\xaa\xd1\xfc\xa8\x1bW<r\x83\xb5h\xa4?\xd5\xc6z\xd9h\x7f0\x8d\xf6\xd2\x0c\xb7\xc5\x90\xb8\xc5\\.\x8b\xf93\x00PK\x07\x08\xde8 Lz\x01\x00\x00\x00\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe0\x11Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00migrations/019_banned_images.sqlUT\x05\x00\x01$\xda\xd2j\x84\x8f\xc1J\xc3@\x10\x86\xcf\xd9\xa7\xf8\xbde!-I!^\xa4\x07AA\x0f\x9e|\x800\xd9\x9dv\xa3\xc9l\xdd\x9dX\xf4\xe9%\xa9\x14<y\x1b\xe6\x9f\x8f\xff\x9b\xcd\x06\xcf\x13\x1d9#\xf1\x1b;e\x0fR\xcc\xa71\x92\x87\x0e\x13o\xf1H. \xc53z\x92\x0c\x1e4p\x02	^\x9f\xee\x1b\xc4u|yh\x11(\x87\xadq\x89I\x19J\xfd\xc8\x0b \xec\xbb\xe1RP\x9a\"\x07j\xd0\x7f)\x13f\x19>f\x86\x0b\xec\xdeQF\xa7\xac\xdd\xc8r\xd4P.W\x16{\xecj[\x99b\xf2\xed\xff\xc8\xe4\xdb\x85hn\x17\"1\xe5(\xf8\xa4\xe4\x02\xa5\xb2\xa9k\x0b\x89\n\x99\xc7\xb12EV:\x1c\xae\xe9\xeeox\xf1\xf7]\x94\xf5\xfb\xac4\x9d\xf4\xbb\xa3Yc'\xf1\\\x99\xe2\xb7~\xb5\xc4\x90W\xd0\xe2f\x8fE\xe2\xba\xb0\xc6\xde\x99\x9f\x01\x00PK\x07\x08\xd7\x19\x96\x9d\xdf\x00\x00\x00]\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00F\x12Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00migrations/020_image_dhash.sqlUT\x05\x00\x01\xe5\xda\xd2j\x8c\x90\xc1n\x131\x10\x86\xcf\xf8)~\x95H\x9b\x95\x92\x8aC\x95\xc3F}\x85\x8a7\xa8\xc6\xde\xd9\xdd\x01{\x1c\xec1\x01Q\xde\x1dm\xb3\x02\xca\x89\x8bey\xfe\xd1\xf7\xfb;\x1e\xf1\x91K\xe0\x8b5\x8a\x18e\x9a\xb8\xb0\x06\xc6Bu\xe1\x8a<A\x12\xcd\x0c[Z\xf2J\x12\xeb=\x9eZ\x8c\x07\xc8\x04[x\x1b/T\xa1\xd9\x1d\x8f\x7f\x82\xf7\x8e\xa2q\x81\x91\x8f[\xac\x82\xc6\x11!\xc7\x96\x14\xe3\x8a\x80\x97Y\xd4\xce\xce\xbdm\xf2:\xcb\x13\x08\x9eTy\xbc\xed\xff\x03\xbdRE\xb5\\x\x04\xd9\x1a\x84I\xe27\xd8\xdb\xf6\xf3\xff\xd0\x9fZ\xf2\\V\xe8M\x83\xe8\x0c/V\xe1\xd9\xae\xcc\n\xbbf\x9c\x1e\xd6\xb7\xcd\x8e\x0b\x85\xc9\x18S\xd3`\x92\x15\x0b\xa5$:?\x8fR\x8d4\xf0\x9e6\xc2\x01~\xbb\xf5\xae\xb0\xb5\xa2\x15\xa2\xe6\"\xe9\xdcV\x7f\xf5K\x84\xa4\xd4n\xb2\xaa\x15	\x86\x0b\x15\x8a\x91#*M\xec\xa8b\xb7s\xef*G\x0e\x86\xc8:\xdb\xb2/|\x89\x14x\xbf'\xbc\x87\xef\x87\xc1\x8b\xedO\x0f\xfd0\x18\x7f\xb3\x03\xba\x0f\xdd\x01]\xd7\xf7n\xb7;;\xd7.\xe3Z8\x91\xa8\xabl\xf8J\x11\x8f\xaf\xe7\xcb\x0b\xba\x1fw\x7f\xeb\xfa\xfd\x8b\xbb\xe1\xf4\xb3\x1b\x86O5\xabw\xd7\x85\x0b\xe33\x7f\xc7#\xba\x90u\x92\xb9;\xbb_\x03\x00PK\x07\x08Q\xa0\x1a:S\x01\x00\x00G\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x99\x12Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00migrations/021_filters.sqlUT\x05\x00\x01\x83\xdb\xd2jtS\xddj\xdc:\x10\xbe\xb6\x9eb\x0e,x\x17v\xc3rn\x97\x14\xf2\x00\xedE_\xc0\x8c\xa5\xb1,\"K\xea\xcc\xb8\xc9\xf6\xe9\x8bb{c(\xb9\xb3\xc5\xa7\xefoF\x97\x0b\xbc\xb8)\xa4\xcb\x84	=9\xe09\x92\x00\x96\x12\x039\xd0\x0c%\x8bB\x9f] \x81\x9c\x80\xd0\x8e0e\x17\x86`QCN\xc62\xa1\x12(\xf6\x91`\x08Q\x89\x05\x8e\xa6	\x0e\xfa\xe0\x858`\x84\xc2aB\xbe\xc3+\xdd\xcf\xa6)\xa8J\x9c\xe07\xb2\x1d\x91\x8f\xff_\xaf'HY!\xcd1\x82\x1d\xc9\xbe\xc2q\x03\xfd\xf7\x0cm{:\x9b&H\xc7\xe4\xe9\x1d\xfa\x9c\xe3'\xdc\xd1\x80sT\x180\n\x9d\x8di.\x17\xf8I\xa2\x1c\xac\n\xe8\xb8\x99\xaaatdB'\xf0\x16t\x04\x1d\x83\x80\xa2\x7f\x82\x1f\x95g\xc8\x0c>\xe6\x1e\xe3zA\x9eL\xa3\xe8w.O\x95\x1em\x8d\x0d2a\x8c!\xe9\xc3\xc8*\xfd\x1d\xd5\x8e\xb5:zW\x08\x02L%\xa2%\xb7\xd3\xec\xef\xdb\xe9DI75\xd3\xec\x0f?E\xaf\xa7\x7f\xb3\xb6\xed\xaa\xf6\xe2\\\xd5\xca\x1f9q\xd61s+ \x05'\x10\x9b\x99\xaa\xd6\xee\xef!U\xcf\xba\x15\x11\xfc>\xc6\xa3\xcf\xeb6\x88\x1d\xf6\xdb3,%,Cw]\x7f\xdf\xd7\xb3\xeb\xe2\x81\xc8	4L$\x8aS\xd1?\x1d\xce\x9a\xbb\x94\xdf\xcc\xe9f\xb6\xd5\xc9\xbc\xf5\x01\xc3\x9c\x96zS\xd60\xdc\xbb\xd5p7\x17W\xf5\x8e'\xc3\xa43'\x01\xe5\xe0=\xb1\x89\x98\xfc\x8c\x9e\xa0\xc4\xe2\xe5W4(p8\x98\x9e|H\xa6)\xc4C\xe6	\x8a\xef\x16\xc6c\xbbR>\xad\x94\xed\xb9\xae\xd7\xad\xb6_\x89?V\xf0f(\xb9\x9b9\x1c>=\xaer_\xf828(1\x84$\xc4Z\xf3,\xe4\xf5\xcbQ$\xa5\xfat\xd6;\xa6.\xda\xc73\x12E]\xa6M\xefdg%(\x9c-\xb9\x99\xe9\xcb\xfc7\xf3w\x00PK\x07\x08\xb6\xa0o\xee\xdc\x01\x00\x00\xb3\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe3\x12Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00migrations/022_ban_appeals.sqlUT\x05\x00\x01\n\xdc\xd2jT\x90Mr\xab0\x10\x84\xd7\xe8\x14\xbd4U\xe0\xe2\xbd\xadW\xef\x04o\x91\x03P\x12jl%\xb2D\xa4\xc1\x98\x9c>%\xff\xc4d7U=\xd3\xfdM\xb7-\xfeM\x13\xb5\xcf\x88#\x8c\x0e\x19f\x85\x9cX\xe6@\x8b93\xe5=\xfe\x07\xbf\"\x06B\xdf\xb611\x95\x0d\xb8\x0c\xed}\\h\xf7jH\xd4B\x886\xfev\xde\xeb\x87\xf3NU\xce\xc2\xb8cfr\xe58\xb9\xb3N+>\xb86JUm\x8b7\n$\"\xcc\xde7XN\x0cO\x84\x12\xe0\xdd(\xb4\x88	\xbcN.1\xab\xaaD\x1bwtA0\x07\xf79\x13\x89#\x13\xc3\xc0\\\xa23b\x80\xa5\xa7\x10\x99r7V\xaa2\xd1\xae\xb8\xe84\x9ct\xda\xfd\xe9\xba\xaeF\x88O\xb9\xba?`\xfb\x18 \xee\xcc,\xfa<\xc9W\xafg\x89}\x88\xcb\x8bu\xc3\xf8\xe8\xa3\xf40\x0c\x9c\x1e\xa0\x89\xef\x1c\xcalVd\xd1\xe3\xa8\xaa\xc4\x8b\xe3B\xdb\x9b\x17\xc1\xdf\xaen6\xca\xef\xdcFU?\x96&F\xaf\xea\x83zv\xec\x82\xe5u\xdbq\xbf1\xe9\x9d\xbd\x96\xff72v\x1b\xbd>\xa8\xef\x01\x00PK\x07\x08v\xb8\x0eH\x1a\x01\x00\x00\xf7\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x16\x13Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00migrations/023_tag_staff.sqlUT\x05\x00\x01l\xdc\xd2jt\x90An\xeb0\x0cD\xd7\xd6)fi\x036\x10d\x9b\x1b\xfc\xed?\x80\xc1H\xb4-D&\x05\x89\x89\x93\x9e\xbep\x936\x9bvC\x10$1\x9cy\xc3\x80\xffF\xd3\x84\xac5ZT\xa9\xa8^3\x07\x98\xc2\x96\xc2\x14*\xb6h\x0b\x085\xb3\x8fS\xf40\x9a\x9d/L\xc60:\xa7\xbd\xcec\xfd\x92i]C\xde\xebU\x0c7*~\xa1\xd2\x1e\x0f\x1dD\x0drM	\x85'.,\x9e+^w\x15*\x08\x9c\xd8\x18\x9e\xaa\xa7\xc0\xbdk\x8c\xe6_\x05z\xe7\x9aa\xc0?\x92hZz\xac\x1a\xb8\x90i\x81\x96\xdd\x06t\x13.\xaeI|\xe3\x84\xbaRJQ\xec\xfd\xdf/\xec/h\x9f\xeb3\xdb\xc6,8\x80$\xe0\xd8\xed\xe2\x94\xb3F1\x0e\xe3\xf9\xf1\x87\x83\xe6\x99=\x8c*\xb0\xb8r5Z\xb3}\x8ct5\x1dE\xb7\xde5\xb9\xc4\x95\xca\x03\x17~\xa0}\x05\xedw\x7f\x9d\xebN\xee\x1b^\x94\xc0\xf77\xbcq\xefb\xb8\xef@~\x86h\x8d\xe6\xee\xe4>\x07\x00PK\x07\x08\x8d\\\xf5\xc8\xff\x00\x00\x00\xa9\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x82\x13Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00migrations/024_thread_pruning.sqlUT\x05\x00\x015\xdd\xd2jt\x8fMn\xeb0\x10\x83\xd7\x99S\xcc\xc2@l\xe0!\x07\x88\x0f#(\x12\xad\x08PFz\xe3\x11\xea\xde\xbe\x0b\xb9?\x8bvI\x10\xfcH\x06\x857p\x96\x88\x83\xed\xa9\xf0qw\x8f\xfej\x88\xae\x8a\xcb\xf1\xe0*\x9f\x06\xcf_\xce\xb2\x12\x9d\xd9\xaa\xach\xc5\x07\xf0\xd6%X\xae\xc2R-o\xefn\xe4\\D\x81!\xce\x0b)\xac\xab\xecl\x9aS\x82R\xf1\x92\xbaO\xe0VZ\xda\xff\x17\xf2;O\x13=\x90\xb2\xd0\xa5A\xb7\xaa/n\xc9\x0d\xe0|\x1d\xc4\xdbI\xbc\xfe\xe3Z\xe2-\xc7\xfb\xddp\xd8\xb2\xd2e4\xb0\xf4RV\x82\xc4\x95\xa6\xe9{\xeb\xd9\xfb\xfb>\xf2\x9bAy\xa8\x1f\xb7i\xab\xca\xf0\xe1\xc9Z\xdf\x18\x07B7p\xd3\x1a\x10\xbb\xe2\xaf\xb3+}\x0c\x00PK\x07\x08\x0b ^w\xc6\x00\x00\x00]\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x11\x16Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00migrations/025_mod_log_ban_targets.sqlUT\x05\x00\x01\x02\xe2\xd2jl\x8dAN\x041\x0c\x04\xcf\xe4\x15\xfd\x80\xcd~\x80?p\xe2>r6N\xb0\xd6c\x8f\x12\x07i~\x8f\x06\xb8\xc1\xb1U\xa5\xae\x9c\xf1N\xa3sLx\x83z\xef\\Q\xc8&\xc8*TZ\xfc\xee;\xde<\xd0|\xb0t\xc3\x93\xcfy\xc3t\xb0\xc5\x10\x9e\xf0\x15*\x9f\x9crF|0\x8eUT\x1e\xdf\xda=\x91\x06\x0f\x04\x15e\xec^7\xf5\x9e^\xa8V<\\\xd7nWn\x93\x03b\x1c\xb7?\xe0\xe7i{\xf2\x89\"]\xec\x1f\x85T/>Q\xdc\x15\xe6\x01[\xaa\xa8\xdchi\xa0\x91N~M_\x03\x00PK\x07\x08\x9a\xf6\xe8\xe5\x97\x00\x00\x00\xea\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00J\x16Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00migrations/026_body_text_code.sqlUT\x05\x00\x01m\xe2\xd2j\x9cTMo\xe36\x10=\x8b\xbfb\x90xMka_\xdaC\xd1\xd5\xc6\x87.\n\xb4\xc0\xf6\xd0$=\x15\xadAKc\x89\xf1\x88T\xc8\x91c\xa3\xe9\x7f/\x86\xb2c;E>\xb0\x07\x89\xc37\xe2\x9b\xd1\xbc\x19\xcef\xf0\xf3\x96\x83)\x19\xb8A`\xdc2\xf8\x15\x18(}\x85\xe0\xe4\xb5\n\xbe\x05\xcb\x11\x1a[7d\xeb\x86\xb1\x82_n\x7f\xfb\xaa\xca\x80\x86\x11|\x80\x80\x1d\x99\x12a\xd5\xbb\x92\xadw\xe9\xf8B\xd8&\x0d\xb7\x94xs\x15\x90\xfb\xe0b\xda)2\xae\xeeM\x8d\x10\xef	l\xdb\xf6l\x96\x84\xd0\x99`\x88\x90 \x9a\x15B\xe4`KV&\xc2h\xa4\xb2\x88\x84%\x1f\x82MT\x96\x9d\x98g\xf6\xf9\xe6\xd9\xee\xf96\x0bX\xe3\xb6[\x1c`\xc9x\n\xfa\xf3\x9f\x7f\xcf\xff\xfa8\xd7S\xd0\xf2\xd4:\x9f\x1e\x0e\xe81q!\xe0g\xbd\x87\x9e|z\\\x0f\xae\xb9Vg\x1e=\xbe\xef\xfd\xe0\xba\xd0\xea\xc4\xa3\xc7\x97\xdf\xff\x98p\xad\x93c\xe0\xd2\xe3\xcb\xedw?\x9c\xe0	\xd6c\xd3v	\x1ck\x95\xe5\x85\x1a\x8d\n\xa5NT4D\xd0G\x0c\xb3\x8d\x8dV\n*\xc5\x1e44\xd0\xf9\xc8\xb0\xf4\xd5\x0e8 \xbe\xa6\x9f|4\xe8\x97z\xe0.z\xb7|I\xc0\x8e\xba\xfa\xdd\"VX\x92	\xa8\xb25\xee\x12O\xa1\xb2\x8d\xa1!B\xa12\xdfs\x82\xe1\n\xb4.\xd4\x12k\xebTV\x9a\xb8Ob\xc1\xbb\x0e\xfd*\xa5\x95\xab\xec\xa1A\x07\xda/\xef\xb0d--\xecT\x96\xad|\x805\xee\xa6 \xc4\xd6\xc1\xbeo>\x0eeH\x91\x16h\xca\xe6@\x92\x91\xf7\x9dT>\x85Y\xe3N\xec\x81\xf9\x16\xb7,\xd5\xfe\xe3\xfa\xeb\x13}\x96\x92\xbc\x02y?>\x82\x06-\xcbD\x82]\xce\xe7\xa0\xff\xf9W\xe7\xc5\x91\xe2\x8b\xaf\xf0\x8d\xb3\xc7iy\x89\xe4\x1aW\x18\xd0\x95o3\x19\xc2X\xa2\x10\xcd\xe6sMf\x89$?p\xc6vc\x97d]\x1d\xc5q\xd3yK\x18\xc4\xfc\xc9S%\xeb\xafl\xc8\x96b\xfd\xde{\xc6\xea\xbd1\x8fM\xb31\x94\x1f\x83\"E\x945s=Q\xaa\x0c\xba\n\xa4\xd8\xb2\x11[\x04(\x0ej\x9a\x10\xcc\xee)\xa6\x88\xf9\x8a\x8e\xe9\xe3\x05\x12\xb6\xe88\xfeO\xd1o\xc9\xf7$\xa1}\xe6\xfb\xbcO\xb2\x1e\xae2i\x81B\xa1\xab\x8esx\x8d\xd6U\xb8M\xa3\x16\xe1\xc1r\x93\xd4\x9d\x027\x86\xa11\x95\xfc\xd7\xf1*\xb5\xae\x86\xd6\x84u\xdfA:\x87\x95\xea\xbbJn\xd5D\xa0\"\xf2~\x16\xe3\x06\xae\x80\xfd\x82\xe3\x06K\xf6a\xa2\xa3m;B==\x19V\xb1\xf2\\=4\x180\xc1\x9f>	\x0ed\xd7\x08\xfa\xc3\xc5\x17_\xe1\xc5\x07]\xa8\xff\x06\x00PK\x07\x08N\xfe\x88\xcd\x9e\x02\x00\x00\xf8\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x1b\x18Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00*\x00	\x00migrations/027_mod_log_filter_patterns.sqlUT\x05\x00\x01\xe6\xe4\xd2j\x00\x83\x00|\xff-- Filter patterns must not be public. Remove them from existing log entries.\nupdate mod_log\nset data = ''\nwhere type in (14, 15);\n\x03\x00PK\x07\x08\xb0\x175|\x8a\x00\x00\x00\x83\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00c\x18Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00&\x00	\x00migrations/028_post_updated_notify.sqlUT\x05\x00\x01k\xe5\xd2jl\x90An\xe30\x0cE\xd7\xd5)\xfe\"@\x13\xa0\xc9\x01\xea;\xcc\x1c!\x90\xa5o\x9b\x18E\xd4Pt\xdd\xdc~\x90\xb8\xb3\xcb\x8e\x0b\xe2\xf1\xf1\x9d\xcf\xf8\xa5.\xd3\x1d)\xa6\x85\x1d:\xe1\xa6Y&aF\xd3\xee\xfd\x82\xdf\x8d\xf59c\xd4,\xec\x88Fl&\xee\xac\x18\xef\xf0\x85\xe1|\xc6\xc6\xb1k\xfaCG\xa7}\xd1\x90\xc5\x98\xbc\xdc?\xd0\x15\xbeH\x87t\xf8Bh-w\x18\x8b\xc4\xb1\x10\xfc\x92\xe4\xa2\x15M\xa5\xfa%$ctB\x0d\xc6Vb\"\xa6\xb5\xee\x1b\xf5\xa9z}\xb8\\\xd7\x96\xa33\x1fO\xc1\xe8\xab\xd5\x0e7\x99gZ(\xb1\xcek\x9c\x89V\xda\xdc\xff\x96\x10;\x0e\x870r\x96\x1a\xde\x1amR\xbb\xa1\xcd\xd7\x1dw|\x7f\xf0.?\xbc\xf7\x0fTn\x17\xc9\x9f\x9f\xceo?\x0d\xe1m\xe7\xa3\xae\xa5\x0c\x815\x0f\xe1p\x18\xc2\x7f\xcf\x9f\xab\xaf\xdcB\x9c\x9c\x86\x9d\x8c\xe7\x87\xdd{\x98\xd4\xc0\x98\x16\x98na[Xq\xd4\x92\x1fq\xb2t\x97\x9a\x1c\x93\xe9\xed\xe1q\n\xfcfZ\x9dh\xa6\x89y5\xbe\x8e0\x84\x7f\x03\x00PK\x07\x08\xc0b\xfb{\x18\x01\x00\x00\xca\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xcc\x18Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00migrations/029_thread_counters.sqlUT\x05\x00\x011\xe6\xd2j\xa4T\xcbn\xdb0\x10<s\xbfb\x0e\x06\"5v\xd0^#\xe4V\x1f\x8a\x16=\xb4\xb9\x1b4\xb9\x92\x88\xd2\xa4J\xae\xea\xe4\xef\x0b=\xe2(i\x0d\xf7q\x13\x85\xdd\xd9\x99\x9d\xc1n6\xf8\xc8\xdc\xa1\x8bY\xa0\x83\x85;\xe8\x86ab\x1f$#\x06H\x9bX\xdb\xbcF\x8e\x90\x96\xe1\x82\xe5\x07\x18\x1d\xb0g\xe4\x98\x84-\xf6\x8f\x90\x96\x0f\xb4\xd9\xe0\xe8\xa4\x8d\xbdL\x00.4c\xcf\x00\x9e\x11k\xf0\x0fN\x8f3$i/\x9c z\xef\xf9i\n)m-L\xf4\xfd!\x8c]\xbb\x11\x07{\xd7\xb8 \x08Q\x10z\xefa\xb9\xd6\xbd\x17\xbc]\xbf\xe8\x18\xb9_j\xa9\x88\xfa\xcej9\x0d\x85PfY\x8e\xbbCAJe\xf6lf%\xc5\x9b\x92\x94\xaaS<\xccb:R\xea\xd8rbt7\x13y\xdcAn\x9c%U\xaeI-\x99\xfc\x0f\xdahIw3\xc2\xc1\xe5\xd3\x06H\x95\x15\x91I<\xe8\x98,\x99\xd5\xec\x9eu\xec\x9c} \xf5\xec!\x8a,\xce|{\x84\xe5l\xd6K\xc1\xd3\x0fg\xc7\x8f\xb2\xfa=\xf0B\xd3\x05\xe4\xa5\xfa\xd7\xd0O\xd81!q\xe7\xb5a\xd4}0\xe2b\xc0\xe4\xcbn\x02\x9d|\xe4\x94\x8b\x92\x12K\x9fB\x86$\xd74\x9c\xc8\xeb\xd0\xf4CN;\xdf5\xf9\xbb'\x9d\xb1Z\xd1\x9e\x1b\x17H\x19\x9d\x19\xd2\xecbG\x83G\x01W\x1f>\x7f\xdd~\xb9\xbf\x1a\xc2\x18H\xa9\x97\xfe\x8f\xe6\xbc\xf2\x7f\xf1\xb8\xc6\xbb5)\xf5\xca\xd2\xe5\xeb\x1aE\xe0\xe3\xaf&\x95\xb7\xb7.\xc8)(n\x88\xc8P8\xe9\xab\x9e\xc8\xbd\xdf~\xda\xdeo\xff\x8d\xdc\xe6\"\xb9\x0d\x8a\xe8\xed\x9f\x91\x1b\nO\xe4\xd8g>\xb7\xab\xb3\xbb\x18\xb8\\\\\x87\xfa\x1bN/\x16\xc6\xc1bp\xb7\"5Eb<\x07\x15q\xb0\x15\xadV\xcf\xe9\x9a\x83r&Q\xa4\xeb\xe1\xf4\xb8\x909\xc9\x90D\xcb\x9e\xa7L\xce\xd1\x88\xf5|\x08\xe3t\x872\xd51\x81\xb5i\x91\xe2\x11\xfc\xc0\xa6\x17F\x97\xa2a\xdb'>3\xa8(+\xfa9\x00PK\x07\x08\x8c`ZL\xfa\x01\x00\x00b\x05\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P]12\x95\x96^\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x04\xd7\xecx\xa6\x02\x00\x00\x8f\x04\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdf^\x00\x00migrations/001_init_main.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\xd5\xc6\x033d\x01\x00\x00\xe4\x02\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd8a\x00\x00migrations/002_images.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x07\xe1\x0b\xcel\x03\x00\x00\x16	\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8cc\x00\x00migrations/003_threads.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\xd1\xe6\xed\xc5\xd6\x00\x00\x00\xcd\x01\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81Ig\x00\x00migrations/004_antispam.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x1d\xd4\x12-\xa2\x00\x00\x00\x9b\x00\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81qh\x00\x00migrations/005_feeds.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\xa6\x97\x9a\xaeO\x02\x00\x00a\x05\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81bi\x00\x00migrations/006_image_insertion.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P>\xe6\xe0+\xe0\x02\x00\x00F\x07\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\nl\x00\x00migrations/007_thread_reading.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x81!\xcb\x17\xfb\x02\x00\x00\xa5\x07\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81Bo\x00\x00migrations/008_thread_add_last_page.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa7v\xd4P\x16\xbb\xee\xd9\x9f\x01\x00\x007\x03\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x9br\x00\x00migrations/009_validate_tags.sqlUT\x05\x00\x01\xda\"\xee^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x17\x0dQ]\xd7\xc6\x9bJ(\x02\x00\x00\x19\x04\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x91t\x00\x00migrations/010_tag_stats.sqlUT\x05\x00\x01\x1f\xd2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x009\x0dQ]\x00;S\xc4L\x02\x00\x00@\x05\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0cw\x00\x00migrations/011_post_search.sqlUT\x05\x00\x01^\xd2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00%\x0eQ]\x0f\n_;\x1f\x01\x00\x001\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xady\x00\x00migrations/012_bans.sqlUT\x05\x00\x01\x17\xd4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00g\x0fQ]>\xfee{F\x02\x00\x00g\x05\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1a{\x00\x00migrations/013_ban_ranges.sqlUT\x05\x00\x01r\xd6\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00	\x10Q])\x0b\xa1\x8e\x10\x01\x00\x002\x02\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb4}\x00\x00migrations/014_mod_log.sqlUT\x05\x00\x01\xb2\xd6\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00K\x10Q]\xeeB\xc1\x85^\x01\x00\x00\xbe\x02\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x15\x7f\x00\x00migrations/015_reports.sqlUT\x05\x00\x01.\xd7\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x92\x10Q]\xe4&\xe3\xa1\xee\x00\x00\x00\xc6\x01\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc4\x80\x00\x00migrations/016_accounts.sqlUT\x05\x00\x01\xb5\xd7\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00 \x11Q]\xbdl\xf7\x05\x17\x04\x00\x00\xbe\x0b\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x04\x82\x00\x00migrations/017_post_moderation.sqlUT\x05\x00\x01\xbc\xd8\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00y\x11Q]\xde8 Lz\x01\x00\x00\x00\x03\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81t\x86\x00\x00migrations/018_thread_flags.sqlUT\x05\x00\x01g\xd9\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe0\x11Q]\xd7\x19\x96\x9d\xdf\x00\x00\x00]\x01\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81D\x88\x00\x00migrations/019_banned_images.sqlUT\x05\x00\x01$\xda\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00F\x12Q]Q\xa0\x1a:S\x01\x00\x00G\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81z\x89\x00\x00migrations/020_image_dhash.sqlUT\x05\x00\x01\xe5\xda\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x99\x12Q]\xb6\xa0o\xee\xdc\x01\x00\x00\xb3\x03\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\"\x8b\x00\x00migrations/021_filters.sqlUT\x05\x00\x01\x83\xdb\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe3\x12Q]v\xb8\x0eH\x1a\x01\x00\x00\xf7\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81O\x8d\x00\x00migrations/022_ban_appeals.sqlUT\x05\x00\x01\n\xdc\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x16\x13Q]\x8d\\\xf5\xc8\xff\x00\x00\x00\xa9\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbe\x8e\x00\x00migrations/023_tag_staff.sqlUT\x05\x00\x01l\xdc\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x82\x13Q]\x0b ^w\xc6\x00\x00\x00]\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x10\x90\x00\x00migrations/024_thread_pruning.sqlUT\x05\x00\x015\xdd\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x11\x16Q]\x9a\xf6\xe8\xe5\x97\x00\x00\x00\xea\x00\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81.\x91\x00\x00migrations/025_mod_log_ban_targets.sqlUT\x05\x00\x01\x02\xe2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00J\x16Q]N\xfe\x88\xcd\x9e\x02\x00\x00\xf8\x05\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\"\x92\x00\x00migrations/026_body_text_code.sqlUT\x05\x00\x01m\xe2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x1b\x18Q]\xb0\x175|\x8a\x00\x00\x00\x83\x00\x00\x00*\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x18\x95\x00\x00migrations/027_mod_log_filter_patterns.sqlUT\x05\x00\x01\xe6\xe4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00c\x18Q]\xc0b\xfb{\x18\x01\x00\x00\xca\x01\x00\x00&\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x03\x96\x00\x00migrations/028_post_updated_notify.sqlUT\x05\x00\x01k\xe5\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xcc\x18Q]\x8c`ZL\xfa\x01\x00\x00b\x05\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81x\x97\x00\x00migrations/029_thread_counters.sqlUT\x05\x00\x011\xe6\xd2jPK\x05\x06\x00\x00\x00\x00\x1e\x00\x1e\x00\xfa	\x00\x00\xcb\x99\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
@@ -0,0 +1,154 @@
+// Code generated by qtc from "archive.html". DO NOT EDIT.
+// See https://github.com/valyala/quicktemplate for details.
+
+//line archive.html:1
+package templates
+
+//line archive.html:1
+import "github.com/bakape/meguca/common"
+
+//line archive.html:2
+import "github.com/bakape/meguca/config"
+
+// ThreadArchive renders a self-contained static page of a thread for offline
+// viewing. Pages link to each other by ArchivePageName.
+
+//line archive.html:6
+import (
+	qtio422016 "io"
+
+	qt422016 "github.com/valyala/quicktemplate"
+)
+
+//line archive.html:6
+var (
+	_ = qtio422016.Copy
+	_ = qt422016.AcquireByteBuffer
+)
+
+//line archive.html:6
+func StreamThreadArchive(qw422016 *qt422016.Writer, c config.Configs, t common.Thread) {
+//line archive.html:6
+	qw422016.N().S(`<!doctype html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width"><title>`)
+//line archive.html:12
+	qw422016.E().S(t.Subject)
+//line archive.html:12
+	qw422016.N().S(`</title><style>body {font-family: sans-serif;background: #eef2ff;margin: 1em;}article {background: #d6daf0;border: 1px solid #b7c5d9;margin: 0.5em 0;padding: 0.5em;display: table;}article.op {background: none;border: none;}figure {float: left;margin: 0 1em 0 0;}.spaced > * {margin-right: 0.5em;}.name {color: #117743;}del {background: #000;color: #000;text-decoration: none;}del:hover {color: #fff;}em {color: #789922;font-style: normal;}</style></head><body>`)
+//line archive.html:55
+	l := archiveLinks(c, t.ID)
+
+//line archive.html:56
+	streamarchivePagination(qw422016, t)
+//line archive.html:56
+	qw422016.N().S(`<section class="thread">`)
+//line archive.html:58
+	for i, post := range t.Posts {
+//line archive.html:59
+		if i == 0 {
+//line archive.html:60
+			streamthreadHeader(qw422016, l, t)
+//line archive.html:61
+		}
+//line archive.html:62
+		streamrenderPost(qw422016, c, l, post)
+//line archive.html:63
+	}
+//line archive.html:63
+	qw422016.N().S(`</section>`)
+//line archive.html:65
+	streamarchivePagination(qw422016, t)
+//line archive.html:65
+	qw422016.N().S(`</body></html>`)
+//line archive.html:68
+}
+
+//line archive.html:68
+func WriteThreadArchive(qq422016 qtio422016.Writer, c config.Configs, t common.Thread) {
+//line archive.html:68
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line archive.html:68
+	StreamThreadArchive(qw422016, c, t)
+//line archive.html:68
+	qt422016.ReleaseWriter(qw422016)
+//line archive.html:68
+}
+
+//line archive.html:68
+func ThreadArchive(c config.Configs, t common.Thread) string {
+//line archive.html:68
+	qb422016 := qt422016.AcquireByteBuffer()
+//line archive.html:68
+	WriteThreadArchive(qb422016, c, t)
+//line archive.html:68
+	qs422016 := string(qb422016.B)
+//line archive.html:68
+	qt422016.ReleaseByteBuffer(qb422016)
+//line archive.html:68
+	return qs422016
+//line archive.html:68
+}
+
+//line archive.html:70
+func streamarchivePagination(qw422016 *qt422016.Writer, t common.Thread) {
+//line archive.html:71
+	if t.LastPage != 0 {
+//line archive.html:71
+		qw422016.N().S(`<nav class="spaced">`)
+//line archive.html:73
+		for i := uint32(0); i <= t.LastPage; i++ {
+//line archive.html:74
+			if i == t.Page {
+//line archive.html:74
+				qw422016.N().S(`<b>`)
+//line archive.html:75
+				qw422016.E().V(i)
+//line archive.html:75
+				qw422016.N().S(`</b>`)
+//line archive.html:76
+			} else {
+//line archive.html:76
+				qw422016.N().S(`<a href="`)
+//line archive.html:77
+				qw422016.E().S(ArchivePageName(i))
+//line archive.html:77
+				qw422016.N().S(`">`)
+//line archive.html:77
+				qw422016.E().V(i)
+//line archive.html:77
+				qw422016.N().S(`</a>`)
+//line archive.html:78
+			}
+//line archive.html:79
+		}
+//line archive.html:79
+		qw422016.N().S(`</nav>`)
+//line archive.html:81
+	}
+//line archive.html:82
+}
+
+//line archive.html:82
+func writearchivePagination(qq422016 qtio422016.Writer, t common.Thread) {
+//line archive.html:82
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line archive.html:82
+	streamarchivePagination(qw422016, t)
+//line archive.html:82
+	qt422016.ReleaseWriter(qw422016)
+//line archive.html:82
+}
+
+//line archive.html:82
+func archivePagination(t common.Thread) string {
+//line archive.html:82
+	qb422016 := qt422016.AcquireByteBuffer()
+//line archive.html:82
+	writearchivePagination(qb422016, t)
+//line archive.html:82
+	qs422016 := string(qb422016.B)
+//line archive.html:82
+	qt422016.ReleaseByteBuffer(qb422016)
+//line archive.html:82
+	return qs422016
+//line archive.html:82
+}
@@ -10,79 +10,252 @@ import "github.com/bakape/meguca/config"
 //line index.html:2
 import "encoding/json"
 
-//line index.html:4
+// Page is server-side rendered page content
+
+//line index.html:5
 import (
 	qtio422016 "io"
 
 	qt422016 "github.com/valyala/quicktemplate"
 )
 
-//line index.html:4
+//line index.html:5
 var (
 	_ = qtio422016.Copy
 	_ = qt422016.AcquireByteBuffer
 )
 
-//line index.html:4
+//line index.html:6
+type Page interface {
+//line index.html:6
+	Title() string
+//line index.html:6
+	StreamTitle(qw422016 *qt422016.Writer)
+//line index.html:6
+	WriteTitle(qq422016 qtio422016.Writer)
+//line index.html:6
+	Meta() string
+//line index.html:6
+	StreamMeta(qw422016 *qt422016.Writer)
+//line index.html:6
+	WriteMeta(qq422016 qtio422016.Writer)
+//line index.html:6
+	Content() string
+//line index.html:6
+	StreamContent(qw422016 *qt422016.Writer)
+//line index.html:6
+	WriteContent(qq422016 qtio422016.Writer)
+//line index.html:6
+}
+
+// Main renders the client application shell without any server-side rendered
+// content
+
+//line index.html:15
 func StreamMain(qw422016 *qt422016.Writer, c config.Configs) {
-//line index.html:4
-	qw422016.N().S(`<!doctype html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width"><meta name="application-name" content="meguca"><meta name="description" content="Realtime imageboard"><link type="image/x-icon" rel="shortcut icon" id="favicon" href="/assets/favicons/default.ico"><title id="page-title">meguca</title><link rel="stylesheet" href="/assets/css/base.css" type="text/css"><link rel="stylesheet" id="theme-css" href="/assets/css/`)
 //line index.html:15
-	qw422016.N().S(c.DefaultCSS)
+	streampage(qw422016, c, emptyPage{})
 //line index.html:15
-	qw422016.N().S(`.css" type="text/css"><style id="user-background-style"></style><script>if (localStorage.theme&& localStorage.theme !== "`)
+}
+
+//line index.html:15
+func WriteMain(qq422016 qtio422016.Writer, c config.Configs) {
+//line index.html:15
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line index.html:15
+	StreamMain(qw422016, c)
+//line index.html:15
+	qt422016.ReleaseWriter(qw422016)
+//line index.html:15
+}
+
+//line index.html:15
+func Main(c config.Configs) string {
+//line index.html:15
+	qb422016 := qt422016.AcquireByteBuffer()
+//line index.html:15
+	WriteMain(qb422016, c)
+//line index.html:15
+	qs422016 := string(qb422016.B)
+//line index.html:15
+	qt422016.ReleaseByteBuffer(qb422016)
+//line index.html:15
+	return qs422016
+//line index.html:15
+}
+
+//line index.html:17
+type emptyPage struct{}
+
 //line index.html:19
-	qw422016.N().S(c.DefaultCSS)
+func (p emptyPage) StreamTitle(qw422016 *qt422016.Writer) {
 //line index.html:19
-	qw422016.N().S(`") {document.getElementById('theme-css').href =`)
+	qw422016.N().S(`meguca`)
 //line index.html:19
-	qw422016.N().S("`")
+}
+
 //line index.html:19
-	qw422016.N().S(`/assets/css/${localStorage.theme}.css`)
+func (p emptyPage) WriteTitle(qq422016 qtio422016.Writer) {
 //line index.html:19
-	qw422016.N().S("`")
+	qw422016 := qt422016.AcquireWriter(qq422016)
 //line index.html:19
-	qw422016.N().S(`;}window.language_pack = new Promise((resolve, reject) => {fetch(`)
+	p.StreamTitle(qw422016)
 //line index.html:19
-	qw422016.N().S("`")
+	qt422016.ReleaseWriter(qw422016)
 //line index.html:19
-	qw422016.N().S(`/assets/lang/${localStorage.lang || "en_GB"}.json`)
+}
+
 //line index.html:19
-	qw422016.N().S("`")
+func (p emptyPage) Title() string {
+//line index.html:19
+	qb422016 := qt422016.AcquireByteBuffer()
 //line index.html:19
+	p.WriteTitle(qb422016)
+//line index.html:19
+	qs422016 := string(qb422016.B)
+//line index.html:19
+	qt422016.ReleaseByteBuffer(qb422016)
+//line index.html:19
+	return qs422016
+//line index.html:19
+}
+
+//line index.html:21
+func (p emptyPage) StreamMeta(qw422016 *qt422016.Writer) {
+//line index.html:21
+}
+
+//line index.html:21
+func (p emptyPage) WriteMeta(qq422016 qtio422016.Writer) {
+//line index.html:21
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line index.html:21
+	p.StreamMeta(qw422016)
+//line index.html:21
+	qt422016.ReleaseWriter(qw422016)
+//line index.html:21
+}
+
+//line index.html:21
+func (p emptyPage) Meta() string {
+//line index.html:21
+	qb422016 := qt422016.AcquireByteBuffer()
+//line index.html:21
+	p.WriteMeta(qb422016)
+//line index.html:21
+	qs422016 := string(qb422016.B)
+//line index.html:21
+	qt422016.ReleaseByteBuffer(qb422016)
+//line index.html:21
+	return qs422016
+//line index.html:21
+}
+
+//line index.html:23
+func (p emptyPage) StreamContent(qw422016 *qt422016.Writer) {
+//line index.html:23
+}
+
+//line index.html:23
+func (p emptyPage) WriteContent(qq422016 qtio422016.Writer) {
+//line index.html:23
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line index.html:23
+	p.StreamContent(qw422016)
+//line index.html:23
+	qt422016.ReleaseWriter(qw422016)
+//line index.html:23
+}
+
+//line index.html:23
+func (p emptyPage) Content() string {
+//line index.html:23
+	qb422016 := qt422016.AcquireByteBuffer()
+//line index.html:23
+	p.WriteContent(qb422016)
+//line index.html:23
+	qs422016 := string(qb422016.B)
+//line index.html:23
+	qt422016.ReleaseByteBuffer(qb422016)
+//line index.html:23
+	return qs422016
+//line index.html:23
+}
+
+//line index.html:25
+func streampage(qw422016 *qt422016.Writer, c config.Configs, p Page) {
+//line index.html:25
+	qw422016.N().S(`<!doctype html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width"><meta name="application-name" content="meguca"><meta name="description" content="Realtime imageboard"><link type="image/x-icon" rel="shortcut icon" id="favicon" href="/assets/favicons/default.ico"><title id="page-title">`)
+//line index.html:34
+	p.StreamTitle(qw422016)
+//line index.html:34
+	qw422016.N().S(`</title>`)
+//line index.html:35
+	p.StreamMeta(qw422016)
+//line index.html:35
+	qw422016.N().S(`<link rel="stylesheet" href="/assets/css/base.css" type="text/css"><link rel="stylesheet" id="theme-css" href="/assets/css/`)
+//line index.html:37
+	qw422016.N().S(c.DefaultCSS)
+//line index.html:37
+	qw422016.N().S(`.css" type="text/css"><style id="user-background-style"></style><script>if (localStorage.theme&& localStorage.theme !== "`)
+//line index.html:41
+	qw422016.N().S(c.DefaultCSS)
+//line index.html:41
+	qw422016.N().S(`") {document.getElementById('theme-css').href =`)
+//line index.html:41
+	qw422016.N().S("`")
+//line index.html:41
+	qw422016.N().S(`/assets/css/${localStorage.theme}.css`)
+//line index.html:41
+	qw422016.N().S("`")
+//line index.html:41
+	qw422016.N().S(`;}window.language_pack = new Promise((resolve, reject) => {fetch(`)
+//line index.html:41
+	qw422016.N().S("`")
+//line index.html:41
+	qw422016.N().S(`/assets/lang/${localStorage.lang || "en_GB"}.json`)
+//line index.html:41
+	qw422016.N().S("`")
+//line index.html:41
 	qw422016.N().S(`).then(r => r.text()).then(resolve).catch(reject)});</script><script id="config-data" type="application/json">`)
-//line index.html:33
+//line index.html:55
 	buf, _ := json.Marshal(c.Public)
 
-//line index.html:34
+//line index.html:56
 	qw422016.N().Z(buf)
-//line index.html:34
-	qw422016.N().S(`</script><script src="/assets/client/index.js"></script></head><body></body></html>`)
-//line index.html:40
+//line index.html:56
+	qw422016.N().S(`</script><script src="/assets/client/index.js"></script></head><body><div id="server-rendered">`)
+//line index.html:62
+	p.StreamContent(qw422016)
+//line index.html:62
+	qw422016.N().S(`</div>`)
+//line index.html:67
+	qw422016.N().S(`<script>document.getElementById("server-rendered").remove();</script></body></html>`)
+//line index.html:73
 }
 
-//line index.html:40
-func WriteMain(qq422016 qtio422016.Writer, c config.Configs) {
-//line index.html:40
+//line index.html:73
+func writepage(qq422016 qtio422016.Writer, c config.Configs, p Page) {
+//line index.html:73
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line index.html:40
-	StreamMain(qw422016, c)
-//line index.html:40
+//line index.html:73
+	streampage(qw422016, c, p)
+//line index.html:73
 	qt422016.ReleaseWriter(qw422016)
-//line index.html:40
+//line index.html:73
 }
 
-//line index.html:40
-func Main(c config.Configs) string {
-//line index.html:40
+//line index.html:73
+func page(c config.Configs, p Page) string {
+//line index.html:73
 	qb422016 := qt422016.AcquireByteBuffer()
-//line index.html:40
-	WriteMain(qb422016, c)
-//line index.html:40
+//line index.html:73
+	writepage(qb422016, c, p)
+//line index.html:73
 	qs422016 := string(qb422016.B)
-//line index.html:40
+//line index.html:73
 	qt422016.ReleaseByteBuffer(qb422016)
-//line index.html:40
+//line index.html:73
 	return qs422016
-//line index.html:40
+//line index.html:73
 }
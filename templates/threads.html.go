@@ -0,0 +1,973 @@
+// Code generated by qtc from "threads.html". DO NOT EDIT.
+// See https://github.com/valyala/quicktemplate for details.
+
+//line threads.html:1
+package templates
+
+//line threads.html:1
+import "github.com/bakape/meguca/common"
+
+//line threads.html:2
+import "github.com/bakape/meguca/config"
+
+// Thread renders a thread page
+
+//line threads.html:5
+import (
+	qtio422016 "io"
+
+	qt422016 "github.com/valyala/quicktemplate"
+)
+
+//line threads.html:5
+var (
+	_ = qtio422016.Copy
+	_ = qt422016.AcquireByteBuffer
+)
+
+//line threads.html:5
+func StreamThread(qw422016 *qt422016.Writer, c config.Configs, t common.Thread) {
+//line threads.html:5
+	streampage(qw422016, c, threadPage{c, t})
+//line threads.html:5
+}
+
+//line threads.html:5
+func WriteThread(qq422016 qtio422016.Writer, c config.Configs, t common.Thread) {
+//line threads.html:5
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:5
+	StreamThread(qw422016, c, t)
+//line threads.html:5
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:5
+}
+
+//line threads.html:5
+func Thread(c config.Configs, t common.Thread) string {
+//line threads.html:5
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:5
+	WriteThread(qb422016, c, t)
+//line threads.html:5
+	qs422016 := string(qb422016.B)
+//line threads.html:5
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:5
+	return qs422016
+//line threads.html:5
+}
+
+//line threads.html:8
+type threadPage struct {
+	c config.Configs
+	t common.Thread
+}
+
+//line threads.html:14
+func (p threadPage) StreamTitle(qw422016 *qt422016.Writer) {
+//line threads.html:14
+	qw422016.E().S(p.t.Subject)
+//line threads.html:14
+}
+
+//line threads.html:14
+func (p threadPage) WriteTitle(qq422016 qtio422016.Writer) {
+//line threads.html:14
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:14
+	p.StreamTitle(qw422016)
+//line threads.html:14
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:14
+}
+
+//line threads.html:14
+func (p threadPage) Title() string {
+//line threads.html:14
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:14
+	p.WriteTitle(qb422016)
+//line threads.html:14
+	qs422016 := string(qb422016.B)
+//line threads.html:14
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:14
+	return qs422016
+//line threads.html:14
+}
+
+//line threads.html:16
+func (p threadPage) StreamMeta(qw422016 *qt422016.Writer) {
+//line threads.html:18
+	url := threadURL(p.c, p.t.ID, p.t.Page)
+	var (
+		desc string
+		img  *common.Image
+	)
+	if len(p.t.Posts) != 0 {
+		desc = description(p.t.Posts[0].Body)
+		img = p.t.Posts[0].Image
+	}
+
+//line threads.html:27
+	qw422016.N().S(`<meta property="og:type" content="article"><meta property="og:site_name" content="meguca"><meta property="og:title" content="`)
+//line threads.html:30
+	qw422016.E().S(p.t.Subject)
+//line threads.html:30
+	qw422016.N().S(`"><meta property="og:url" content="`)
+//line threads.html:31
+	qw422016.E().S(url)
+//line threads.html:31
+	qw422016.N().S(`"><meta property="og:description" content="`)
+//line threads.html:32
+	qw422016.E().S(desc)
+//line threads.html:32
+	qw422016.N().S(`"><meta name="description" content="`)
+//line threads.html:33
+	qw422016.E().S(desc)
+//line threads.html:33
+	qw422016.N().S(`"><meta name="twitter:title" content="`)
+//line threads.html:34
+	qw422016.E().S(p.t.Subject)
+//line threads.html:34
+	qw422016.N().S(`"><meta name="twitter:description" content="`)
+//line threads.html:35
+	qw422016.E().S(desc)
+//line threads.html:35
+	qw422016.N().S(`">`)
+//line threads.html:36
+	if img != nil {
+//line threads.html:37
+		w, h, src := thumbnailAttrs(p.c, img)
+
+//line threads.html:37
+		qw422016.N().S(`<meta property="og:image" content="`)
+//line threads.html:38
+		qw422016.E().S(AbsoluteURL(p.c, src))
+//line threads.html:38
+		qw422016.N().S(`"><meta property="og:image:width" content="`)
+//line threads.html:39
+		qw422016.N().D(int(w))
+//line threads.html:39
+		qw422016.N().S(`"><meta property="og:image:height" content="`)
+//line threads.html:40
+		qw422016.N().D(int(h))
+//line threads.html:40
+		qw422016.N().S(`"><meta name="twitter:card" content="summary"><meta name="twitter:image" content="`)
+//line threads.html:42
+		qw422016.E().S(AbsoluteURL(p.c, src))
+//line threads.html:42
+		qw422016.N().S(`">`)
+//line threads.html:43
+	} else {
+//line threads.html:43
+		qw422016.N().S(`<meta name="twitter:card" content="summary">`)
+//line threads.html:45
+	}
+//line threads.html:45
+	qw422016.N().S(`<link rel="alternate" type="application/json+oembed" href="`)
+//line threads.html:46
+	qw422016.E().S(oEmbedURL(p.c, url))
+//line threads.html:46
+	qw422016.N().S(`" title="`)
+//line threads.html:46
+	qw422016.E().S(p.t.Subject)
+//line threads.html:46
+	qw422016.N().S(`">`)
+//line threads.html:47
+}
+
+//line threads.html:47
+func (p threadPage) WriteMeta(qq422016 qtio422016.Writer) {
+//line threads.html:47
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:47
+	p.StreamMeta(qw422016)
+//line threads.html:47
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:47
+}
+
+//line threads.html:47
+func (p threadPage) Meta() string {
+//line threads.html:47
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:47
+	p.WriteMeta(qb422016)
+//line threads.html:47
+	qs422016 := string(qb422016.B)
+//line threads.html:47
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:47
+	return qs422016
+//line threads.html:47
+}
+
+//line threads.html:49
+func (p threadPage) StreamContent(qw422016 *qt422016.Writer) {
+//line threads.html:49
+	qw422016.N().S(`<section class="thread">`)
+//line threads.html:51
+	for i, post := range p.t.Posts {
+//line threads.html:52
+		if i == 0 {
+//line threads.html:53
+			streamthreadHeader(qw422016, serverLinks, p.t)
+//line threads.html:54
+		}
+//line threads.html:55
+		streamrenderPost(qw422016, p.c, serverLinks, post)
+//line threads.html:56
+	}
+//line threads.html:56
+	qw422016.N().S(`</section>`)
+//line threads.html:58
+	streamthreadPagination(qw422016, p.t)
+//line threads.html:59
+}
+
+//line threads.html:59
+func (p threadPage) WriteContent(qq422016 qtio422016.Writer) {
+//line threads.html:59
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:59
+	p.StreamContent(qw422016)
+//line threads.html:59
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:59
+}
+
+//line threads.html:59
+func (p threadPage) Content() string {
+//line threads.html:59
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:59
+	p.WriteContent(qb422016)
+//line threads.html:59
+	qs422016 := string(qb422016.B)
+//line threads.html:59
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:59
+	return qs422016
+//line threads.html:59
+}
+
+// Index renders a page of the thread index
+
+//line threads.html:62
+func StreamIndex(qw422016 *qt422016.Writer, c config.Configs, threads []common.Thread, page uint, lastPage bool) {
+//line threads.html:62
+	streampage(qw422016, c, indexPage{c, threads, page, lastPage})
+//line threads.html:62
+}
+
+//line threads.html:62
+func WriteIndex(qq422016 qtio422016.Writer, c config.Configs, threads []common.Thread, page uint, lastPage bool) {
+//line threads.html:62
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:62
+	StreamIndex(qw422016, c, threads, page, lastPage)
+//line threads.html:62
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:62
+}
+
+//line threads.html:62
+func Index(c config.Configs, threads []common.Thread, page uint, lastPage bool) string {
+//line threads.html:62
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:62
+	WriteIndex(qb422016, c, threads, page, lastPage)
+//line threads.html:62
+	qs422016 := string(qb422016.B)
+//line threads.html:62
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:62
+	return qs422016
+//line threads.html:62
+}
+
+//line threads.html:65
+type indexPage struct {
+	c        config.Configs
+	threads  []common.Thread
+	page     uint
+	lastPage bool
+}
+
+//line threads.html:73
+func (p indexPage) StreamTitle(qw422016 *qt422016.Writer) {
+//line threads.html:73
+	qw422016.N().S(`meguca`)
+//line threads.html:73
+}
+
+//line threads.html:73
+func (p indexPage) WriteTitle(qq422016 qtio422016.Writer) {
+//line threads.html:73
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:73
+	p.StreamTitle(qw422016)
+//line threads.html:73
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:73
+}
+
+//line threads.html:73
+func (p indexPage) Title() string {
+//line threads.html:73
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:73
+	p.WriteTitle(qb422016)
+//line threads.html:73
+	qs422016 := string(qb422016.B)
+//line threads.html:73
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:73
+	return qs422016
+//line threads.html:73
+}
+
+//line threads.html:75
+func (p indexPage) StreamMeta(qw422016 *qt422016.Writer) {
+//line threads.html:75
+}
+
+//line threads.html:75
+func (p indexPage) WriteMeta(qq422016 qtio422016.Writer) {
+//line threads.html:75
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:75
+	p.StreamMeta(qw422016)
+//line threads.html:75
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:75
+}
+
+//line threads.html:75
+func (p indexPage) Meta() string {
+//line threads.html:75
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:75
+	p.WriteMeta(qb422016)
+//line threads.html:75
+	qs422016 := string(qb422016.B)
+//line threads.html:75
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:75
+	return qs422016
+//line threads.html:75
+}
+
+//line threads.html:77
+func (p indexPage) StreamContent(qw422016 *qt422016.Writer) {
+//line threads.html:77
+	qw422016.N().S(`<nav class="spaced"><a href="/catalog">catalog</a></nav>`)
+//line threads.html:81
+	for _, t := range p.threads {
+//line threads.html:81
+		qw422016.N().S(`<section class="thread">`)
+//line threads.html:83
+		for i, post := range t.Posts {
+//line threads.html:84
+			if i == 0 {
+//line threads.html:85
+				streamthreadHeader(qw422016, serverLinks, t)
+//line threads.html:86
+			}
+//line threads.html:87
+			streamrenderPost(qw422016, p.c, serverLinks, post)
+//line threads.html:88
+		}
+//line threads.html:88
+		qw422016.N().S(`<nav class="spaced"><a href="/threads/`)
+//line threads.html:90
+		qw422016.E().V(t.ID)
+//line threads.html:90
+		qw422016.N().S(`/0">`)
+//line threads.html:90
+		qw422016.E().V(t.PostCount)
+//line threads.html:90
+		qw422016.N().S(`posts</a></nav></section><hr>`)
+//line threads.html:94
+	}
+//line threads.html:94
+	qw422016.N().S(`<nav class="spaced">`)
+//line threads.html:96
+	if p.page != 0 {
+//line threads.html:96
+		qw422016.N().S(`<a href="/?page=`)
+//line threads.html:97
+		qw422016.N().D(int(p.page) - 1)
+//line threads.html:97
+		qw422016.N().S(`">previous</a>`)
+//line threads.html:98
+	}
+//line threads.html:99
+	if !p.lastPage {
+//line threads.html:99
+		qw422016.N().S(`<a href="/?page=`)
+//line threads.html:100
+		qw422016.N().D(int(p.page) + 1)
+//line threads.html:100
+		qw422016.N().S(`">next</a>`)
+//line threads.html:101
+	}
+//line threads.html:101
+	qw422016.N().S(`</nav>`)
+//line threads.html:103
+}
+
+//line threads.html:103
+func (p indexPage) WriteContent(qq422016 qtio422016.Writer) {
+//line threads.html:103
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:103
+	p.StreamContent(qw422016)
+//line threads.html:103
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:103
+}
+
+//line threads.html:103
+func (p indexPage) Content() string {
+//line threads.html:103
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:103
+	p.WriteContent(qb422016)
+//line threads.html:103
+	qs422016 := string(qb422016.B)
+//line threads.html:103
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:103
+	return qs422016
+//line threads.html:103
+}
+
+// Catalog renders the thread catalog
+
+//line threads.html:106
+func StreamCatalog(qw422016 *qt422016.Writer, c config.Configs, threads []common.Thread) {
+//line threads.html:106
+	streampage(qw422016, c, catalogPage{c, threads})
+//line threads.html:106
+}
+
+//line threads.html:106
+func WriteCatalog(qq422016 qtio422016.Writer, c config.Configs, threads []common.Thread) {
+//line threads.html:106
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:106
+	StreamCatalog(qw422016, c, threads)
+//line threads.html:106
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:106
+}
+
+//line threads.html:106
+func Catalog(c config.Configs, threads []common.Thread) string {
+//line threads.html:106
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:106
+	WriteCatalog(qb422016, c, threads)
+//line threads.html:106
+	qs422016 := string(qb422016.B)
+//line threads.html:106
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:106
+	return qs422016
+//line threads.html:106
+}
+
+//line threads.html:109
+type catalogPage struct {
+	c       config.Configs
+	threads []common.Thread
+}
+
+//line threads.html:115
+func (p catalogPage) StreamTitle(qw422016 *qt422016.Writer) {
+//line threads.html:115
+	qw422016.N().S(`catalog`)
+//line threads.html:115
+}
+
+//line threads.html:115
+func (p catalogPage) WriteTitle(qq422016 qtio422016.Writer) {
+//line threads.html:115
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:115
+	p.StreamTitle(qw422016)
+//line threads.html:115
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:115
+}
+
+//line threads.html:115
+func (p catalogPage) Title() string {
+//line threads.html:115
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:115
+	p.WriteTitle(qb422016)
+//line threads.html:115
+	qs422016 := string(qb422016.B)
+//line threads.html:115
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:115
+	return qs422016
+//line threads.html:115
+}
+
+//line threads.html:117
+func (p catalogPage) StreamMeta(qw422016 *qt422016.Writer) {
+//line threads.html:117
+}
+
+//line threads.html:117
+func (p catalogPage) WriteMeta(qq422016 qtio422016.Writer) {
+//line threads.html:117
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:117
+	p.StreamMeta(qw422016)
+//line threads.html:117
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:117
+}
+
+//line threads.html:117
+func (p catalogPage) Meta() string {
+//line threads.html:117
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:117
+	p.WriteMeta(qb422016)
+//line threads.html:117
+	qs422016 := string(qb422016.B)
+//line threads.html:117
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:117
+	return qs422016
+//line threads.html:117
+}
+
+//line threads.html:119
+func (p catalogPage) StreamContent(qw422016 *qt422016.Writer) {
+//line threads.html:119
+	qw422016.N().S(`<nav class="spaced"><a href="/">index</a></nav><div class="catalog">`)
+//line threads.html:124
+	for _, t := range p.threads {
+//line threads.html:125
+		if len(t.Posts) == 0 {
+//line threads.html:125
+			continue
+//line threads.html:125
+		}
+//line threads.html:126
+		op := t.Posts[0]
+
+//line threads.html:126
+		qw422016.N().S(`<article class="glass catalog-thread">`)
+//line threads.html:128
+		if op.Image != nil {
+//line threads.html:128
+			qw422016.N().S(`<a href="/threads/`)
+//line threads.html:129
+			qw422016.E().V(t.ID)
+//line threads.html:129
+			qw422016.N().S(`/0">`)
+//line threads.html:130
+			streamthumbnail(qw422016, p.c, op.Image)
+//line threads.html:130
+			qw422016.N().S(`</a>`)
+//line threads.html:132
+		}
+//line threads.html:132
+		qw422016.N().S(`<span class="spaced">`)
+//line threads.html:134
+		qw422016.E().V(t.PostCount)
+//line threads.html:134
+		qw422016.N().S(`/`)
+//line threads.html:134
+		qw422016.E().V(t.ImageCount)
+//line threads.html:134
+		qw422016.N().S(`</span><br>`)
+//line threads.html:137
+		streamtags(qw422016, t.Tags)
+//line threads.html:137
+		qw422016.N().S(`<h3><a href="/threads/`)
+//line threads.html:139
+		qw422016.E().V(t.ID)
+//line threads.html:139
+		qw422016.N().S(`/0">「`)
+//line threads.html:139
+		qw422016.E().S(t.Subject)
+//line threads.html:139
+		qw422016.N().S(`」</a></h3><blockquote>`)
+//line threads.html:141
+		streambody(qw422016, serverLinks, op.Body)
+//line threads.html:141
+		qw422016.N().S(`</blockquote></article>`)
+//line threads.html:143
+	}
+//line threads.html:143
+	qw422016.N().S(`</div>`)
+//line threads.html:145
+}
+
+//line threads.html:145
+func (p catalogPage) WriteContent(qq422016 qtio422016.Writer) {
+//line threads.html:145
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:145
+	p.StreamContent(qw422016)
+//line threads.html:145
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:145
+}
+
+//line threads.html:145
+func (p catalogPage) Content() string {
+//line threads.html:145
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:145
+	p.WriteContent(qb422016)
+//line threads.html:145
+	qs422016 := string(qb422016.B)
+//line threads.html:145
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:145
+	return qs422016
+//line threads.html:145
+}
+
+//line threads.html:147
+func streamthreadHeader(qw422016 *qt422016.Writer, l pageLinker, t common.Thread) {
+//line threads.html:147
+	qw422016.N().S(`<header class="spaced">`)
+//line threads.html:149
+	streamtags(qw422016, t.Tags)
+//line threads.html:149
+	qw422016.N().S(`<h3><a href="`)
+//line threads.html:151
+	qw422016.E().S(l.page(t.ID, 0))
+//line threads.html:151
+	qw422016.N().S(`">「`)
+//line threads.html:151
+	qw422016.E().S(t.Subject)
+//line threads.html:151
+	qw422016.N().S(`」</a></h3></header>`)
+//line threads.html:154
+}
+
+//line threads.html:154
+func writethreadHeader(qq422016 qtio422016.Writer, l pageLinker, t common.Thread) {
+//line threads.html:154
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:154
+	streamthreadHeader(qw422016, l, t)
+//line threads.html:154
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:154
+}
+
+//line threads.html:154
+func threadHeader(l pageLinker, t common.Thread) string {
+//line threads.html:154
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:154
+	writethreadHeader(qb422016, l, t)
+//line threads.html:154
+	qs422016 := string(qb422016.B)
+//line threads.html:154
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:154
+	return qs422016
+//line threads.html:154
+}
+
+//line threads.html:156
+func streamtags(qw422016 *qt422016.Writer, tags []string) {
+//line threads.html:157
+	for _, tag := range tags {
+//line threads.html:157
+		qw422016.N().S(`<b>/`)
+//line threads.html:158
+		qw422016.E().S(tag)
+//line threads.html:158
+		qw422016.N().S(`/</b>`)
+//line threads.html:159
+	}
+//line threads.html:160
+}
+
+//line threads.html:160
+func writetags(qq422016 qtio422016.Writer, tags []string) {
+//line threads.html:160
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:160
+	streamtags(qw422016, tags)
+//line threads.html:160
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:160
+}
+
+//line threads.html:160
+func tags(tags []string) string {
+//line threads.html:160
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:160
+	writetags(qb422016, tags)
+//line threads.html:160
+	qs422016 := string(qb422016.B)
+//line threads.html:160
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:160
+	return qs422016
+//line threads.html:160
+}
+
+//line threads.html:162
+func streamthreadPagination(qw422016 *qt422016.Writer, t common.Thread) {
+//line threads.html:163
+	if t.LastPage != 0 {
+//line threads.html:163
+		qw422016.N().S(`<nav class="spaced">`)
+//line threads.html:165
+		for i := uint32(0); i <= t.LastPage; i++ {
+//line threads.html:166
+			if i == t.Page {
+//line threads.html:166
+				qw422016.N().S(`<b>`)
+//line threads.html:167
+				qw422016.E().V(i)
+//line threads.html:167
+				qw422016.N().S(`</b>`)
+//line threads.html:168
+			} else {
+//line threads.html:168
+				qw422016.N().S(`<a href="/threads/`)
+//line threads.html:169
+				qw422016.E().V(t.ID)
+//line threads.html:169
+				qw422016.N().S(`/`)
+//line threads.html:169
+				qw422016.E().V(i)
+//line threads.html:169
+				qw422016.N().S(`">`)
+//line threads.html:169
+				qw422016.E().V(i)
+//line threads.html:169
+				qw422016.N().S(`</a>`)
+//line threads.html:170
+			}
+//line threads.html:171
+		}
+//line threads.html:171
+		qw422016.N().S(`</nav>`)
+//line threads.html:173
+	}
+//line threads.html:174
+}
+
+//line threads.html:174
+func writethreadPagination(qq422016 qtio422016.Writer, t common.Thread) {
+//line threads.html:174
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:174
+	streamthreadPagination(qw422016, t)
+//line threads.html:174
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:174
+}
+
+//line threads.html:174
+func threadPagination(t common.Thread) string {
+//line threads.html:174
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:174
+	writethreadPagination(qb422016, t)
+//line threads.html:174
+	qs422016 := string(qb422016.B)
+//line threads.html:174
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:174
+	return qs422016
+//line threads.html:174
+}
+
+//line threads.html:176
+func streamrenderPost(qw422016 *qt422016.Writer, c config.Configs, l pageLinker, p common.Post) {
+//line threads.html:176
+	qw422016.N().S(`<article id="p`)
+//line threads.html:177
+	qw422016.E().V(p.ID)
+//line threads.html:177
+	qw422016.N().S(`" class="glass`)
+//line threads.html:177
+	if p.ID == p.Thread {
+//line threads.html:177
+		qw422016.N().S(` `)
+//line threads.html:177
+		qw422016.N().S(`op`)
+//line threads.html:177
+	}
+//line threads.html:177
+	qw422016.N().S(`"><header class="spaced"><b class="name">`)
+//line threads.html:180
+	if p.Name != nil && *p.Name != "" {
+//line threads.html:181
+		qw422016.E().S(*p.Name)
+//line threads.html:182
+	} else {
+//line threads.html:182
+		qw422016.N().S(`Anonymous`)
+//line threads.html:184
+	}
+//line threads.html:185
+	if p.Trip != nil && *p.Trip != "" {
+//line threads.html:185
+		qw422016.N().S(`<code>!`)
+//line threads.html:186
+		qw422016.E().S(*p.Trip)
+//line threads.html:186
+		qw422016.N().S(`</code>`)
+//line threads.html:187
+	}
+//line threads.html:187
+	qw422016.N().S(`</b><time datetime="`)
+//line threads.html:189
+	qw422016.E().S(formatTime(p.CreatedOn))
+//line threads.html:189
+	qw422016.N().S(`">`)
+//line threads.html:190
+	qw422016.E().S(formatTime(p.CreatedOn))
+//line threads.html:190
+	qw422016.N().S(`</time><nav class="spaced"><a href="`)
+//line threads.html:193
+	qw422016.E().S(l.page(p.Thread, p.Page))
+//line threads.html:193
+	qw422016.N().S(`#p`)
+//line threads.html:193
+	qw422016.E().V(p.ID)
+//line threads.html:193
+	qw422016.N().S(`">#</a><a>`)
+//line threads.html:194
+	qw422016.E().V(p.ID)
+//line threads.html:194
+	qw422016.N().S(`</a></nav></header>`)
+//line threads.html:197
+	if p.Image != nil {
+//line threads.html:197
+		qw422016.N().S(`<figcaption class="spaced"><a href="`)
+//line threads.html:199
+		qw422016.E().S(sourcePath(c, p.Image))
+//line threads.html:199
+		qw422016.N().S(`" download="`)
+//line threads.html:199
+		qw422016.E().S(p.Image.Name)
+//line threads.html:199
+		qw422016.N().S(`">`)
+//line threads.html:200
+		qw422016.E().S(p.Image.Name)
+//line threads.html:200
+		qw422016.N().S(`</a></figcaption>`)
+//line threads.html:203
+	}
+//line threads.html:203
+	qw422016.N().S(`<div class="post-container">`)
+//line threads.html:205
+	if p.Image != nil {
+//line threads.html:205
+		qw422016.N().S(`<figure><a href="`)
+//line threads.html:207
+		qw422016.E().S(sourcePath(c, p.Image))
+//line threads.html:207
+		qw422016.N().S(`" target="_blank">`)
+//line threads.html:208
+		streamthumbnail(qw422016, c, p.Image)
+//line threads.html:208
+		qw422016.N().S(`</a></figure>`)
+//line threads.html:211
+	}
+//line threads.html:211
+	qw422016.N().S(`<blockquote>`)
+//line threads.html:212
+	streambody(qw422016, l, p.Body)
+//line threads.html:212
+	qw422016.N().S(`</blockquote></div></article>`)
+//line threads.html:215
+}
+
+//line threads.html:215
+func writerenderPost(qq422016 qtio422016.Writer, c config.Configs, l pageLinker, p common.Post) {
+//line threads.html:215
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:215
+	streamrenderPost(qw422016, c, l, p)
+//line threads.html:215
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:215
+}
+
+//line threads.html:215
+func renderPost(c config.Configs, l pageLinker, p common.Post) string {
+//line threads.html:215
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:215
+	writerenderPost(qb422016, c, l, p)
+//line threads.html:215
+	qs422016 := string(qb422016.B)
+//line threads.html:215
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:215
+	return qs422016
+//line threads.html:215
+}
+
+//line threads.html:217
+func streamthumbnail(qw422016 *qt422016.Writer, c config.Configs, img *common.Image) {
+//line threads.html:218
+	w, h, src := thumbnailAttrs(c, img)
+
+//line threads.html:218
+	qw422016.N().S(`<img src="`)
+//line threads.html:219
+	qw422016.E().S(src)
+//line threads.html:219
+	qw422016.N().S(`" width="`)
+//line threads.html:219
+	qw422016.N().D(int(w))
+//line threads.html:219
+	qw422016.N().S(`" height="`)
+//line threads.html:219
+	qw422016.N().D(int(h))
+//line threads.html:219
+	qw422016.N().S(`" loading="lazy">`)
+//line threads.html:220
+}
+
+//line threads.html:220
+func writethumbnail(qq422016 qtio422016.Writer, c config.Configs, img *common.Image) {
+//line threads.html:220
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line threads.html:220
+	streamthumbnail(qw422016, c, img)
+//line threads.html:220
+	qt422016.ReleaseWriter(qw422016)
+//line threads.html:220
+}
+
+//line threads.html:220
+func thumbnail(c config.Configs, img *common.Image) string {
+//line threads.html:220
+	qb422016 := qt422016.AcquireByteBuffer()
+//line threads.html:220
+	writethumbnail(qb422016, c, img)
+//line threads.html:220
+	qs422016 := string(qb422016.B)
+//line threads.html:220
+	qt422016.ReleaseByteBuffer(qb422016)
+//line threads.html:220
+	return qs422016
+//line threads.html:220
+}
@@ -0,0 +1,291 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/valyala/quicktemplate"
+)
+
+func formatTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+// Maximum length of page descriptions derived from post text
+const maxLenDescription = 200
+
+// Derive a page description from a post body
+func description(body []byte) string {
+	s := strings.Join(strings.Fields(common.BodyText(body)), " ")
+	if utf8.RuneCountInString(s) > maxLenDescription {
+		s = string([]rune(s)[:maxLenDescription-1]) + "…"
+	}
+	return s
+}
+
+// Returns absolute URL of a thread page
+func threadURL(c config.Configs, thread uint64, page uint32) string {
+	return fmt.Sprintf("%s/threads/%d/%d", c.RootURL, thread, page)
+}
+
+// AbsoluteURL converts a root-relative URL to an absolute one
+func AbsoluteURL(c config.Configs, u string) string {
+	if strings.HasPrefix(u, "/") && !strings.HasPrefix(u, "//") {
+		return c.RootURL + u
+	}
+	return u
+}
+
+// Returns the oEmbed discovery URL for a page URL
+func oEmbedURL(c config.Configs, u string) string {
+	return c.RootURL + "/api/oembed?format=json&url=" + url.QueryEscape(u)
+}
+
+// ArchivePageName returns the file name of a thread page in a thread archive
+func ArchivePageName(page uint32) string {
+	return fmt.Sprintf("page_%d.html", page)
+}
+
+// Generates URLs of thread pages
+type pageLinker struct {
+	// Thread rendered into an archive, if any. Pages of this thread are linked
+	// to by their file names in the archive.
+	archived uint64
+
+	// Root URL of the server for links leaving the archive
+	root string
+}
+
+// Links to thread pages served by the server
+var serverLinks pageLinker
+
+// Returns links for the archive of a thread
+func archiveLinks(c config.Configs, thread uint64) pageLinker {
+	return pageLinker{
+		archived: thread,
+		root:     c.RootURL,
+	}
+}
+
+// Returns the URL of a thread page
+func (l pageLinker) page(thread uint64, page uint32) string {
+	switch {
+	case l.archived == 0:
+		return fmt.Sprintf("/threads/%d/%d", thread, page)
+	case thread == l.archived:
+		return ArchivePageName(page)
+	default:
+		return fmt.Sprintf("%s/threads/%d/%d", l.root, thread, page)
+	}
+}
+
+// Returns root URL for serving images
+func imageRoot(c config.Configs) string {
+	if c.ImageRootOverride != "" {
+		return c.ImageRootOverride
+	}
+	return "/assets/images"
+}
+
+// ImagePaths returns URLs of an image's source file and thumbnail
+func ImagePaths(c config.Configs, img *common.Image) (paths [2]string) {
+	paths = assets.GetFilePaths(img.SHA1, img.FileType, img.ThumbType)
+	for i, p := range paths {
+		paths[i] = imageRoot(c) + "/" +
+			strings.TrimPrefix(filepath.ToSlash(p), "images/")
+	}
+	return
+}
+
+func sourcePath(c config.Configs, img *common.Image) string {
+	return ImagePaths(c, img)[0]
+}
+
+// Returns dimensions and URL of an image thumbnail
+func thumbnailAttrs(c config.Configs, img *common.Image) (
+	width, height uint16,
+	url string,
+) {
+	switch {
+	case img.ThumbType == common.NoFile:
+		url = "/assets/file.png"
+		switch img.FileType {
+		case common.WEBM, common.MP4, common.MP3, common.OGG, common.FLAC:
+			url = "/assets/audio.png"
+		}
+		return 150, 150, url
+	case img.Spoilered:
+		return 150, 150, "/assets/spoil/default.jpg"
+	default:
+		return img.ThumbWidth, img.ThumbHeight, ImagePaths(c, img)[1]
+	}
+}
+
+// Render post body JSON AST as HTML
+func streambody(
+	qw *quicktemplate.Writer,
+	l pageLinker,
+	body json.RawMessage,
+) {
+	var n interface{}
+	if json.Unmarshal(body, &n) != nil {
+		return
+	}
+	streamnode(qw, l, n)
+}
+
+func streamnode(qw *quicktemplate.Writer, l pageLinker, node interface{}) {
+	switch n := node.(type) {
+	case string:
+		if n == "NewLine" {
+			qw.N().S("<br>")
+		}
+	case []interface{}:
+		for _, ch := range n {
+			streamnode(qw, l, ch)
+		}
+	case map[string]interface{}:
+		for k, v := range n {
+			streamNodeVariant(qw, l, k, v)
+		}
+	}
+}
+
+// Render a Node enum variant with associated data
+func streamNodeVariant(
+	qw *quicktemplate.Writer,
+	l pageLinker,
+	k string,
+	v interface{},
+) {
+	wrap := func(tag string) {
+		qw.N().S("<" + tag + ">")
+		streamnode(qw, l, v)
+		qw.N().S("</" + tag + ">")
+	}
+	link := func(href, text string) {
+		qw.N().S(`<a href="`)
+		qw.E().S(href)
+		qw.N().S(`" target="_blank" rel="noopener">`)
+		qw.E().S(text)
+		qw.N().S("</a>")
+	}
+
+	switch k {
+	case "Text":
+		s, _ := v.(string)
+		qw.E().S(s)
+	case "Siblings":
+		streamnode(qw, l, v)
+	case "PostLink":
+		m, _ := v.(map[string]interface{})
+		id, _ := m["id"].(float64)
+		thread, _ := m["thread"].(float64)
+		page, _ := m["page"].(float64)
+		qw.N().S(`<a href="`)
+		if thread != 0 {
+			qw.E().S(l.page(uint64(thread), uint32(page)))
+		}
+		fmt.Fprintf(qw.N(), `#p%d">&gt;&gt;%d</a>`, uint64(id), uint64(id))
+	case "Command":
+		if s := commandText(v); s != "" {
+			qw.N().S("<strong>")
+			qw.E().S(s)
+			qw.N().S("</strong>")
+		}
+	case "URL":
+		s, _ := v.(string)
+		link(s, s)
+	case "Reference":
+		m, _ := v.(map[string]interface{})
+		label, _ := m["label"].(string)
+		url, _ := m["url"].(string)
+		link(url, ">>>/"+label+"/")
+	case "Code":
+		// Code is highlighted into HTML by the server
+		s, _ := v.(string)
+		qw.N().S("<code>")
+		qw.N().S(s)
+		qw.N().S("</code>")
+	case "Spoiler":
+		wrap("del")
+	case "Quoted":
+		wrap("em")
+	case "Bold":
+		wrap("b")
+	case "Italic":
+		wrap("i")
+	}
+}
+
+// Format hash command result as text
+func commandText(v interface{}) string {
+	m, _ := v.(map[string]interface{})
+	for k, v := range m {
+		switch k {
+		case "Flip":
+			if b, _ := v.(bool); b {
+				return "#flip flap"
+			}
+			return "#flip flop"
+		case "EightBall":
+			s, _ := v.(string)
+			return "#8ball " + s
+		case "Autobahn", "Pyu", "PCount":
+			n, _ := v.(float64)
+			return fmt.Sprintf("#%s %d", strings.ToLower(k), uint64(n))
+		case "Dice":
+			return diceText(v)
+		}
+	}
+	return ""
+}
+
+func diceText(v interface{}) string {
+	var (
+		w       strings.Builder
+		m, _    = v.(map[string]interface{})
+		fOff, _ = m["offset"].(float64)
+		faces   = m["faces"]
+		res, _  = m["results"].([]interface{})
+		offset  = int64(fOff)
+		sign    = '+'
+		sum     int64
+	)
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	w.WriteByte('#')
+	if len(res) > 1 {
+		w.WriteString(strconv.Itoa(len(res)))
+	}
+	fmt.Fprintf(&w, "d%v", faces)
+	if offset != 0 {
+		fmt.Fprintf(&w, "%c%d", sign, offset)
+	}
+	for i, r := range res {
+		if i != 0 {
+			w.WriteString(" + ")
+		}
+		n, _ := r.(float64)
+		sum += int64(n)
+		fmt.Fprintf(&w, "%d", int64(n))
+	}
+	if offset != 0 {
+		sum += int64(fOff)
+		fmt.Fprintf(&w, " %c %d", sign, offset)
+	}
+	fmt.Fprintf(&w, " = %d", sum)
+	return w.String()
+}
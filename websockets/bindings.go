@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"unsafe"
 
 	"github.com/bakape/meguca/cache"
@@ -46,7 +47,13 @@ func Init() (err error) {
 	if err != nil {
 		return
 	}
-	return fromCError(C.ws_init(toWSBuffer(buf)))
+	err = fromCError(C.ws_init(toWSBuffer(buf)))
+	if err != nil {
+		return
+	}
+	return db.ListenThreadDeletion(func(id uint64) error {
+		return fromCError(C.ws_remove_thread(C.uint64_t(id)))
+	})
 }
 
 //export ws_thread_exists
@@ -105,14 +112,37 @@ func ws_close_client(clientID C.uint64_t, err C.WSBuffer) {
 	}
 }
 
+// Return common.ErrBanned, if the IP of the client or the public key is
+// banned
+func assertNotBanned(clientID, publicKey C.uint64_t) (err error) {
+	clientsMu.RLock()
+	c, ok := clients[uint64(clientID)]
+	clientsMu.RUnlock()
+
+	var ip net.IP
+	if ok {
+		ip = c.ip
+	}
+	if db.IsBanned(ip, uint64(publicKey)) {
+		err = common.ErrBanned
+	}
+	return
+}
+
 //export ws_insert_thread
 func ws_insert_thread(
+	client_id C.uint64_t,
 	subject C.WSBuffer,
 	tags *C.WSBuffer, tags_size C.size_t,
 	public_key C.uint64_t,
 	name, trip, body C.WSBuffer,
 	id *C.uint64_t,
 ) *C.char {
+	err := assertNotBanned(client_id, public_key)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
 	tags_ := make([]string, int(tags_size))
 	size := unsafe.Sizeof(C.WSBuffer{})
 	for i := range tags_ {
@@ -147,17 +177,23 @@ func ws_insert_thread(
 
 //export ws_insert_post
 func ws_insert_post(
+	client_id C.uint64_t,
 	sage C.bool,
 	thread, public_key C.uint64_t,
 	name, trip, body C.WSBuffer,
 	id *C.uint64_t,
 	page *C.uint32_t,
 ) *C.char {
+	err := assertNotBanned(client_id, public_key)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
 	var (
 		id_   uint64
 		page_ uint32
 	)
-	err := db.InTransaction(context.Background(), func(tx pgx.Tx) (err error) {
+	err = db.InTransaction(context.Background(), func(tx pgx.Tx) (err error) {
 		id_, page_, err = db.InsertPost(tx, db.ReplyInsertParams{
 			Sage:   bool(sage),
 			Thread: uint64(thread),
@@ -181,6 +217,69 @@ func ws_insert_post(
 	return nil
 }
 
+//export ws_filter_body
+func ws_filter_body(
+	thread, post, public_key C.uint64_t,
+	prev, body C.WSBuffer,
+	filtered *C.WSBuffer, // Only set, if the body was modified by filters
+) *C.char {
+	ctx := context.Background()
+	body_ := toString(body)
+	res, err := db.ApplyFilters(ctx, uint64(thread), toString(prev), body_)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
+	if res.SpamScore != 0 {
+		db.IncrementSpamScore(uint64(public_key), res.SpamScore)
+	}
+	for _, reason := range res.Reports {
+		err = db.InsertFilterReport(ctx, uint64(post), reason)
+		if err != nil {
+			return C.CString(err.Error())
+		}
+	}
+	if res.Reject {
+		return C.CString(common.ErrBodyFiltered.Error())
+	}
+
+	if res.Body != body_ {
+		filtered.data = (*C.uint8_t)(C.CBytes([]byte(res.Body)))
+		filtered.size = C.size_t(len(res.Body))
+	}
+	return nil
+}
+
+//export ws_insert_report
+func ws_insert_report(
+	client_id, public_key, post C.uint64_t,
+	reason C.WSBuffer,
+	id *C.uint64_t,
+) *C.char {
+	err := assertNotBanned(client_id, public_key)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
+	id_, err := db.InsertReport(
+		context.Background(),
+		uint64(public_key),
+		uint64(post),
+		toString(reason),
+	)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+	*id = C.uint64_t(id_)
+
+	db.IncrementSpamScore(
+		uint64(public_key),
+		config.Get().SpamScores.Report,
+	)
+
+	return nil
+}
+
 //export ws_register_public_key
 func ws_register_public_key(
 	pub_key C.WSBuffer,
@@ -266,6 +365,25 @@ func ws_need_captcha(pub_key C.uint64_t, need *C.bool) *C.char {
 	return nil
 }
 
+//export ws_authenticate_client
+func ws_authenticate_client(client_id, pub_key C.uint64_t) *C.char {
+	clientsMu.RLock()
+	c, ok := clients[uint64(client_id)]
+	clientsMu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	err := db.RecordPublicKeyIP(context.Background(), uint64(pub_key), c.ip)
+	if err == nil && db.IsBanned(c.ip, uint64(pub_key)) {
+		err = common.ErrBanned
+	}
+	if err != nil {
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
 // Register image insertion into an open post.
 //
 // image: JSON-encoded inserted image data
@@ -280,3 +398,15 @@ func InsertImage(thread, post uint64, img common.Image) (err error) {
 		toWSBuffer(buf),
 	))
 }
+
+// Propagate a moderation action applied to a post to all clients
+func ModeratePost(
+	thread, post uint64,
+	action common.ModerationAction,
+) error {
+	return fromCError(C.ws_moderate_post(
+		C.uint64_t(thread),
+		C.uint64_t(post),
+		C.uint8_t(action),
+	))
+}
@@ -45,3 +45,13 @@ char* ws_set_config(const WSBuffer);
 //
 // image: JSON-encoded inserted image data
 char* ws_insert_image(uint64_t thread, uint64_t post, const WSBuffer image);
+
+// Propagate a moderation action applied to a post.
+//
+// action: common.ModerationAction applied to the post
+char* ws_moderate_post(uint64_t thread, uint64_t post, uint8_t action);
+
+// Deallocate the resources of a deleted thread and notify all clients.
+//
+// Error must be freed by caller, if not null.
+char* ws_remove_thread(uint64_t id);
@@ -16,6 +16,7 @@ import (
 
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
 	"github.com/go-playground/log"
 	"nhooyr.io/websocket"
 )
@@ -79,6 +80,7 @@ func Handle(w http.ResponseWriter, r *http.Request,
 	if err != nil {
 		return
 	}
+	banned := db.IsBanned(ip, 0)
 
 	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
 		InsecureSkipVerify: true,
@@ -87,6 +89,13 @@ func Handle(w http.ResponseWriter, r *http.Request,
 	if err != nil {
 		return
 	}
+	if banned {
+		// Close with a reason, so the client can display it
+		return false, conn.Close(
+			websocket.StatusPolicyViolation,
+			common.ErrBanned.Error(),
+		)
+	}
 	defer conn.Close(websocket.StatusNormalClosure, "")
 
 	c := client{
@@ -216,6 +216,7 @@ fn ref_option(src: &Option<String>) -> WSBuffer {
 
 // Create a new thread and return it's ID
 pub fn insert_thread(
+	client_id: u64,
 	subject: &str,
 	tags: &[String],
 	public_key: u64,
@@ -227,6 +228,7 @@ pub fn insert_thread(
 	let mut id: u64 = 0;
 	cast_c_err(unsafe {
 		ws_insert_thread(
+			client_id,
 			subject.into(),
 			tags_.as_ptr(),
 			tags_.len(),
@@ -242,6 +244,8 @@ pub fn insert_thread(
 
 // Create a new post and return it's ID and page
 pub fn insert_post(
+	client_id: u64,
+	sage: bool,
 	thread: u64,
 	public_key: u64,
 	name: &Option<String>,
@@ -252,6 +256,8 @@ pub fn insert_post(
 	let mut page: u32 = 0;
 	cast_c_err(unsafe {
 		ws_insert_post(
+			client_id,
+			sage,
 			thread,
 			public_key,
 			ref_option(name),
@@ -264,6 +270,54 @@ pub fn insert_post(
 	Ok((id, page))
 }
 
+// Apply post body filters to a modified open post body.
+// Returns the body with replacements applied, if any were made, or an error,
+// if the modification was rejected.
+pub fn filter_body(
+	thread: u64,
+	post: u64,
+	public_key: u64,
+	prev: &str,
+	body: &str,
+) -> Result<Option<String>, String> {
+	let mut filtered = WSBufferMut::default();
+	cast_c_err(unsafe {
+		ws_filter_body(
+			thread,
+			post,
+			public_key,
+			prev.into(),
+			body.into(),
+			&mut filtered as *mut WSBufferMut,
+		)
+	})?;
+	if filtered.data == null_mut() {
+		Ok(None)
+	} else {
+		Ok(Some(String::from_utf8_lossy(filtered.as_ref()).into()))
+	}
+}
+
+// Report a post and return the report's ID
+pub fn insert_report(
+	client_id: u64,
+	public_key: u64,
+	post: u64,
+	reason: &str,
+) -> Result<u64, String> {
+	let mut id: u64 = 0;
+	cast_c_err(unsafe {
+		ws_insert_report(
+			client_id,
+			public_key,
+			post,
+			reason.into(),
+			&mut id as *mut u64,
+		)
+	})?;
+	Ok(id)
+}
+
 // Log error on Go side
 pub fn log_error(err: &str) {
 	unsafe { ws_log_error(err.into()) };
@@ -315,6 +369,47 @@ extern "C" fn ws_insert_image(
 	})
 }
 
+// Propagate a moderation action applied to a post.
+//
+// action: common.ModerationAction applied to the post
+#[no_mangle]
+extern "C" fn ws_moderate_post(
+	thread: u64,
+	post: u64,
+	action: u8,
+) -> *mut c_char {
+	cast_to_c_error(|| -> Result<(), String> {
+		use protocol::payloads::PostModeration::*;
+
+		// Must match the values of common.ModerationAction on the Go side
+		let action = match action {
+			2 => Delete,
+			3 => DeleteImage,
+			4 => SpoilerImage,
+			8 => Purge,
+			9 => ShadowBin,
+			_ => {
+				return Err(format!("unsupported post moderation: {}", action))
+			}
+		};
+		pulsar::moderate_post(
+			thread,
+			protocol::payloads::ModeratePost { post, action },
+		)
+		.map_err(|e| e.to_string())?;
+		Ok(())
+	})
+}
+
+// Deallocate the resources of a deleted thread and notify all clients
+#[no_mangle]
+extern "C" fn ws_remove_thread(id: u64) -> *mut c_char {
+	cast_to_c_error(|| -> Result<(), String> {
+		pulsar::remove_thread(id).map_err(|e| e.to_string())?;
+		Ok(())
+	})
+}
+
 // Register public key in the DB (if not already registered) and return its
 // private ID, public ID and if the key was freshly registered
 pub fn register_public_key(
@@ -381,6 +476,12 @@ pub fn increment_spam_score(pub_key: u64, score: usize) {
 	}
 }
 
+// Register client authentication with a public key.
+// Returns an error, if the client is banned.
+pub fn authenticate_client(client_id: u64, pub_key: u64) -> Result<(), String> {
+	cast_c_err(unsafe { ws_authenticate_client(client_id, pub_key) })
+}
+
 // Check, if user needs to solve a captcha
 pub fn need_captcha(pub_key: u64) -> Result<bool, String> {
 	if !crate::config::read(|c| c.captcha) {
@@ -399,6 +500,7 @@ extern "C" {
 	fn ws_thread_exists(id: u64, exists: *mut bool) -> *mut c_char;
 	fn ws_log_error(err: WSBuffer);
 	fn ws_insert_thread(
+		client_id: u64,
 		subject: WSBuffer,
 		tags: *const WSBuffer,
 		tags_size: usize,
@@ -409,6 +511,8 @@ extern "C" {
 		id: *mut u64,
 	) -> *mut c_char;
 	fn ws_insert_post(
+		client_id: u64,
+		sage: bool,
 		thread: u64,
 		public_key: u64,
 		name: WSBuffer,
@@ -417,6 +521,21 @@ extern "C" {
 		id: *mut u64,
 		page: *mut u32,
 	) -> *mut c_char;
+	fn ws_filter_body(
+		thread: u64,
+		post: u64,
+		public_key: u64,
+		prev: WSBuffer,
+		body: WSBuffer,
+		filtered: *mut WSBufferMut,
+	) -> *mut c_char;
+	fn ws_insert_report(
+		client_id: u64,
+		public_key: u64,
+		post: u64,
+		reason: WSBuffer,
+		id: *mut u64,
+	) -> *mut c_char;
 	fn ws_register_public_key(
 		pub_key: WSBuffer,
 		priv_id: *mut u64,
@@ -435,4 +554,5 @@ extern "C" {
 	) -> *mut c_char;
 	fn ws_increment_spam_score(pub_key: u64, score: u64);
 	fn ws_need_captcha(pub_key: u64, need: *mut bool) -> *mut c_char;
+	fn ws_authenticate_client(client_id: u64, pub_key: u64) -> *mut c_char;
 }
@@ -2,8 +2,8 @@ use crate::{bindings, common::DynResult, config, pulsar, registry, str_err};
 use protocol::{
 	debug_log,
 	payloads::{
-		post_body::TextPatch, Authorization, HandshakeReq, PostCreationReq,
-		Signature, ThreadCreationReq,
+		post_body::TextPatch, Authorization, HandshakeReq, OpenBodyReset,
+		PostCreationReq, ReportReq, Signature, ThreadCreationReq,
 	},
 	Decoder, Encoder, MessageType,
 };
@@ -250,6 +250,9 @@ impl Client {
 							PatchPostBody => |req: TextPatch| {
 								self.patch_body(req)
 							}
+							Report => |req: ReportReq| {
+								self.report(req)
+							}
 						},
 					}
 				}
@@ -343,6 +346,7 @@ impl Client {
 		let [name, trip] = Self::parse_name(req.opts.name)?;
 		self.check_captcha(&req.captcha_solution)?;
 		let id = bindings::insert_thread(
+			self.id,
 			&req.subject,
 			&req.tags,
 			self.pub_key.priv_id,
@@ -385,8 +389,10 @@ impl Client {
 
 		let [name, trip] = Self::parse_name(req.opts.name)?;
 		let (id, page) = bindings::insert_post(
+			self.id,
+			req.sage,
 			req.thread,
-			req.thread,
+			self.pub_key.priv_id,
 			&name,
 			&trip,
 			Self::empty_body_json(),
@@ -407,6 +413,28 @@ impl Client {
 		Ok(())
 	}
 
+	// Report a post to staff and pass the report ID to client
+	fn report(&mut self, mut req: ReportReq) -> DynResult {
+		if bindings::need_captcha(self.pub_key.priv_id)? {
+			self.send(MessageType::NeedCaptcha, &())?;
+			return Ok(());
+		}
+
+		Self::trim(&mut req.reason);
+		if req.reason.is_empty() {
+			str_err!("no reason provided")
+		}
+		check_unicode_len!(req.reason, 100);
+		let id = bindings::insert_report(
+			self.id,
+			self.pub_key.priv_id,
+			req.post,
+			&req.reason,
+		)?;
+		self.send(MessageType::ReportAck, &id)?;
+		Ok(())
+	}
+
 	// Reduce open post text body size by n chars from the back
 	fn backspace(&mut self, n: usize) -> DynResult {
 		if n == 0 {
@@ -470,8 +498,8 @@ impl Client {
 		)
 	}
 
-	// Update post body, sync to various services and DB and performs error
-	// handling
+	// Update post body, apply post body filters, sync to various services and
+	// DB and performs error handling
 	//
 	// len_diff: how much in Unicode chars would the length of the body change
 	// affected: number of Unicode characters affected by the mutation
@@ -487,12 +515,33 @@ impl Client {
 			None => return Err("no post open".into()),
 		};
 
-		p.char_length += len_diff;
-		if p.char_length < 0 || p.char_length > 2000 {
+		let mut char_length = p.char_length + len_diff;
+		if char_length < 0 || char_length > 2000 {
 			str_err!("body length would exceed bounds")
 		}
 
-		modify(&mut p.body)?;
+		let mut body = p.body.clone();
+		modify(&mut body)?;
+		let mut reset = None;
+		if let Some(filtered) = bindings::filter_body(
+			p.thread,
+			p.id,
+			self.pub_key.priv_id,
+			&p.body,
+			&body,
+		)? {
+			char_length = filtered.chars().count() as isize;
+			if char_length > 2000 {
+				str_err!("body length would exceed bounds")
+			}
+			body = filtered;
+			reset = Some(OpenBodyReset {
+				post: p.id,
+				body: body.clone(),
+			});
+		}
+		p.char_length = char_length;
+		p.body = body;
 
 		pulsar::set_open_body(p.id, p.thread, p.body.clone())?;
 		bindings::increment_spam_score(
@@ -500,6 +549,12 @@ impl Client {
 			affected * crate::config::read(|c| c.spam_scores.character),
 		);
 
+		// The author computes further patches against its own copy of the
+		// body, so it must be replaced with the filtered one
+		if let Some(reset) = reset {
+			self.send(MessageType::ResetOpenBody, &reset)?;
+		}
+
 		Ok(())
 	}
 
@@ -539,7 +594,7 @@ impl Client {
 				};
 				if fresh {
 					registry::set_client_key(self.id, priv_id);
-					self.conn_state = ConnState::AcceptedHandshake;
+					self.accept_handshake()?;
 				} else {
 					self.conn_state = ConnState::RequestedReshake { pub_key };
 				}
@@ -585,6 +640,7 @@ impl Client {
 			str_err!("invalid signature");
 		}
 
+		self.accept_handshake()?;
 		self.send(
 			MessageType::Handshake,
 			&protocol::payloads::HandshakeRes {
@@ -592,6 +648,12 @@ impl Client {
 				id: self.pub_key.pub_id,
 			},
 		)?;
+		Ok(())
+	}
+
+	// Accept client handshake, if the client is not banned
+	fn accept_handshake(&mut self) -> DynResult {
+		bindings::authenticate_client(self.id, self.pub_key.priv_id)?;
 		self.conn_state = ConnState::AcceptedHandshake;
 		Ok(())
 	}
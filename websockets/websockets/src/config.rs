@@ -11,6 +11,9 @@ pub struct SpamScores {
 
 	// Score for creating a post
 	pub post_creation: usize,
+
+	// Score for reporting a post
+	pub report: usize,
 }
 
 // Global server configurations
@@ -1,7 +1,10 @@
 use crate::{bindings, common::DynResult, registry};
 use protocol::{
 	debug_log,
-	payloads::{FeedData, Image, PostCreationNotice, ThreadCreationNotice},
+	payloads::{
+		FeedData, Image, ModeratePost, PostCreationNotice,
+		ThreadCreationNotice,
+	},
 	util::SetMap,
 	Encoder, MessageType,
 };
@@ -56,6 +59,9 @@ pub fn init(feed_data: &[u8]) -> DynResult {
 						InsertPost(data) => p.insert_post(data),
 						RemoveThread(id) => p.remove_thread(id),
 						InsertImage(req) => p.insert_image(req),
+						ModeratePost { thread, req } => {
+							p.moderate_post(thread, req)
+						}
 						SetOpenBody { post, thread, body } => {
 							p.enqueue_open_body(post, thread, body)
 						}
@@ -451,14 +457,20 @@ impl Pulsar {
 		});
 	}
 
-	// Deallocate thread resources and redirect all of its clients
+	// Deallocate thread resources and notify all clients. Clients synced to
+	// the thread redirect themselves on receiving the message.
 	fn remove_thread(&mut self, id: u64) {
 		self.global.clear_cache();
+		if self.feeds.remove(&id).is_none() {
+			// Thread was not loaded into Pulsar or already removed
+			return;
+		}
 
-		todo!(concat!(
-			"Remove feed data, redirect clients on thread deletion, ",
-			"clear cache, pass message to global feed"
-		))
+		if let Err(e) = get_encoder(&mut self.global.pending)
+			.write_message(MessageType::RemoveThread, &id)
+		{
+			self.global.log_encode_error(e);
+		}
 	}
 
 	// Log an item has not been found
@@ -505,6 +517,33 @@ impl Pulsar {
 		})
 	}
 
+	// Apply a moderation action to a post and propagate it to clients
+	fn moderate_post(&mut self, thread: u64, req: ModeratePost) {
+		use protocol::payloads::PostModeration::*;
+
+		self.mod_thread(thread, |f| {
+			match req.action {
+				SpoilerImage => {
+					if let Some(p) = f.data.open_posts.get_mut(&req.post) {
+						p.image_spoilered = true;
+					}
+				}
+				DeleteImage => {
+					if let Some(p) = f.data.open_posts.get_mut(&req.post) {
+						p.has_image = false;
+						p.image_spoilered = false;
+					}
+				}
+				Delete | Purge | ShadowBin => {
+					// Hidden posts can no longer be edited
+					f.data.open_posts.remove(&req.post);
+					f.pending_open_bodies.remove(&req.post);
+				}
+			}
+			f.encode_post_message(req.post, MessageType::ModeratePost, &req);
+		})
+	}
+
 	// Enqueue open body for parsing and diffing on next pulse
 	fn enqueue_open_body(&mut self, post: u64, thread: u64, body: String) {
 		self.mod_thread(thread, |f| {
@@ -824,6 +863,12 @@ pub enum Request {
 	// Insert an image into an allocated post
 	InsertImage(ImageInsertionReq),
 
+	// Apply a moderation action to a post
+	ModeratePost {
+		thread: u64,
+		req: ModeratePost,
+	},
+
 	// Set the body of an open post
 	SetOpenBody {
 		post: u64,
@@ -863,6 +908,11 @@ pub fn insert_image(thread: u64, post: u64, img: Image) -> SendResult {
 	}))
 }
 
+// Apply a moderation action to a post
+pub fn moderate_post(thread: u64, req: ModeratePost) -> SendResult {
+	send_request(Request::ModeratePost { thread, req })
+}
+
 // Initialize a freshly-created post
 pub fn insert_post(data: PostCreationNotice) -> SendResult {
 	send_request(Request::InsertPost(data))